@@ -0,0 +1,74 @@
+//go:build !amd64
+// +build !amd64
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+func sumUint32(encoded []byte, count int) uint64 {
+	return sumUint32scalar(encoded, count)
+}
+
+func minMaxUint32(encoded []byte, count int) (min, max uint32) {
+	return minMaxUint32scalar(encoded, count)
+}
+
+func countRangeUint32(encoded []byte, count int, lo, hi uint32) int {
+	return countRangeUint32scalar(encoded, count, lo, hi)
+}
+
+func sumDeltaUint32(encoded []byte, count int, previous uint32) uint64 {
+	return sumDeltaUint32scalar(encoded, count, previous)
+}
+
+func minMaxDeltaUint32(encoded []byte, count int, previous uint32) (min, max uint32) {
+	return minMaxDeltaUint32scalar(encoded, count, previous)
+}
+
+func countRangeDeltaUint32(encoded []byte, count int, previous uint32, lo, hi uint32) int {
+	return countRangeDeltaUint32scalar(encoded, count, previous, lo, hi)
+}
+
+func sumInt32(encoded []byte, count int) int64 {
+	return sumInt32scalar(encoded, count)
+}
+
+func minMaxInt32(encoded []byte, count int) (min, max int32) {
+	return minMaxInt32scalar(encoded, count)
+}
+
+func countRangeInt32(encoded []byte, count int, lo, hi int32) int {
+	return countRangeInt32scalar(encoded, count, lo, hi)
+}
+
+func sumDeltaInt32(encoded []byte, count int, previous int32) int64 {
+	return sumDeltaInt32scalar(encoded, count, previous)
+}
+
+func minMaxDeltaInt32(encoded []byte, count int, previous int32) (min, max int32) {
+	return minMaxDeltaInt32scalar(encoded, count, previous)
+}
+
+func countRangeDeltaInt32(encoded []byte, count int, previous int32, lo, hi int32) int {
+	return countRangeDeltaInt32scalar(encoded, count, previous, lo, hi)
+}
@@ -0,0 +1,133 @@
+//go:generate go run gen_aggregate_sse3.go -out aggregate_sse3_amd64.s
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+func sumUint32(encoded []byte, count int) uint64 {
+	if activeKernel() >= KernelSSE3 {
+		return sumUint32SSE3(encoded, count)
+	}
+	return sumUint32scalar(encoded, count)
+}
+
+func sumUint32SSE3(encoded []byte, count int) uint64
+
+func minMaxUint32(encoded []byte, count int) (min, max uint32) {
+	if activeKernel() >= KernelSSE3 {
+		return minMaxUint32SSE3(encoded, count)
+	}
+	return minMaxUint32scalar(encoded, count)
+}
+
+func minMaxUint32SSE3(encoded []byte, count int) (min, max uint32)
+
+func countRangeUint32(encoded []byte, count int, lo, hi uint32) int {
+	if activeKernel() >= KernelSSE3 {
+		return countRangeUint32SSE3(encoded, count, lo, hi)
+	}
+	return countRangeUint32scalar(encoded, count, lo, hi)
+}
+
+func countRangeUint32SSE3(encoded []byte, count int, lo, hi uint32) int
+
+func sumDeltaUint32(encoded []byte, count int, previous uint32) uint64 {
+	if activeKernel() >= KernelSSE3 {
+		return sumDeltaUint32SSE3(encoded, count, previous)
+	}
+	return sumDeltaUint32scalar(encoded, count, previous)
+}
+
+func sumDeltaUint32SSE3(encoded []byte, count int, previous uint32) uint64
+
+func minMaxDeltaUint32(encoded []byte, count int, previous uint32) (min, max uint32) {
+	if activeKernel() >= KernelSSE3 {
+		return minMaxDeltaUint32SSE3(encoded, count, previous)
+	}
+	return minMaxDeltaUint32scalar(encoded, count, previous)
+}
+
+func minMaxDeltaUint32SSE3(encoded []byte, count int, previous uint32) (min, max uint32)
+
+func countRangeDeltaUint32(encoded []byte, count int, previous uint32, lo, hi uint32) int {
+	if activeKernel() >= KernelSSE3 {
+		return countRangeDeltaUint32SSE3(encoded, count, previous, lo, hi)
+	}
+	return countRangeDeltaUint32scalar(encoded, count, previous, lo, hi)
+}
+
+func countRangeDeltaUint32SSE3(encoded []byte, count int, previous uint32, lo, hi uint32) int
+
+func sumInt32(encoded []byte, count int) int64 {
+	if activeKernel() >= KernelSSE3 {
+		return sumInt32SSE3(encoded, count)
+	}
+	return sumInt32scalar(encoded, count)
+}
+
+func sumInt32SSE3(encoded []byte, count int) int64
+
+func minMaxInt32(encoded []byte, count int) (min, max int32) {
+	if activeKernel() >= KernelSSE3 {
+		return minMaxInt32SSE3(encoded, count)
+	}
+	return minMaxInt32scalar(encoded, count)
+}
+
+func minMaxInt32SSE3(encoded []byte, count int) (min, max int32)
+
+func countRangeInt32(encoded []byte, count int, lo, hi int32) int {
+	if activeKernel() >= KernelSSE3 {
+		return countRangeInt32SSE3(encoded, count, lo, hi)
+	}
+	return countRangeInt32scalar(encoded, count, lo, hi)
+}
+
+func countRangeInt32SSE3(encoded []byte, count int, lo, hi int32) int
+
+func sumDeltaInt32(encoded []byte, count int, previous int32) int64 {
+	if activeKernel() >= KernelSSE3 {
+		return sumDeltaInt32SSE3(encoded, count, previous)
+	}
+	return sumDeltaInt32scalar(encoded, count, previous)
+}
+
+func sumDeltaInt32SSE3(encoded []byte, count int, previous int32) int64
+
+func minMaxDeltaInt32(encoded []byte, count int, previous int32) (min, max int32) {
+	if activeKernel() >= KernelSSE3 {
+		return minMaxDeltaInt32SSE3(encoded, count, previous)
+	}
+	return minMaxDeltaInt32scalar(encoded, count, previous)
+}
+
+func minMaxDeltaInt32SSE3(encoded []byte, count int, previous int32) (min, max int32)
+
+func countRangeDeltaInt32(encoded []byte, count int, previous int32, lo, hi int32) int {
+	if activeKernel() >= KernelSSE3 {
+		return countRangeDeltaInt32SSE3(encoded, count, previous, lo, hi)
+	}
+	return countRangeDeltaInt32scalar(encoded, count, previous, lo, hi)
+}
+
+func countRangeDeltaInt32SSE3(encoded []byte, count int, previous int32, lo, hi int32) int
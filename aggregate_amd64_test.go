@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"testing"
+
+	"golang.org/x/sys/cpu"
+)
+
+func TestDifferentialAggregateSSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		encoded := make([]byte, MaxSize32(size))
+		EncodeUint32(encoded, makeRandomUint32(r, size))
+		lo, hi := r.Uint32()>>1, r.Uint32()|1<<31
+		previous := r.Uint32()
+
+		if got, expected := sumUint32SSE3(encoded, size), sumUint32scalar(encoded, size); got != expected {
+			t.Errorf("size %d: got sumUint32SSE3: %d, expected: %d", size, got, expected)
+		}
+		if got, expected := sumDeltaUint32SSE3(encoded, size, previous), sumDeltaUint32scalar(encoded, size, previous); got != expected {
+			t.Errorf("size %d: got sumDeltaUint32SSE3: %d, expected: %d", size, got, expected)
+		}
+		if got, expected := sumInt32SSE3(encoded, size), sumInt32scalar(encoded, size); got != expected {
+			t.Errorf("size %d: got sumInt32SSE3: %d, expected: %d", size, got, expected)
+		}
+		if got, expected := sumDeltaInt32SSE3(encoded, size, int32(previous)), sumDeltaInt32scalar(encoded, size, int32(previous)); got != expected {
+			t.Errorf("size %d: got sumDeltaInt32SSE3: %d, expected: %d", size, got, expected)
+		}
+
+		gotMin, gotMax := minMaxUint32SSE3(encoded, size)
+		min, max := minMaxUint32scalar(encoded, size)
+		if gotMin != min || gotMax != max {
+			t.Errorf("size %d: got minMaxUint32SSE3: %d, %d, expected: %d, %d", size, gotMin, gotMax, min, max)
+		}
+		gotMin, gotMax = minMaxDeltaUint32SSE3(encoded, size, previous)
+		min, max = minMaxDeltaUint32scalar(encoded, size, previous)
+		if gotMin != min || gotMax != max {
+			t.Errorf("size %d: got minMaxDeltaUint32SSE3: %d, %d, expected: %d, %d", size, gotMin, gotMax, min, max)
+		}
+		gotSignedMin, gotSignedMax := minMaxInt32SSE3(encoded, size)
+		signedMin, signedMax := minMaxInt32scalar(encoded, size)
+		if gotSignedMin != signedMin || gotSignedMax != signedMax {
+			t.Errorf("size %d: got minMaxInt32SSE3: %d, %d, expected: %d, %d", size, gotSignedMin, gotSignedMax, signedMin, signedMax)
+		}
+		gotSignedMin, gotSignedMax = minMaxDeltaInt32SSE3(encoded, size, int32(previous))
+		signedMin, signedMax = minMaxDeltaInt32scalar(encoded, size, int32(previous))
+		if gotSignedMin != signedMin || gotSignedMax != signedMax {
+			t.Errorf("size %d: got minMaxDeltaInt32SSE3: %d, %d, expected: %d, %d", size, gotSignedMin, gotSignedMax, signedMin, signedMax)
+		}
+
+		if got, expected := countRangeUint32SSE3(encoded, size, lo, hi), countRangeUint32scalar(encoded, size, lo, hi); got != expected {
+			t.Errorf("size %d: got countRangeUint32SSE3: %d, expected: %d", size, got, expected)
+		}
+		if got, expected := countRangeDeltaUint32SSE3(encoded, size, previous, lo, hi), countRangeDeltaUint32scalar(encoded, size, previous, lo, hi); got != expected {
+			t.Errorf("size %d: got countRangeDeltaUint32SSE3: %d, expected: %d", size, got, expected)
+		}
+		signedLo, signedHi := -int32(lo), int32(lo)
+		if got, expected := countRangeInt32SSE3(encoded, size, signedLo, signedHi), countRangeInt32scalar(encoded, size, signedLo, signedHi); got != expected {
+			t.Errorf("size %d: got countRangeInt32SSE3: %d, expected: %d", size, got, expected)
+		}
+		if got, expected := countRangeDeltaInt32SSE3(encoded, size, int32(previous), signedLo, signedHi), countRangeDeltaInt32scalar(encoded, size, int32(previous), signedLo, signedHi); got != expected {
+			t.Errorf("size %d: got countRangeDeltaInt32SSE3: %d, expected: %d", size, got, expected)
+		}
+	}
+}
+
+func BenchmarkSumUint32Scalar(b *testing.B) {
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = EncodeUint32(benchEncoded, benchUint32Data)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sumUint32scalar(benchEncoded, benchSize)
+	}
+}
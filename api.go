@@ -31,7 +31,8 @@ https://arxiv.org/abs/1709.08990
 
 The focus of this method is fast decoding speed on processors with
 SIMD instructions.  As such, this package contains fast assembly implementations
-for decoding on amd64 processors with SSE3 instructions.  Other processors
+for decoding on amd64 processors with SSE3, AVX2 or AVX-512 VBMI instructions and
+for encoding on amd64 processors with SSE4.1 or AVX-512 VBMI2 instructions.  Other processors
 will use the slower pure go implementation.
 
 Assembly implementations were generated using the excellent avo package https://github.com/mmcloughlin/avo
@@ -58,13 +59,14 @@ func MaxSize32(length int) int {
 //   encoded := make([]byte, MaxSize32(len(data)))
 // to obtain a worst case size.
 func EncodeUint32(encoded []byte, data []uint32) int {
-	return encodeUint32scalar(encoded, data)
+	return encodeUint32(encoded, data)
 }
 
 // DecodeUint32 decodes len(data) uint32 from encoded using the Stream
-// Vbyte algorithm.  encoded must contain exactly len(data) encoded uint32.
-func DecodeUint32(data []uint32, encoded []byte) {
-	decodeUint32(data, encoded)
+// Vbyte algorithm and returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded uint32.
+func DecodeUint32(data []uint32, encoded []byte) int {
+	return decodeUint32(data, encoded)
 }
 
 // EncodeDeltaUint32 encodes data using the Stream VByte
@@ -78,14 +80,15 @@ func DecodeUint32(data []uint32, encoded []byte) {
 //   encoded := make([]byte, MaxSize32(len(data)))
 // to obtain a worst case size.
 func EncodeDeltaUint32(encoded []byte, data []uint32, previous uint32) int {
-	return encodeDeltaUint32scalar(encoded, data, previous)
+	return encodeDeltaUint32(encoded, data, previous)
 }
 
 // DecodeDeltaUint32 decodes len(data) uint32 from encoded using the Stream
-// Vbyte algorithm with delta encoding using the initial value previous.
-// encoded must contain exactly len(data) encoded uint32.
-func DecodeDeltaUint32(data []uint32, encoded []byte, previous uint32) {
-	decodeDeltaUint32(data, encoded, previous)
+// Vbyte algorithm with delta encoding using the initial value previous and
+// returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded uint32.
+func DecodeDeltaUint32(data []uint32, encoded []byte, previous uint32) int {
+	return decodeDeltaUint32(data, encoded, previous)
 }
 
 // EncodeInt32 encodes data using the Stream VByte
@@ -95,13 +98,14 @@ func DecodeDeltaUint32(data []uint32, encoded []byte, previous uint32) {
 //   encoded := make([]byte, MaxSize32(len(data)))
 // to obtain a worst case size.
 func EncodeInt32(encoded []byte, data []int32) int {
-	return encodeInt32scalar(encoded, data)
+	return encodeInt32(encoded, data)
 }
 
 // DecodeInt32 decodes len(data) int32 from encoded using the Stream
-// Vbyte algorithm.  encoded must contain exactly len(data) encoded int32.
-func DecodeInt32(data []int32, encoded []byte) {
-	decodeInt32(data, encoded)
+// Vbyte algorithm and returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded int32.
+func DecodeInt32(data []int32, encoded []byte) int {
+	return decodeInt32(data, encoded)
 }
 
 // EncodeDeltaInt32 encodes data using the Stream VByte
@@ -116,12 +120,76 @@ func DecodeInt32(data []int32, encoded []byte) {
 //   encoded := make([]byte, MaxSize32(len(data)))
 // to obtain a worst case size.
 func EncodeDeltaInt32(encoded []byte, data []int32, previous int32) int {
-	return encodeDeltaInt32scalar(encoded, data, previous)
+	return encodeDeltaInt32(encoded, data, previous)
 }
 
 // DecodeDeltaInt32 decodes len(data) int32 from encoded using the Stream
-// Vbyte algorithm with delta and zigzag encoding using the initial value previous.
-// encoded must contain exactly len(data) encoded uint32.
-func DecodeDeltaInt32(data []int32, encoded []byte, previous int32) {
-	decodeDeltaInt32(data, encoded, previous)
+// Vbyte algorithm with delta and zigzag encoding using the initial value previous
+// and returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded int32.
+func DecodeDeltaInt32(data []int32, encoded []byte, previous int32) int {
+	return decodeDeltaInt32(data, encoded, previous)
+}
+
+// EncodedLen returns the size of count uint32 or int32 encoded at the start
+// of encoded, i.e. the number of bytes decoding them consumes, by summing the
+// lengths in the control bytes without decoding the data.  encoded must
+// contain at least the (count+3)/4 control bytes.
+func EncodedLen(count int, encoded []byte) int {
+	fullControlBytes := count >> 2
+	size := (count+3)>>2 + dataLen(encoded[:fullControlBytes])
+	if rem := count & 3; rem != 0 {
+		size += partialDataLen(encoded[fullControlBytes], rem)
+	}
+	return size
+}
+
+// SafeDecodeUint32 decodes len(data) uint32 from encoded using the Stream
+// Vbyte algorithm and returns the number of bytes consumed.  Unlike DecodeUint32
+// encoded may hold more data, and ErrTruncated is returned if it holds less.
+func SafeDecodeUint32(data []uint32, encoded []byte) (int, error) {
+	size, err := checkEncoded(len(data), encoded)
+	if err != nil {
+		return 0, err
+	}
+	decodeUint32(data, encoded[:size:size])
+	return size, nil
+}
+
+// SafeDecodeDeltaUint32 decodes len(data) uint32 from encoded using the Stream
+// Vbyte algorithm with delta encoding using the initial value previous and returns
+// the number of bytes consumed.  Unlike DecodeDeltaUint32 encoded may hold more
+// data, and ErrTruncated is returned if it holds less.
+func SafeDecodeDeltaUint32(data []uint32, encoded []byte, previous uint32) (int, error) {
+	size, err := checkEncoded(len(data), encoded)
+	if err != nil {
+		return 0, err
+	}
+	decodeDeltaUint32(data, encoded[:size:size], previous)
+	return size, nil
+}
+
+// SafeDecodeInt32 decodes len(data) int32 from encoded using the Stream
+// Vbyte algorithm and returns the number of bytes consumed.  Unlike DecodeInt32
+// encoded may hold more data, and ErrTruncated is returned if it holds less.
+func SafeDecodeInt32(data []int32, encoded []byte) (int, error) {
+	size, err := checkEncoded(len(data), encoded)
+	if err != nil {
+		return 0, err
+	}
+	decodeInt32(data, encoded[:size:size])
+	return size, nil
+}
+
+// SafeDecodeDeltaInt32 decodes len(data) int32 from encoded using the Stream
+// Vbyte algorithm with delta and zigzag encoding using the initial value previous
+// and returns the number of bytes consumed.  Unlike DecodeDeltaInt32 encoded may
+// hold more data, and ErrTruncated is returned if it holds less.
+func SafeDecodeDeltaInt32(data []int32, encoded []byte, previous int32) (int, error) {
+	size, err := checkEncoded(len(data), encoded)
+	if err != nil {
+		return 0, err
+	}
+	decodeDeltaInt32(data, encoded[:size:size], previous)
+	return size, nil
 }
@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+// The 0124 variant uses the same layout as EncodeUint32 but the control
+// codes 0, 1, 2, 3 indicate 0, 1, 2 and 4 data bytes, so zero values take
+// only their 2 control bits.  It compresses data dominated by zeros and
+// small values better at the cost of wider 3 byte values. Use MaxSize32
+// to obtain a worst case size.
+
+// EncodeUint32_0124 encodes data using the Stream VByte
+// 0124 algorithm into encoded and returns the encoded size.
+// This function assumes that the size of encoded is sufficient to hold the
+// compressed data.  Use
+//
+//	encoded := make([]byte, MaxSize32(len(data)))
+//
+// to obtain a worst case size.
+func EncodeUint32_0124(encoded []byte, data []uint32) int {
+	return encodeUint32_0124scalar(encoded, data)
+}
+
+// DecodeUint32_0124 decodes len(data) uint32 from encoded using the Stream
+// VByte 0124 algorithm and returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded uint32.
+func DecodeUint32_0124(data []uint32, encoded []byte) int {
+	return decodeUint32_0124(data, encoded)
+}
+
+// EncodeDeltaUint32_0124 encodes data using the Stream VByte
+// 0124 algorithm and delta encoding with a step size of 1, i.e. it encodes
+//
+//	delta[n] = data[n] - data[n-1],
+//
+// where the initial value
+//
+//	data[-1] := previous
+//
+// The return value is the encoded size.  This function assumes
+// that the size of encoded is sufficient to hold the
+// compressed data.  Use
+//
+//	encoded := make([]byte, MaxSize32(len(data)))
+//
+// to obtain a worst case size.
+func EncodeDeltaUint32_0124(encoded []byte, data []uint32, previous uint32) int {
+	return encodeDeltaUint32_0124scalar(encoded, data, previous)
+}
+
+// DecodeDeltaUint32_0124 decodes len(data) uint32 from encoded using the Stream
+// VByte 0124 algorithm with delta encoding using the initial value previous and
+// returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded uint32.
+func DecodeDeltaUint32_0124(data []uint32, encoded []byte, previous uint32) int {
+	return decodeDeltaUint32_0124(data, encoded, previous)
+}
+
+// EncodeInt32_0124 encodes data using the Stream VByte
+// 0124 algorithm with zigzag encoding into encoded and returns the encoded size.
+// This function assumes that the size of encoded is sufficient to hold the
+// compressed data.  Use
+//
+//	encoded := make([]byte, MaxSize32(len(data)))
+//
+// to obtain a worst case size.
+func EncodeInt32_0124(encoded []byte, data []int32) int {
+	return encodeInt32_0124scalar(encoded, data)
+}
+
+// DecodeInt32_0124 decodes len(data) int32 from encoded using the Stream
+// VByte 0124 algorithm and returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded int32.
+func DecodeInt32_0124(data []int32, encoded []byte) int {
+	return decodeInt32_0124(data, encoded)
+}
+
+// EncodeDeltaInt32_0124 encodes data using the Stream VByte
+// 0124 algorithm and delta encoding with a step size of 1, i.e. it encodes
+//
+//	delta[n] = data[n] - data[n-1]
+//
+// where the initial value
+//
+//	data[-1] := previous
+//
+// followed by zigzag encoding the deltas.
+// The return value is the encoded size.  This function assumes
+// that the size of encoded is sufficient to hold the
+// compressed data.  Use
+//
+//	encoded := make([]byte, MaxSize32(len(data)))
+//
+// to obtain a worst case size.
+func EncodeDeltaInt32_0124(encoded []byte, data []int32, previous int32) int {
+	return encodeDeltaInt32_0124scalar(encoded, data, previous)
+}
+
+// DecodeDeltaInt32_0124 decodes len(data) int32 from encoded using the Stream
+// VByte 0124 algorithm with delta and zigzag encoding using the initial value previous
+// and returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded int32.
+func DecodeDeltaInt32_0124(data []int32, encoded []byte, previous int32) int {
+	return decodeDeltaInt32_0124(data, encoded, previous)
+}
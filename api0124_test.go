@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"testing"
+)
+
+var zeroUint32Data = make([]uint32, benchSize)
+
+// testUniformAndRandomUint32_0124 round trips zero, uniform width and random data
+// through the 0124 encoder and decoder.
+func testUniformAndRandomUint32_0124(t *testing.T, encoder func([]byte, []uint32) int, decoder func([]uint32, []byte) int) {
+	for _, size := range testSizes {
+		expectedSize := (size + 3) / 4
+		testRoundTripUint32(t, encoder, decoder, zeroUint32Data[0:size:size], expectedSize)
+		expectedSize = (size+3)/4 + size
+		testRoundTripUint32(t, encoder, decoder, oneByteUint32Data[0:size:size], expectedSize)
+		expectedSize = (size+3)/4 + size*2
+		testRoundTripUint32(t, encoder, decoder, twoByteUint32Data[0:size:size], expectedSize)
+		expectedSize = (size+3)/4 + size*4
+		testRoundTripUint32(t, encoder, decoder, threeByteUint32Data[0:size:size], expectedSize)
+		testRoundTripUint32(t, encoder, decoder, fourByteUint32Data[0:size:size], expectedSize)
+		testRoundTripUint32(t, encoder, decoder, benchUint32Data[0:size:size], -1)
+	}
+}
+
+func testUniformAndRandomInt32_0124(t *testing.T, encoder func([]byte, []int32) int, decoder func([]int32, []byte) int) {
+	for _, size := range testSizes {
+		expectedSize := (size+3)/4 + size
+		testRoundTripInt32(t, encoder, decoder, oneByteInt32Data[0:size:size], expectedSize)
+		expectedSize = (size+3)/4 + size*2
+		testRoundTripInt32(t, encoder, decoder, twoByteInt32Data[0:size:size], expectedSize)
+		expectedSize = (size+3)/4 + size*4
+		testRoundTripInt32(t, encoder, decoder, threeByteInt32Data[0:size:size], expectedSize)
+		testRoundTripInt32(t, encoder, decoder, fourByteInt32Data[0:size:size], expectedSize)
+		testRoundTripInt32(t, encoder, decoder, benchInt32Data[0:size:size], -1)
+	}
+}
+
+func TestRoundTripUint32_0124(t *testing.T) {
+	testUniformAndRandomUint32_0124(t, EncodeUint32_0124, DecodeUint32_0124)
+}
+
+func EncodeDeltaUint32_0124Test(encoded []byte, data []uint32) int {
+	return EncodeDeltaUint32_0124(encoded, data, 0)
+}
+func DecodeDeltaUint32_0124Test(data []uint32, encoded []byte) int {
+	return DecodeDeltaUint32_0124(data, encoded, 0)
+}
+
+func TestRoundTripDeltaUint32_0124(t *testing.T) {
+	for _, size := range testSizes {
+		// a run of equal values has zero deltas after the first
+		testRoundTripUint32(t, EncodeDeltaUint32_0124Test, DecodeDeltaUint32_0124Test, oneByteUint32Data[0:size:size], -1)
+		testRoundTripUint32(t, EncodeDeltaUint32_0124Test, DecodeDeltaUint32_0124Test, benchUint32DataSorted[0:size:size], -1)
+		testRoundTripUint32(t, EncodeDeltaUint32_0124Test, DecodeDeltaUint32_0124Test, benchUint32Data[0:size:size], -1)
+	}
+}
+
+func TestRoundTripInt32_0124(t *testing.T) {
+	testUniformAndRandomInt32_0124(t, EncodeInt32_0124, DecodeInt32_0124)
+}
+
+func EncodeDeltaInt32_0124Test(encoded []byte, data []int32) int {
+	return EncodeDeltaInt32_0124(encoded, data, 0)
+}
+func DecodeDeltaInt32_0124Test(data []int32, encoded []byte) int {
+	return DecodeDeltaInt32_0124(data, encoded, 0)
+}
+
+func TestRoundTripDeltaInt32_0124(t *testing.T) {
+	for _, size := range testSizes {
+		testRoundTripInt32(t, EncodeDeltaInt32_0124Test, DecodeDeltaInt32_0124Test, oneByteDeltaInt32Data[0:size:size], -1)
+		testRoundTripInt32(t, EncodeDeltaInt32_0124Test, DecodeDeltaInt32_0124Test, benchInt32DataSorted[0:size:size], -1)
+		testRoundTripInt32(t, EncodeDeltaInt32_0124Test, DecodeDeltaInt32_0124Test, benchInt32Data[0:size:size], -1)
+	}
+}
+
+func TestEncodeUint32_0124Zeros(t *testing.T) {
+	// the first value needs a data byte, the remaining deltas are zero
+	data := []uint32{5, 5, 5, 5, 5}
+	encoded := make([]byte, MaxSize32(len(data)))
+	if size := EncodeDeltaUint32_0124(encoded, data, 0); size != 3 {
+		t.Errorf("got encodedSize: %d, expected: %d", size, 3)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+// The 16-bit format uses a single control bit per value, a set bit
+// indicates 2 data bytes and a clear bit 1 data byte, so 8 values share
+// a control byte.  The data bytes start at (len(data) + 7) / 8.
+
+// MaxSize16 returns the maximum possible size of an encoded
+// slice of 16-bit integers. Usage:
+//
+//	encoded := make([]byte, MaxSize16(len(data)))
+//
+// This will ensure that the slice is large enough to hold the
+// encoded data in the worst case of no compression.
+func MaxSize16(length int) int {
+	numControlBytes := (length + 7) / 8
+	maxNumDataBytes := 2 * length
+	return numControlBytes + maxNumDataBytes
+}
+
+// EncodeUint16 encodes data using the Stream VByte 16
+// algorithm into encoded and returns the encoded size.
+// This function assumes that the size of encoded is sufficient to hold the
+// compressed data.  Use
+//
+//	encoded := make([]byte, MaxSize16(len(data)))
+//
+// to obtain a worst case size.
+func EncodeUint16(encoded []byte, data []uint16) int {
+	return encodeUint16scalar(encoded, data)
+}
+
+// DecodeUint16 decodes len(data) uint16 from encoded using the Stream
+// VByte 16 algorithm and returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded uint16.
+func DecodeUint16(data []uint16, encoded []byte) int {
+	return decodeUint16(data, encoded)
+}
+
+// EncodeDeltaUint16 encodes data using the Stream VByte 16
+// algorithm and delta encoding with a step size of 1, i.e. it encodes
+//
+//	delta[n] = data[n] - data[n-1],
+//
+// where the initial value
+//
+//	data[-1] := previous
+//
+// The return value is the encoded size.  This function assumes
+// that the size of encoded is sufficient to hold the
+// compressed data.  Use
+//
+//	encoded := make([]byte, MaxSize16(len(data)))
+//
+// to obtain a worst case size.
+func EncodeDeltaUint16(encoded []byte, data []uint16, previous uint16) int {
+	return encodeDeltaUint16scalar(encoded, data, previous)
+}
+
+// DecodeDeltaUint16 decodes len(data) uint16 from encoded using the Stream
+// VByte 16 algorithm with delta encoding using the initial value previous and
+// returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded uint16.
+func DecodeDeltaUint16(data []uint16, encoded []byte, previous uint16) int {
+	return decodeDeltaUint16(data, encoded, previous)
+}
+
+// EncodeInt16 encodes data using the Stream VByte 16
+// algorithm with zigzag encoding into encoded and returns the encoded size.
+// This function assumes that the size of encoded is sufficient to hold the
+// compressed data.  Use
+//
+//	encoded := make([]byte, MaxSize16(len(data)))
+//
+// to obtain a worst case size.
+func EncodeInt16(encoded []byte, data []int16) int {
+	return encodeInt16scalar(encoded, data)
+}
+
+// DecodeInt16 decodes len(data) int16 from encoded using the Stream
+// VByte 16 algorithm and returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded int16.
+func DecodeInt16(data []int16, encoded []byte) int {
+	return decodeInt16(data, encoded)
+}
+
+// EncodeDeltaInt16 encodes data using the Stream VByte 16
+// algorithm and delta encoding with a step size of 1, i.e. it encodes
+//
+//	delta[n] = data[n] - data[n-1]
+//
+// where the initial value
+//
+//	data[-1] := previous
+//
+// followed by zigzag encoding the deltas.
+// The return value is the encoded size.  This function assumes
+// that the size of encoded is sufficient to hold the
+// compressed data.  Use
+//
+//	encoded := make([]byte, MaxSize16(len(data)))
+//
+// to obtain a worst case size.
+func EncodeDeltaInt16(encoded []byte, data []int16, previous int16) int {
+	return encodeDeltaInt16scalar(encoded, data, previous)
+}
+
+// DecodeDeltaInt16 decodes len(data) int16 from encoded using the Stream
+// VByte 16 algorithm with delta and zigzag encoding using the initial value previous
+// and returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded int16.
+func DecodeDeltaInt16(data []int16, encoded []byte, previous int16) int {
+	return decodeDeltaInt16(data, encoded, previous)
+}
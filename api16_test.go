@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var (
+	benchUint16Data       = make([]uint16, benchSize)
+	benchUint16DataSorted = make([]uint16, benchSize)
+	benchInt16Data        = make([]int16, benchSize)
+	benchEncoded16        = make([]byte, MaxSize16(benchSize))
+)
+
+func init() {
+	previous := uint16(0)
+	for i, v := range benchUint32Data {
+		benchUint16Data[i] = uint16(v)
+		benchInt16Data[i] = int16(benchInt32Data[i])
+		previous += uint16(v)
+		benchUint16DataSorted[i] = previous
+	}
+}
+
+// makeUniformUint16 returns size uint16 each width bytes wide.
+func makeUniformUint16(size, width int) []uint16 {
+	data := make([]uint16, size, size)
+	for i := range data {
+		data[i] = 0xBEEF >> (8 * uint(2-width))
+	}
+	return data
+}
+
+// makeUniformDeltaUint16 returns size uint16 with deltas each width bytes wide.
+func makeUniformDeltaUint16(size, width int) []uint16 {
+	data := make([]uint16, size, size)
+	previous := uint16(0)
+	for i := range data {
+		previous += 0xBEEF >> (8 * uint(2-width))
+		data[i] = previous
+	}
+	return data
+}
+
+// makeUniformInt16 returns size int16 of alternating sign whose zigzag
+// encodings are each width bytes wide.
+func makeUniformInt16(size, width int) []int16 {
+	data := make([]int16, size, size)
+	for i := range data {
+		data[i] = int16(0x3EEF>>(8*uint(2-width))) * int16(1-(i&1)<<1)
+	}
+	return data
+}
+
+// makeUniformDeltaInt16 returns size int16 with deltas of alternating sign
+// whose zigzag encodings are each width bytes wide.
+func makeUniformDeltaInt16(size, width int) []int16 {
+	data := make([]int16, size, size)
+	previous := int16(0)
+	for i := range data {
+		previous += int16(0x3EEF>>(8*uint(2-width))) * int16(1-(i&1)<<1)
+		data[i] = previous
+	}
+	return data
+}
+
+// makeRandomUint16 returns size uint16 with byte widths chosen uniformly
+// at random so that every control byte is exercised.
+func makeRandomUint16(r *rand.Rand, size int) []uint16 {
+	data := make([]uint16, size, size)
+	for i := range data {
+		data[i] = uint16(r.Uint32()) >> (8 * uint(r.Intn(2)))
+	}
+	return data
+}
+
+// makeRandomInt16 returns size int16 whose zigzag encodings have byte widths
+// chosen uniformly at random.
+func makeRandomInt16(r *rand.Rand, size int) []int16 {
+	data := make([]int16, size, size)
+	for i := range data {
+		v := uint16(r.Uint32()) >> (8 * uint(r.Intn(2)))
+		data[i] = int16((v >> 1) ^ -(v & 1))
+	}
+	return data
+}
+
+// testRoundTripUint16 tests that encoder and decoder correctly round trip data,
+// if expectedSize is not negative the encoded size will be verified.
+func testRoundTripUint16(t *testing.T, encoder func([]byte, []uint16) int, decoder func([]uint16, []byte) int, data []uint16, expectedSize int) {
+	encodedRaw := make([]byte, MaxSize16(len(data)))
+	encodedSize := encoder(encodedRaw, data)
+	if expectedSize >= 0 && encodedSize != expectedSize {
+		t.Errorf("got encodedSize: %d, expected: %d", encodedSize, expectedSize)
+	}
+	encoded := make([]byte, encodedSize, encodedSize) // ensure the encoded size is precise
+	copy(encoded, encodedRaw)
+	decodedData := make([]uint16, len(data), len(data))
+	decodedSize := decoder(decodedData, encoded)
+	if decodedSize != encodedSize {
+		t.Errorf("got decodedSize: %d, expected: %d", decodedSize, encodedSize)
+	}
+	for i := range data {
+		if decodedData[i] != data[i] {
+			t.Fatalf("got decodedData[%d]: %d, expected: %d", i, decodedData[i], data[i])
+		}
+	}
+}
+
+// testRoundTripInt16 tests that encoder and decoder correctly round trip data,
+// if expectedSize is not negative the encoded size will be verified.
+func testRoundTripInt16(t *testing.T, encoder func([]byte, []int16) int, decoder func([]int16, []byte) int, data []int16, expectedSize int) {
+	encodedRaw := make([]byte, MaxSize16(len(data)))
+	encodedSize := encoder(encodedRaw, data)
+	if expectedSize >= 0 && encodedSize != expectedSize {
+		t.Errorf("got encodedSize: %d, expected: %d", encodedSize, expectedSize)
+	}
+	encoded := make([]byte, encodedSize, encodedSize) // ensure the encoded size is precise
+	copy(encoded, encodedRaw)
+	decodedData := make([]int16, len(data), len(data))
+	decodedSize := decoder(decodedData, encoded)
+	if decodedSize != encodedSize {
+		t.Errorf("got decodedSize: %d, expected: %d", decodedSize, encodedSize)
+	}
+	for i := range data {
+		if decodedData[i] != data[i] {
+			t.Fatalf("got decodedData[%d]: %d, expected: %d", i, decodedData[i], data[i])
+		}
+	}
+}
+
+// uint16
+
+func testUniformAndRandomUint16(t *testing.T, encoder func([]byte, []uint16) int, decoder func([]uint16, []byte) int, makeUniform func(int, int) []uint16) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		for width := 1; width <= 2; width++ {
+			expectedSize := (size+7)/8 + size*width
+			testRoundTripUint16(t, encoder, decoder, makeUniform(size, width), expectedSize)
+		}
+		testRoundTripUint16(t, encoder, decoder, makeRandomUint16(r, size), -1)
+	}
+}
+
+func TestRoundTripUint16(t *testing.T) {
+	testUniformAndRandomUint16(t, EncodeUint16, DecodeUint16, makeUniformUint16)
+}
+
+func EncodeDeltaUint16Test(encoded []byte, data []uint16) int {
+	return EncodeDeltaUint16(encoded, data, 0)
+}
+func DecodeDeltaUint16Test(data []uint16, encoded []byte) int {
+	return DecodeDeltaUint16(data, encoded, 0)
+}
+
+func TestRoundTripDeltaUint16(t *testing.T) {
+	testUniformAndRandomUint16(t, EncodeDeltaUint16Test, DecodeDeltaUint16Test, makeUniformDeltaUint16)
+}
+
+// int16
+
+func testUniformAndRandomInt16(t *testing.T, encoder func([]byte, []int16) int, decoder func([]int16, []byte) int, makeUniform func(int, int) []int16) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		for width := 1; width <= 2; width++ {
+			expectedSize := (size+7)/8 + size*width
+			testRoundTripInt16(t, encoder, decoder, makeUniform(size, width), expectedSize)
+		}
+		testRoundTripInt16(t, encoder, decoder, makeRandomInt16(r, size), -1)
+	}
+}
+
+func TestRoundTripInt16(t *testing.T) {
+	testUniformAndRandomInt16(t, EncodeInt16, DecodeInt16, makeUniformInt16)
+}
+
+func EncodeDeltaInt16Test(encoded []byte, data []int16) int {
+	return EncodeDeltaInt16(encoded, data, 0)
+}
+func DecodeDeltaInt16Test(data []int16, encoded []byte) int {
+	return DecodeDeltaInt16(data, encoded, 0)
+}
+
+func TestRoundTripDeltaInt16(t *testing.T) {
+	testUniformAndRandomInt16(t, EncodeDeltaInt16Test, DecodeDeltaInt16Test, makeUniformDeltaInt16)
+}
@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+// The 64-bit format packs a 3-bit code per value into the control bytes,
+// the code n indicates n+1 data bytes, so 8 values share 3 control bytes.
+// The data bytes start at (3*len(data) + 7) / 8.
+
+// MaxSize64 returns the maximum possible size of an encoded
+// slice of 64-bit integers. Usage:
+//
+//	encoded := make([]byte, MaxSize64(len(data)))
+//
+// This will ensure that the slice is large enough to hold the
+// encoded data in the worst case of no compression.
+func MaxSize64(length int) int {
+	numControlBytes := (3*length + 7) / 8
+	maxNumDataBytes := 8 * length
+	return numControlBytes + maxNumDataBytes
+}
+
+// EncodeUint64 encodes data using the Stream VByte 64
+// algorithm into encoded and returns the encoded size.
+// This function assumes that the size of encoded is sufficient to hold the
+// compressed data.  Use
+//
+//	encoded := make([]byte, MaxSize64(len(data)))
+//
+// to obtain a worst case size.
+func EncodeUint64(encoded []byte, data []uint64) int {
+	return encodeUint64scalar(encoded, data)
+}
+
+// DecodeUint64 decodes len(data) uint64 from encoded using the Stream
+// VByte 64 algorithm and returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded uint64.
+func DecodeUint64(data []uint64, encoded []byte) int {
+	return decodeUint64(data, encoded)
+}
+
+// EncodeDeltaUint64 encodes data using the Stream VByte 64
+// algorithm and delta encoding with a step size of 1, i.e. it encodes
+//
+//	delta[n] = data[n] - data[n-1],
+//
+// where the initial value
+//
+//	data[-1] := previous
+//
+// The return value is the encoded size.  This function assumes
+// that the size of encoded is sufficient to hold the
+// compressed data.  Use
+//
+//	encoded := make([]byte, MaxSize64(len(data)))
+//
+// to obtain a worst case size.
+func EncodeDeltaUint64(encoded []byte, data []uint64, previous uint64) int {
+	return encodeDeltaUint64scalar(encoded, data, previous)
+}
+
+// DecodeDeltaUint64 decodes len(data) uint64 from encoded using the Stream
+// VByte 64 algorithm with delta encoding using the initial value previous and
+// returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded uint64.
+func DecodeDeltaUint64(data []uint64, encoded []byte, previous uint64) int {
+	return decodeDeltaUint64(data, encoded, previous)
+}
+
+// EncodeInt64 encodes data using the Stream VByte 64
+// algorithm with zigzag encoding into encoded and returns the encoded size.
+// This function assumes that the size of encoded is sufficient to hold the
+// compressed data.  Use
+//
+//	encoded := make([]byte, MaxSize64(len(data)))
+//
+// to obtain a worst case size.
+func EncodeInt64(encoded []byte, data []int64) int {
+	return encodeInt64scalar(encoded, data)
+}
+
+// DecodeInt64 decodes len(data) int64 from encoded using the Stream
+// VByte 64 algorithm and returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded int64.
+func DecodeInt64(data []int64, encoded []byte) int {
+	return decodeInt64(data, encoded)
+}
+
+// EncodeDeltaInt64 encodes data using the Stream VByte 64
+// algorithm and delta encoding with a step size of 1, i.e. it encodes
+//
+//	delta[n] = data[n] - data[n-1]
+//
+// where the initial value
+//
+//	data[-1] := previous
+//
+// followed by zigzag encoding the deltas.
+// The return value is the encoded size.  This function assumes
+// that the size of encoded is sufficient to hold the
+// compressed data.  Use
+//
+//	encoded := make([]byte, MaxSize64(len(data)))
+//
+// to obtain a worst case size.
+func EncodeDeltaInt64(encoded []byte, data []int64, previous int64) int {
+	return encodeDeltaInt64scalar(encoded, data, previous)
+}
+
+// DecodeDeltaInt64 decodes len(data) int64 from encoded using the Stream
+// VByte 64 algorithm with delta and zigzag encoding using the initial value previous
+// and returns the number of bytes consumed.
+// encoded must contain at least len(data) encoded int64.
+func DecodeDeltaInt64(data []int64, encoded []byte, previous int64) int {
+	return decodeDeltaInt64(data, encoded, previous)
+}
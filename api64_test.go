@@ -0,0 +1,216 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var (
+	benchUint64Data       = make([]uint64, benchSize)
+	benchUint64DataSorted = make([]uint64, benchSize)
+	benchInt64Data        = make([]int64, benchSize)
+	benchEncoded64        = make([]byte, MaxSize64(benchSize))
+)
+
+func init() {
+	previous := uint64(0)
+	for i, v := range benchUint32Data {
+		benchUint64Data[i] = uint64(v)<<uint(v&31) | uint64(v)
+		benchInt64Data[i] = int64(benchInt32Data[i]) << uint(v&31)
+		previous += uint64(v)
+		benchUint64DataSorted[i] = previous
+	}
+}
+
+// makeUniformUint64 returns size uint64 each width bytes wide.
+func makeUniformUint64(size, width int) []uint64 {
+	data := make([]uint64, size, size)
+	for i := range data {
+		data[i] = 0x8877665544332211 >> (8 * uint(8-width))
+	}
+	return data
+}
+
+// makeUniformDeltaUint64 returns size uint64 with deltas each width bytes wide.
+func makeUniformDeltaUint64(size, width int) []uint64 {
+	data := make([]uint64, size, size)
+	previous := uint64(0)
+	for i := range data {
+		previous += 0x8877665544332211 >> (8 * uint(8-width))
+		data[i] = previous
+	}
+	return data
+}
+
+// makeUniformInt64 returns size int64 of alternating sign whose zigzag
+// encodings are each width bytes wide.
+func makeUniformInt64(size, width int) []int64 {
+	data := make([]int64, size, size)
+	for i := range data {
+		data[i] = int64(0x3877665544332211>>(8*uint(8-width))) * int64(1-(i&1)<<1)
+	}
+	return data
+}
+
+// makeUniformDeltaInt64 returns size int64 with deltas of alternating sign
+// whose zigzag encodings are each width bytes wide.
+func makeUniformDeltaInt64(size, width int) []int64 {
+	data := make([]int64, size, size)
+	previous := int64(0)
+	for i := range data {
+		previous += int64(0x3877665544332211>>(8*uint(8-width))) * int64(1-(i&1)<<1)
+		data[i] = previous
+	}
+	return data
+}
+
+// makeRandomUint64 returns size uint64 with byte widths chosen uniformly
+// at random so that every code is exercised.
+func makeRandomUint64(r *rand.Rand, size int) []uint64 {
+	data := make([]uint64, size, size)
+	for i := range data {
+		data[i] = r.Uint64() >> (8 * uint(r.Intn(8)))
+	}
+	return data
+}
+
+// makeRandomInt64 returns size int64 whose zigzag encodings have byte widths
+// chosen uniformly at random.
+func makeRandomInt64(r *rand.Rand, size int) []int64 {
+	data := make([]int64, size, size)
+	for i := range data {
+		v := r.Uint64() >> (8 * uint(r.Intn(8)))
+		data[i] = int64((v >> 1) ^ -(v & 1))
+	}
+	return data
+}
+
+// testRoundTripUint64 tests that encoder and decoder correctly round trip data,
+// if expectedSize is not negative the encoded size will be verified.
+func testRoundTripUint64(t *testing.T, encoder func([]byte, []uint64) int, decoder func([]uint64, []byte) int, data []uint64, expectedSize int) {
+	encodedRaw := make([]byte, MaxSize64(len(data)))
+	encodedSize := encoder(encodedRaw, data)
+	if expectedSize >= 0 && encodedSize != expectedSize {
+		t.Errorf("got encodedSize: %d, expected: %d", encodedSize, expectedSize)
+	}
+	encoded := make([]byte, encodedSize, encodedSize) // ensure the encoded size is precise
+	copy(encoded, encodedRaw)
+	decodedData := make([]uint64, len(data), len(data))
+	decodedSize := decoder(decodedData, encoded)
+	if decodedSize != encodedSize {
+		t.Errorf("got decodedSize: %d, expected: %d", decodedSize, encodedSize)
+	}
+	for i := range data {
+		if decodedData[i] != data[i] {
+			t.Fatalf("got decodedData[%d]: %d, expected: %d", i, decodedData[i], data[i])
+		}
+	}
+}
+
+// testRoundTripInt64 tests that encoder and decoder correctly round trip data,
+// if expectedSize is not negative the encoded size will be verified.
+func testRoundTripInt64(t *testing.T, encoder func([]byte, []int64) int, decoder func([]int64, []byte) int, data []int64, expectedSize int) {
+	encodedRaw := make([]byte, MaxSize64(len(data)))
+	encodedSize := encoder(encodedRaw, data)
+	if expectedSize >= 0 && encodedSize != expectedSize {
+		t.Errorf("got encodedSize: %d, expected: %d", encodedSize, expectedSize)
+	}
+	encoded := make([]byte, encodedSize, encodedSize) // ensure the encoded size is precise
+	copy(encoded, encodedRaw)
+	decodedData := make([]int64, len(data), len(data))
+	decodedSize := decoder(decodedData, encoded)
+	if decodedSize != encodedSize {
+		t.Errorf("got decodedSize: %d, expected: %d", decodedSize, encodedSize)
+	}
+	for i := range data {
+		if decodedData[i] != data[i] {
+			t.Fatalf("got decodedData[%d]: %d, expected: %d", i, decodedData[i], data[i])
+		}
+	}
+}
+
+// uint64
+
+func testUniformAndRandomUint64(t *testing.T, encoder func([]byte, []uint64) int, decoder func([]uint64, []byte) int, makeUniform func(int, int) []uint64) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		for width := 1; width <= 8; width++ {
+			expectedSize := (3*size+7)/8 + size*width
+			testRoundTripUint64(t, encoder, decoder, makeUniform(size, width), expectedSize)
+		}
+		testRoundTripUint64(t, encoder, decoder, makeRandomUint64(r, size), -1)
+	}
+}
+
+func TestRoundTripUint64(t *testing.T) {
+	testUniformAndRandomUint64(t, EncodeUint64, DecodeUint64, makeUniformUint64)
+}
+
+func EncodeDeltaUint64Test(encoded []byte, data []uint64) int {
+	return EncodeDeltaUint64(encoded, data, 0)
+}
+func DecodeDeltaUint64Test(data []uint64, encoded []byte) int {
+	return DecodeDeltaUint64(data, encoded, 0)
+}
+
+func TestRoundTripDeltaUint64(t *testing.T) {
+	testUniformAndRandomUint64(t, EncodeDeltaUint64Test, DecodeDeltaUint64Test, makeUniformDeltaUint64)
+}
+
+// int64
+
+func testUniformAndRandomInt64(t *testing.T, encoder func([]byte, []int64) int, decoder func([]int64, []byte) int, makeUniform func(int, int) []int64) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		for width := 1; width <= 8; width++ {
+			expectedSize := (3*size+7)/8 + size*width
+			testRoundTripInt64(t, encoder, decoder, makeUniform(size, width), expectedSize)
+		}
+		testRoundTripInt64(t, encoder, decoder, makeRandomInt64(r, size), -1)
+	}
+}
+
+func TestRoundTripInt64(t *testing.T) {
+	testUniformAndRandomInt64(t, EncodeInt64, DecodeInt64, makeUniformInt64)
+}
+
+func EncodeDeltaInt64Test(encoded []byte, data []int64) int {
+	return EncodeDeltaInt64(encoded, data, 0)
+}
+func DecodeDeltaInt64Test(data []int64, encoded []byte) int {
+	return DecodeDeltaInt64(data, encoded, 0)
+}
+
+func TestRoundTripDeltaInt64(t *testing.T) {
+	testUniformAndRandomInt64(t, EncodeDeltaInt64Test, DecodeDeltaInt64Test, makeUniformDeltaInt64)
+}
+
+func TestMaxSize64(t *testing.T) {
+	for _, size := range testSizes {
+		if got, expected := MaxSize64(size), numControlBytes64(size)+8*size; got != expected {
+			t.Errorf("got MaxSize64(%d): %d, expected: %d", size, got, expected)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+// The aggregate functions operate directly on count values encoded at the
+// start of encoded, as by the corresponding Encode function, without
+// writing the decoded values to memory.  encoded must contain at least
+// count encoded values.
+
+// SumUint32 returns the sum of count uint32 encoded by EncodeUint32.
+func SumUint32(encoded []byte, count int) uint64 {
+	return sumUint32(encoded, count)
+}
+
+// MinMaxUint32 returns the minimum and maximum of count uint32 encoded by
+// EncodeUint32.  If count is zero, min is the largest and max the smallest uint32.
+func MinMaxUint32(encoded []byte, count int) (min, max uint32) {
+	return minMaxUint32(encoded, count)
+}
+
+// CountRangeUint32 returns the number of values in the closed interval [lo, hi]
+// among count uint32 encoded by EncodeUint32.
+func CountRangeUint32(encoded []byte, count int, lo, hi uint32) int {
+	return countRangeUint32(encoded, count, lo, hi)
+}
+
+// SumDeltaUint32 returns the sum of count uint32 encoded by EncodeDeltaUint32 with the initial value previous.
+func SumDeltaUint32(encoded []byte, count int, previous uint32) uint64 {
+	return sumDeltaUint32(encoded, count, previous)
+}
+
+// MinMaxDeltaUint32 returns the minimum and maximum of count uint32 encoded by
+// EncodeDeltaUint32 with the initial value previous.  If count is zero, min is the largest and max the smallest uint32.
+func MinMaxDeltaUint32(encoded []byte, count int, previous uint32) (min, max uint32) {
+	return minMaxDeltaUint32(encoded, count, previous)
+}
+
+// CountRangeDeltaUint32 returns the number of values in the closed interval [lo, hi]
+// among count uint32 encoded by EncodeDeltaUint32 with the initial value previous.
+func CountRangeDeltaUint32(encoded []byte, count int, previous uint32, lo, hi uint32) int {
+	return countRangeDeltaUint32(encoded, count, previous, lo, hi)
+}
+
+// SumInt32 returns the sum of count int32 encoded by EncodeInt32.
+func SumInt32(encoded []byte, count int) int64 {
+	return sumInt32(encoded, count)
+}
+
+// MinMaxInt32 returns the minimum and maximum of count int32 encoded by
+// EncodeInt32.  If count is zero, min is the largest and max the smallest int32.
+func MinMaxInt32(encoded []byte, count int) (min, max int32) {
+	return minMaxInt32(encoded, count)
+}
+
+// CountRangeInt32 returns the number of values in the closed interval [lo, hi]
+// among count int32 encoded by EncodeInt32.
+func CountRangeInt32(encoded []byte, count int, lo, hi int32) int {
+	return countRangeInt32(encoded, count, lo, hi)
+}
+
+// SumDeltaInt32 returns the sum of count int32 encoded by EncodeDeltaInt32 with the initial value previous.
+func SumDeltaInt32(encoded []byte, count int, previous int32) int64 {
+	return sumDeltaInt32(encoded, count, previous)
+}
+
+// MinMaxDeltaInt32 returns the minimum and maximum of count int32 encoded by
+// EncodeDeltaInt32 with the initial value previous.  If count is zero, min is the largest and max the smallest int32.
+func MinMaxDeltaInt32(encoded []byte, count int, previous int32) (min, max int32) {
+	return minMaxDeltaInt32(encoded, count, previous)
+}
+
+// CountRangeDeltaInt32 returns the number of values in the closed interval [lo, hi]
+// among count int32 encoded by EncodeDeltaInt32 with the initial value previous.
+func CountRangeDeltaInt32(encoded []byte, count int, previous int32, lo, hi int32) int {
+	return countRangeDeltaInt32(encoded, count, previous, lo, hi)
+}
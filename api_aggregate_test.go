@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestAggregateUint32(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeRandomDeltaUint32(r, size, 7)
+		encoded := make([]byte, MaxSize32(size))
+		deltaEncoded := make([]byte, MaxSize32(size))
+		EncodeUint32(encoded, data)
+		EncodeDeltaUint32(deltaEncoded, data, 7)
+		lo, hi := r.Uint32(), r.Uint32()
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+
+		var sum uint64
+		min, max := uint32(math.MaxUint32), uint32(0)
+		inRange := 0
+		for _, v := range data {
+			sum += uint64(v)
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+			if lo <= v && v <= hi {
+				inRange++
+			}
+		}
+
+		if got := SumUint32(encoded, size); got != sum {
+			t.Errorf("size %d: got SumUint32: %d, expected: %d", size, got, sum)
+		}
+		if got := SumDeltaUint32(deltaEncoded, size, 7); got != sum {
+			t.Errorf("size %d: got SumDeltaUint32: %d, expected: %d", size, got, sum)
+		}
+		if gotMin, gotMax := MinMaxUint32(encoded, size); gotMin != min || gotMax != max {
+			t.Errorf("size %d: got MinMaxUint32: %d, %d, expected: %d, %d", size, gotMin, gotMax, min, max)
+		}
+		if gotMin, gotMax := MinMaxDeltaUint32(deltaEncoded, size, 7); gotMin != min || gotMax != max {
+			t.Errorf("size %d: got MinMaxDeltaUint32: %d, %d, expected: %d, %d", size, gotMin, gotMax, min, max)
+		}
+		if got := CountRangeUint32(encoded, size, lo, hi); got != inRange {
+			t.Errorf("size %d: got CountRangeUint32: %d, expected: %d", size, got, inRange)
+		}
+		if got := CountRangeDeltaUint32(deltaEncoded, size, 7, lo, hi); got != inRange {
+			t.Errorf("size %d: got CountRangeDeltaUint32: %d, expected: %d", size, got, inRange)
+		}
+	}
+}
+
+func TestAggregateInt32(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeRandomDeltaInt32(r, size, -7)
+		encoded := make([]byte, MaxSize32(size))
+		deltaEncoded := make([]byte, MaxSize32(size))
+		EncodeInt32(encoded, data)
+		EncodeDeltaInt32(deltaEncoded, data, -7)
+		lo, hi := int32(r.Uint32()), int32(r.Uint32())
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+
+		var sum int64
+		min, max := int32(math.MaxInt32), int32(math.MinInt32)
+		inRange := 0
+		for _, v := range data {
+			sum += int64(v)
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+			if lo <= v && v <= hi {
+				inRange++
+			}
+		}
+
+		if got := SumInt32(encoded, size); got != sum {
+			t.Errorf("size %d: got SumInt32: %d, expected: %d", size, got, sum)
+		}
+		if got := SumDeltaInt32(deltaEncoded, size, -7); got != sum {
+			t.Errorf("size %d: got SumDeltaInt32: %d, expected: %d", size, got, sum)
+		}
+		if gotMin, gotMax := MinMaxInt32(encoded, size); gotMin != min || gotMax != max {
+			t.Errorf("size %d: got MinMaxInt32: %d, %d, expected: %d, %d", size, gotMin, gotMax, min, max)
+		}
+		if gotMin, gotMax := MinMaxDeltaInt32(deltaEncoded, size, -7); gotMin != min || gotMax != max {
+			t.Errorf("size %d: got MinMaxDeltaInt32: %d, %d, expected: %d, %d", size, gotMin, gotMax, min, max)
+		}
+		if got := CountRangeInt32(encoded, size, lo, hi); got != inRange {
+			t.Errorf("size %d: got CountRangeInt32: %d, expected: %d", size, got, inRange)
+		}
+		if got := CountRangeDeltaInt32(deltaEncoded, size, -7, lo, hi); got != inRange {
+			t.Errorf("size %d: got CountRangeDeltaInt32: %d, expected: %d", size, got, inRange)
+		}
+	}
+}
+
+func BenchmarkSumUint32(b *testing.B) {
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = EncodeUint32(benchEncoded, benchUint32Data)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SumUint32(benchEncoded, benchSize)
+	}
+}
+
+func BenchmarkMinMaxDeltaUint32(b *testing.B) {
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = EncodeDeltaUint32(benchEncoded, benchUint32DataSorted, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MinMaxDeltaUint32(benchEncoded, benchSize, 0)
+	}
+}
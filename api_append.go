@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/bits"
+	"slices"
+)
+
+// AppendUint32 appends the encoding of data like EncodeUint32 to dst and
+// returns the extended slice.  dst is grown only if its spare capacity is
+// less than the exact encoded size, found by a first pass over data.
+func AppendUint32(dst []byte, data []uint32) []byte {
+	size := controlLen(len(data))
+	for _, v := range data {
+		size += valueLen(v)
+	}
+	dst = slices.Grow(dst, size)
+	n := EncodeUint32(dst[len(dst):cap(dst)], data)
+	return dst[:len(dst)+n]
+}
+
+// AppendDeltaUint32 appends the encoding of data like EncodeDeltaUint32 with
+// the initial value previous to dst and returns the extended slice.  dst is
+// grown only if its spare capacity is less than the exact encoded size, found
+// by a first pass over data.
+func AppendDeltaUint32(dst []byte, data []uint32, previous uint32) []byte {
+	size := controlLen(len(data))
+	last := previous
+	for _, v := range data {
+		size += valueLen(v - last)
+		last = v
+	}
+	dst = slices.Grow(dst, size)
+	n := EncodeDeltaUint32(dst[len(dst):cap(dst)], data, previous)
+	return dst[:len(dst)+n]
+}
+
+// AppendInt32 appends the encoding of data like EncodeInt32 to dst and returns
+// the extended slice.  dst is grown only if its spare capacity is less than
+// the exact encoded size, found by a first pass over data.
+func AppendInt32(dst []byte, data []int32) []byte {
+	size := controlLen(len(data))
+	for _, v := range data {
+		size += valueLen(zigzag(v))
+	}
+	dst = slices.Grow(dst, size)
+	n := EncodeInt32(dst[len(dst):cap(dst)], data)
+	return dst[:len(dst)+n]
+}
+
+// AppendDeltaInt32 appends the encoding of data like EncodeDeltaInt32 with the
+// initial value previous to dst and returns the extended slice.  dst is grown
+// only if its spare capacity is less than the exact encoded size, found by a
+// first pass over data.
+func AppendDeltaInt32(dst []byte, data []int32, previous int32) []byte {
+	size := controlLen(len(data))
+	last := previous
+	for _, v := range data {
+		size += valueLen(zigzag(v - last))
+		last = v
+	}
+	dst = slices.Grow(dst, size)
+	n := EncodeDeltaInt32(dst[len(dst):cap(dst)], data, previous)
+	return dst[:len(dst)+n]
+}
+
+// controlLen returns the number of control bytes of count values.
+func controlLen(count int) int {
+	return (count + 3) >> 2
+}
+
+// valueLen returns the number of data bytes v is encoded with.
+func valueLen(v uint32) int {
+	return (bits.Len32(v|1) + 7) >> 3
+}
+
+// zigzag returns the zigzag encoding of v as EncodeInt32 stores it.
+func zigzag(v int32) uint32 {
+	return uint32((v >> 31) ^ (v << 1))
+}
+
+// AppendDecodeUint32 appends the n values decoded from encoded like
+// DecodeUint32 to dst and returns the extended slice.  encoded must contain at
+// least n encoded uint32.
+func AppendDecodeUint32(dst []uint32, encoded []byte, n int) []uint32 {
+	dst = slices.Grow(dst, n)
+	DecodeUint32(dst[len(dst):len(dst)+n], encoded)
+	return dst[:len(dst)+n]
+}
+
+// AppendDecodeDeltaUint32 appends the n values decoded from encoded like
+// DecodeDeltaUint32 with the initial value previous to dst and returns the
+// extended slice.  encoded must contain at least n encoded uint32.
+func AppendDecodeDeltaUint32(dst []uint32, encoded []byte, n int, previous uint32) []uint32 {
+	dst = slices.Grow(dst, n)
+	DecodeDeltaUint32(dst[len(dst):len(dst)+n], encoded, previous)
+	return dst[:len(dst)+n]
+}
+
+// AppendDecodeInt32 appends the n values decoded from encoded like DecodeInt32
+// to dst and returns the extended slice.  encoded must contain at least n
+// encoded int32.
+func AppendDecodeInt32(dst []int32, encoded []byte, n int) []int32 {
+	dst = slices.Grow(dst, n)
+	DecodeInt32(dst[len(dst):len(dst)+n], encoded)
+	return dst[:len(dst)+n]
+}
+
+// AppendDecodeDeltaInt32 appends the n values decoded from encoded like
+// DecodeDeltaInt32 with the initial value previous to dst and returns the
+// extended slice.  encoded must contain at least n encoded int32.
+func AppendDecodeDeltaInt32(dst []int32, encoded []byte, n int, previous int32) []int32 {
+	dst = slices.Grow(dst, n)
+	DecodeDeltaInt32(dst[len(dst):len(dst)+n], encoded, previous)
+	return dst[:len(dst)+n]
+}
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"bytes"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestAppend(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	prefix := []byte("prefix")
+	for _, size := range testSizes {
+		data := makeRandomUint32(r, size)
+		int32Data := make([]int32, size)
+		for i, v := range data {
+			int32Data[i] = int32(v)
+		}
+		encoded := make([]byte, MaxSize32(size))
+
+		n := EncodeUint32(encoded, data)
+		got := AppendUint32(slices.Clone(prefix), data)
+		if !bytes.Equal(got, append(slices.Clone(prefix), encoded[:n]...)) {
+			t.Errorf("size %d: AppendUint32 got %d bytes, expected: %d", size, len(got), len(prefix)+n)
+		}
+		decoded := AppendDecodeUint32([]uint32{1, 2}, got[len(prefix):], size)
+		if !slices.Equal(decoded, append([]uint32{1, 2}, data...)) {
+			t.Errorf("size %d: AppendDecodeUint32 got %d values", size, len(decoded))
+		}
+
+		n = EncodeDeltaUint32(encoded, data, 7)
+		got = AppendDeltaUint32(slices.Clone(prefix), data, 7)
+		if !bytes.Equal(got, append(slices.Clone(prefix), encoded[:n]...)) {
+			t.Errorf("size %d: AppendDeltaUint32 got %d bytes, expected: %d", size, len(got), len(prefix)+n)
+		}
+		decoded = AppendDecodeDeltaUint32(nil, got[len(prefix):], size, 7)
+		if !slices.Equal(decoded, data) {
+			t.Errorf("size %d: AppendDecodeDeltaUint32 got %d values", size, len(decoded))
+		}
+
+		n = EncodeInt32(encoded, int32Data)
+		got = AppendInt32(slices.Clone(prefix), int32Data)
+		if !bytes.Equal(got, append(slices.Clone(prefix), encoded[:n]...)) {
+			t.Errorf("size %d: AppendInt32 got %d bytes, expected: %d", size, len(got), len(prefix)+n)
+		}
+		int32Decoded := AppendDecodeInt32([]int32{-1}, got[len(prefix):], size)
+		if !slices.Equal(int32Decoded, append([]int32{-1}, int32Data...)) {
+			t.Errorf("size %d: AppendDecodeInt32 got %d values", size, len(int32Decoded))
+		}
+
+		n = EncodeDeltaInt32(encoded, int32Data, -7)
+		got = AppendDeltaInt32(slices.Clone(prefix), int32Data, -7)
+		if !bytes.Equal(got, append(slices.Clone(prefix), encoded[:n]...)) {
+			t.Errorf("size %d: AppendDeltaInt32 got %d bytes, expected: %d", size, len(got), len(prefix)+n)
+		}
+		int32Decoded = AppendDecodeDeltaInt32(nil, got[len(prefix):], size, -7)
+		if !slices.Equal(int32Decoded, int32Data) {
+			t.Errorf("size %d: AppendDecodeDeltaInt32 got %d values", size, len(int32Decoded))
+		}
+	}
+}
+
+func TestAppendNoGrow(t *testing.T) {
+	data := []uint32{1, 1 << 8, 1 << 16, 1 << 24, 5}
+	dst := make([]byte, 0, MaxSize32(len(data)))
+	if got := AppendUint32(dst, data); &got[0] != &dst[:1][0] {
+		t.Error("AppendUint32 reallocated dst with sufficient capacity")
+	}
+	values := make([]uint32, 0, len(data))
+	if got := AppendDecodeUint32(values, AppendUint32(nil, data), len(data)); &got[0] != &values[:1][0] {
+		t.Error("AppendDecodeUint32 reallocated dst with sufficient capacity")
+	}
+}
+
+func TestAppendExactGrow(t *testing.T) {
+	data := []uint32{1, 2, 3, 1 << 8, 1 << 16, 7, 1 << 24, 5, 6}
+	int32Data := []int32{1, -2, 3, -1 << 8, 1 << 16, -7, 1 << 24, 5, -6}
+	encoded := make([]byte, MaxSize32(len(data)))
+	for _, tc := range []struct {
+		name   string
+		encode func([]byte) int
+		append func([]byte) []byte
+	}{
+		{"AppendUint32", func(b []byte) int { return EncodeUint32(b, data) }, func(dst []byte) []byte { return AppendUint32(dst, data) }},
+		{"AppendDeltaUint32", func(b []byte) int { return EncodeDeltaUint32(b, data, 7) }, func(dst []byte) []byte { return AppendDeltaUint32(dst, data, 7) }},
+		{"AppendInt32", func(b []byte) int { return EncodeInt32(b, int32Data) }, func(dst []byte) []byte { return AppendInt32(dst, int32Data) }},
+		{"AppendDeltaInt32", func(b []byte) int { return EncodeDeltaInt32(b, int32Data, -7) }, func(dst []byte) []byte { return AppendDeltaInt32(dst, int32Data, -7) }},
+	} {
+		n := tc.encode(encoded)
+		dst := make([]byte, 2, 2+n)
+		got := tc.append(dst)
+		if &got[0] != &dst[0] {
+			t.Errorf("%s reallocated dst with capacity for the %d encoded bytes", tc.name, n)
+		}
+		if !bytes.Equal(got[2:], encoded[:n]) {
+			t.Errorf("%s got %d bytes, expected: %d", tc.name, len(got)-2, n)
+		}
+	}
+}
+
+func BenchmarkAppendUint32(b *testing.B) {
+	dst := make([]byte, 0, MaxSize32(benchSize))
+	b.SetBytes(int64(4 * benchSize))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = AppendUint32(dst[:0], benchUint32Data)
+	}
+}
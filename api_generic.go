@@ -0,0 +1,181 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"unsafe"
+)
+
+// Integer is the set of integer types with a Stream VByte encoding, including
+// named types such as
+//
+//	type DocID uint32
+//
+// 16-bit types use the format of EncodeUint16, 32-bit types that of
+// EncodeUint32 and 64-bit types that of EncodeUint64, with zigzag encoding for
+// signed types.
+type Integer interface {
+	~uint16 | ~int16 | ~uint32 | ~int32 | ~uint64 | ~int64
+}
+
+// integerKind identifies the specialized functions for an Integer type.
+type integerKind int
+
+const (
+	kindUint16 integerKind = iota
+	kindInt16
+	kindUint32
+	kindInt32
+	kindUint64
+	kindInt64
+)
+
+// kindOf returns the integerKind of T from its size and signedness, which
+// unlike a type switch also identifies named types.
+func kindOf[T Integer]() integerKind {
+	var zero T
+	kind := kindUint16
+	switch unsafe.Sizeof(zero) {
+	case 4:
+		kind = kindUint32
+	case 8:
+		kind = kindUint64
+	}
+	if ^zero < 0 {
+		kind++
+	}
+	return kind
+}
+
+// as returns s reinterpreted as a slice of U, which must have the same size
+// as T.
+func as[U, T Integer](s []T) []U {
+	return unsafe.Slice((*U)(unsafe.Pointer(unsafe.SliceData(s))), len(s))
+}
+
+// MaxSize returns the maximum possible size of length encoded values of type
+// T, i.e. MaxSize16, MaxSize32 or MaxSize64 depending on its size.
+func MaxSize[T Integer](length int) int {
+	switch kindOf[T]() {
+	case kindUint16, kindInt16:
+		return MaxSize16(length)
+	case kindUint32, kindInt32:
+		return MaxSize32(length)
+	default:
+		return MaxSize64(length)
+	}
+}
+
+// Encode encodes data into encoded with the specialized encoder for the
+// underlying type of T, e.g. EncodeUint32 or EncodeInt64, and returns the
+// encoded size.  This function assumes that the size of encoded is
+// sufficient to hold the compressed data.  Use
+//
+//	encoded := make([]byte, MaxSize[T](len(data)))
+//
+// to obtain a worst case size.
+func Encode[T Integer](encoded []byte, data []T) int {
+	switch kindOf[T]() {
+	case kindUint16:
+		return EncodeUint16(encoded, as[uint16](data))
+	case kindInt16:
+		return EncodeInt16(encoded, as[int16](data))
+	case kindUint32:
+		return EncodeUint32(encoded, as[uint32](data))
+	case kindInt32:
+		return EncodeInt32(encoded, as[int32](data))
+	case kindUint64:
+		return EncodeUint64(encoded, as[uint64](data))
+	default:
+		return EncodeInt64(encoded, as[int64](data))
+	}
+}
+
+// Decode decodes len(data) values from encoded with the specialized decoder
+// for the underlying type of T, e.g. DecodeUint32 or DecodeInt64, and returns
+// the number of bytes consumed.  encoded must contain at least len(data)
+// encoded values.
+func Decode[T Integer](data []T, encoded []byte) int {
+	switch kindOf[T]() {
+	case kindUint16:
+		return DecodeUint16(as[uint16](data), encoded)
+	case kindInt16:
+		return DecodeInt16(as[int16](data), encoded)
+	case kindUint32:
+		return DecodeUint32(as[uint32](data), encoded)
+	case kindInt32:
+		return DecodeInt32(as[int32](data), encoded)
+	case kindUint64:
+		return DecodeUint64(as[uint64](data), encoded)
+	default:
+		return DecodeInt64(as[int64](data), encoded)
+	}
+}
+
+// EncodeDelta encodes data into encoded with delta encoding using the initial
+// value previous, using the specialized encoder for the underlying type of T,
+// e.g. EncodeDeltaUint32 or EncodeDeltaInt64, and returns the encoded size.
+// This function assumes that the size of encoded is sufficient to hold the
+// compressed data.  Use
+//
+//	encoded := make([]byte, MaxSize[T](len(data)))
+//
+// to obtain a worst case size.
+func EncodeDelta[T Integer](encoded []byte, data []T, previous T) int {
+	switch kindOf[T]() {
+	case kindUint16:
+		return EncodeDeltaUint16(encoded, as[uint16](data), uint16(previous))
+	case kindInt16:
+		return EncodeDeltaInt16(encoded, as[int16](data), int16(previous))
+	case kindUint32:
+		return EncodeDeltaUint32(encoded, as[uint32](data), uint32(previous))
+	case kindInt32:
+		return EncodeDeltaInt32(encoded, as[int32](data), int32(previous))
+	case kindUint64:
+		return EncodeDeltaUint64(encoded, as[uint64](data), uint64(previous))
+	default:
+		return EncodeDeltaInt64(encoded, as[int64](data), int64(previous))
+	}
+}
+
+// DecodeDelta decodes len(data) values from encoded with delta encoding using
+// the initial value previous, using the specialized decoder for the
+// underlying type of T, e.g. DecodeDeltaUint32 or DecodeDeltaInt64, and
+// returns the number of bytes consumed.  encoded must contain at least
+// len(data) encoded values.
+func DecodeDelta[T Integer](data []T, encoded []byte, previous T) int {
+	switch kindOf[T]() {
+	case kindUint16:
+		return DecodeDeltaUint16(as[uint16](data), encoded, uint16(previous))
+	case kindInt16:
+		return DecodeDeltaInt16(as[int16](data), encoded, int16(previous))
+	case kindUint32:
+		return DecodeDeltaUint32(as[uint32](data), encoded, uint32(previous))
+	case kindInt32:
+		return DecodeDeltaInt32(as[int32](data), encoded, int32(previous))
+	case kindUint64:
+		return DecodeDeltaUint64(as[uint64](data), encoded, uint64(previous))
+	default:
+		return DecodeDeltaInt64(as[int64](data), encoded, int64(previous))
+	}
+}
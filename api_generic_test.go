@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"bytes"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+type docID uint32
+
+type offset int64
+
+// testGeneric checks that the generic functions match the specialized ones
+// for T, whose underlying type is U.
+func testGeneric[T, U Integer](t *testing.T, r *rand.Rand, maxSize func(int) int,
+	encode func([]byte, []U) int, encodeDelta func([]byte, []U, U) int) {
+	for _, size := range testSizes {
+		data := make([]T, size)
+		for i := range data {
+			data[i] = T(r.Uint64() >> (r.Intn(64)))
+		}
+		previous := T(r.Uint64())
+		if got := MaxSize[T](size); got != maxSize(size) {
+			t.Errorf("size %d: got MaxSize[%T] %d, expected: %d", size, previous, got, maxSize(size))
+		}
+		expected := make([]byte, maxSize(size))
+		encoded := make([]byte, maxSize(size))
+		decoded := make([]T, size)
+
+		n := encode(expected, as[U](data))
+		if got := Encode(encoded, data); got != n || !bytes.Equal(encoded[:n], expected[:n]) {
+			t.Errorf("size %d: got Encode[%T] size %d, expected: %d", size, previous, got, n)
+		}
+		if got := Decode(decoded, encoded); got != n || !slices.Equal(decoded, data) {
+			t.Errorf("size %d: got Decode[%T] size %d, expected: %d", size, previous, got, n)
+		}
+
+		n = encodeDelta(expected, as[U](data), U(previous))
+		if got := EncodeDelta(encoded, data, previous); got != n || !bytes.Equal(encoded[:n], expected[:n]) {
+			t.Errorf("size %d: got EncodeDelta[%T] size %d, expected: %d", size, previous, got, n)
+		}
+		if got := DecodeDelta(decoded, encoded, previous); got != n || !slices.Equal(decoded, data) {
+			t.Errorf("size %d: got DecodeDelta[%T] size %d, expected: %d", size, previous, got, n)
+		}
+	}
+}
+
+func TestGeneric(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	testGeneric[uint16](t, r, MaxSize16, EncodeUint16, EncodeDeltaUint16)
+	testGeneric[int16](t, r, MaxSize16, EncodeInt16, EncodeDeltaInt16)
+	testGeneric[uint32](t, r, MaxSize32, EncodeUint32, EncodeDeltaUint32)
+	testGeneric[docID](t, r, MaxSize32, EncodeUint32, EncodeDeltaUint32)
+	testGeneric[int32](t, r, MaxSize32, EncodeInt32, EncodeDeltaInt32)
+	testGeneric[uint64](t, r, MaxSize64, EncodeUint64, EncodeDeltaUint64)
+	testGeneric[int64](t, r, MaxSize64, EncodeInt64, EncodeDeltaInt64)
+	testGeneric[offset](t, r, MaxSize64, EncodeInt64, EncodeDeltaInt64)
+}
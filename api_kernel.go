@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"errors"
+	"os"
+	"sync/atomic"
+)
+
+// Kernel identifies a set of implementations the package dispatches to.
+// Kernels are ordered, each one using the fastest implementation of every
+// function at or below its level that the CPU supports, e.g. KernelAVX2 uses
+// the AVX2 decoders along with the SSE4.1 encoders and the SSE3 aggregates.
+type Kernel int32
+
+const (
+	// KernelScalar is the reference pure go implementation.
+	KernelScalar Kernel = iota
+	// KernelSWAR adds the table driven pure go decoders.
+	KernelSWAR
+	// KernelSSE3 adds the SSE3 decoders and the SSE4.1 encoders on amd64.
+	KernelSSE3
+	// KernelAVX2 adds the AVX2 decoders on amd64.
+	KernelAVX2
+	// KernelAVX512 adds the AVX-512 VBMI decoders and VBMI2 encoders on amd64.
+	KernelAVX512
+)
+
+// KernelEnv is the environment variable read at startup to select the
+// Kernel, holding one of the names returned by Kernel.String.  Unknown or
+// unsupported kernels are ignored in favor of the fastest available.
+const KernelEnv = "STREAMVBYTE_KERNEL"
+
+var kernelNames = [...]string{
+	KernelScalar: "scalar",
+	KernelSWAR:   "swar",
+	KernelSSE3:   "sse3",
+	KernelAVX2:   "avx2",
+	KernelAVX512: "avx512",
+}
+
+// ErrKernelUnsupported is returned by SetKernel for a Kernel the CPU does not support.
+var ErrKernelUnsupported = errors.New("streamvbyte: kernel not supported on this CPU")
+
+// String returns the name of k, e.g. "sse3".
+func (k Kernel) String() string {
+	if k < 0 || int(k) >= len(kernelNames) {
+		return "unknown"
+	}
+	return kernelNames[k]
+}
+
+// ParseKernel returns the Kernel named name as returned by Kernel.String.
+func ParseKernel(name string) (Kernel, error) {
+	for k, kernelName := range kernelNames {
+		if name == kernelName {
+			return Kernel(k), nil
+		}
+	}
+	return 0, errors.New("streamvbyte: unknown kernel " + name)
+}
+
+// Available returns the kernels the CPU supports in increasing order.
+func Available() []Kernel {
+	var kernels []Kernel
+	for k := range kernelNames {
+		if kernelSupported(Kernel(k)) {
+			kernels = append(kernels, Kernel(k))
+		}
+	}
+	return kernels
+}
+
+// currentKernel holds the active Kernel, initially the one selected by
+// KernelEnv or the fastest available.
+var currentKernel = int32(initialKernel(os.Getenv(KernelEnv)))
+
+func initialKernel(name string) Kernel {
+	if k, err := ParseKernel(name); err == nil && kernelSupported(k) {
+		return k
+	}
+	kernels := Available()
+	return kernels[len(kernels)-1]
+}
+
+func activeKernel() Kernel {
+	return Kernel(atomic.LoadInt32(&currentKernel))
+}
+
+// ActiveKernel returns the Kernel in use.
+func ActiveKernel() Kernel {
+	return activeKernel()
+}
+
+// SetKernel selects the Kernel used by all subsequent calls, e.g. to pin an
+// implementation in tests or to benchmark kernels side by side.  It returns
+// ErrKernelUnsupported if k is not among Available.
+func SetKernel(k Kernel) error {
+	if !kernelSupported(k) {
+		return ErrKernelUnsupported
+	}
+	atomic.StoreInt32(&currentKernel, int32(k))
+	return nil
+}
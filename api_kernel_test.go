@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// withKernel runs f with k selected, restoring the active Kernel afterwards.
+func withKernel(t testing.TB, k Kernel, f func()) {
+	defer SetKernel(ActiveKernel())
+	if err := SetKernel(k); err != nil {
+		t.Fatalf("SetKernel(%v): %v", k, err)
+	}
+	f()
+}
+
+func TestKernelNames(t *testing.T) {
+	for k := KernelScalar; k <= KernelAVX512; k++ {
+		if got, err := ParseKernel(k.String()); err != nil || got != k {
+			t.Errorf("got ParseKernel(%q): %v, %v, expected: %v", k.String(), got, err, k)
+		}
+	}
+	if _, err := ParseKernel("bogus"); err == nil {
+		t.Error("expected an error parsing an unknown kernel")
+	}
+	if got := Kernel(99).String(); got != "unknown" {
+		t.Errorf("got Kernel(99).String(): %q, expected: \"unknown\"", got)
+	}
+}
+
+func TestAvailable(t *testing.T) {
+	kernels := Available()
+	if len(kernels) < 2 || kernels[0] != KernelScalar || kernels[1] != KernelSWAR {
+		t.Errorf("got Available: %v, expected the pure go kernels first", kernels)
+	}
+	if !slices.IsSorted(kernels) {
+		t.Errorf("got Available: %v, expected increasing order", kernels)
+	}
+	if !slices.Contains(kernels, ActiveKernel()) {
+		t.Errorf("got ActiveKernel: %v, expected one of %v", ActiveKernel(), kernels)
+	}
+	if err := SetKernel(Kernel(99)); err != ErrKernelUnsupported {
+		t.Errorf("got SetKernel(99) error: %v, expected: %v", err, ErrKernelUnsupported)
+	}
+}
+
+func TestInitialKernel(t *testing.T) {
+	kernels := Available()
+	for _, tc := range []struct {
+		name     string
+		expected Kernel
+	}{
+		{"", kernels[len(kernels)-1]},
+		{"bogus", kernels[len(kernels)-1]},
+		{"scalar", KernelScalar},
+		{"swar", KernelSWAR},
+	} {
+		if got := initialKernel(tc.name); got != tc.expected {
+			t.Errorf("got initialKernel(%q): %v, expected: %v", tc.name, got, tc.expected)
+		}
+	}
+}
+
+// TestKernels checks that every available Kernel encodes and decodes like
+// the scalar reference.
+func TestKernels(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeRandomUint32(r, size)
+		int32Data := make([]int32, size)
+		for i, v := range data {
+			int32Data[i] = int32(v)
+		}
+		expected := make([]byte, MaxSize32(size))
+		n := encodeDeltaUint32scalar(expected, data, 7)
+		expectedInt32 := make([]byte, MaxSize32(size))
+		m := encodeDeltaInt32scalar(expectedInt32, int32Data, -7)
+
+		for _, k := range Available() {
+			withKernel(t, k, func() {
+				encoded := make([]byte, MaxSize32(size))
+				if got := EncodeDeltaUint32(encoded, data, 7); got != n || !slices.Equal(encoded[:n], expected[:n]) {
+					t.Errorf("size %d kernel %v: EncodeDeltaUint32 mismatch", size, k)
+				}
+				decoded := make([]uint32, size)
+				if got := DecodeDeltaUint32(decoded, encoded, 7); got != n || !slices.Equal(decoded, data) {
+					t.Errorf("size %d kernel %v: DecodeDeltaUint32 mismatch", size, k)
+				}
+				if got := EncodedLen(size, encoded); got != n {
+					t.Errorf("size %d kernel %v: got EncodedLen %d, expected: %d", size, k, got, n)
+				}
+				if got, expected := SumUint32(encoded, size), sumUint32scalar(encoded, size); got != expected {
+					t.Errorf("size %d kernel %v: got SumUint32 %d, expected: %d", size, k, got, expected)
+				}
+
+				if got := EncodeDeltaInt32(encoded, int32Data, -7); got != m || !slices.Equal(encoded[:m], expectedInt32[:m]) {
+					t.Errorf("size %d kernel %v: EncodeDeltaInt32 mismatch", size, k)
+				}
+				decodedInt32 := make([]int32, size)
+				if got := DecodeDeltaInt32(decodedInt32, encoded, -7); got != m || !slices.Equal(decodedInt32, int32Data) {
+					t.Errorf("size %d kernel %v: DecodeDeltaInt32 mismatch", size, k)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkDecodeUint32Kernels(b *testing.B) {
+	benchEncodedSize = encodeUint32scalar(benchEncoded, benchUint32Data)
+	for _, k := range Available() {
+		b.Run(k.String(), func(b *testing.B) {
+			withKernel(b, k, func() {
+				b.SetBytes(int64(4 * benchSize))
+				for i := 0; i < b.N; i++ {
+					DecodeUint32(benchUint32Data, benchEncoded)
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func makeSortedUint32(r *rand.Rand, size int) []uint32 {
+	data := makeRandomUint32(r, size)
+	sort.Slice(data, func(i, j int) bool { return data[i] < data[j] })
+	return data
+}
+
+func searchTargets(r *rand.Rand, data []uint32) []uint32 {
+	targets := []uint32{0, math.MaxUint32}
+	for i := 0; i < 16 && len(data) > 0; i++ {
+		v := data[r.Intn(len(data))]
+		targets = append(targets, v, v+1, v-1)
+	}
+	return targets
+}
+
+func TestSearchDeltaUint32(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeSortedUint32(r, size)
+		encoded := make([]byte, MaxSize32(size))
+		EncodeDeltaUint32(encoded, data, 0)
+		for _, target := range searchTargets(r, data) {
+			expected := sort.Search(size, func(i int) bool { return data[i] >= target })
+			if got := SearchDeltaUint32(encoded, size, 0, target); got != expected {
+				t.Errorf("size %d: got SearchDeltaUint32(%d): %d, expected: %d", size, target, got, expected)
+			}
+		}
+	}
+}
+
+func TestSeekDeltaUint32(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeSortedUint32(r, size)
+		encoded := make([]byte, MaxSize32(size))
+		EncodeDeltaUint32(encoded, data, 0)
+		numControlBytes := (size + 3) >> 2
+		control, rest := encoded[:numControlBytes], encoded[numControlBytes:]
+		for _, target := range searchTargets(r, data) {
+			expected := sort.Search(size, func(i int) bool { return data[i] >= target })
+			if expected < size {
+				expected &^= 3
+			}
+			expectedLast := uint32(0)
+			if expected > 0 {
+				expectedLast = data[expected-1]
+			}
+			expectedDi := dataLen(control[:expected>>2])
+			if expected == size {
+				expectedDi = EncodedLen(size, encoded) - numControlBytes
+			}
+			n, di, last := seekDeltaUint32(control, rest, size, 0, target)
+			if n != expected || di != expectedDi || last != expectedLast {
+				t.Errorf("size %d: got seekDeltaUint32(%d): %d, %d, %d, expected: %d, %d, %d", size, target, n, di, last, expected, expectedDi, expectedLast)
+			}
+		}
+	}
+}
+
+func TestIndexSearch(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeSortedUint32(r, size)
+		encoded := make([]byte, MaxSize32(size))
+		for _, interval := range []int{1, 2, 16} {
+			_, x := EncodeDeltaUint32Indexed(encoded, data, 0, interval)
+			for _, target := range searchTargets(r, data) {
+				expected := sort.Search(size, func(i int) bool { return data[i] >= target })
+				if got := x.Search(target); got != expected {
+					t.Errorf("size %d interval %d: got Search(%d): %d, expected: %d", size, interval, target, got, expected)
+				}
+			}
+		}
+	}
+}
+
+func TestIndexSearchNotDelta(t *testing.T) {
+	data := []uint32{1, 2, 3}
+	encoded := make([]byte, MaxSize32(len(data)))
+	_, x := EncodeUint32Indexed(encoded, data, 1)
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Search on a non-delta Index to panic")
+		}
+	}()
+	x.Search(2)
+}
+
+func BenchmarkSearchDeltaUint32(b *testing.B) {
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = EncodeDeltaUint32(benchEncoded, benchUint32DataSorted, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SearchDeltaUint32(benchEncoded, benchSize, 0, math.MaxUint32)
+	}
+}
+
+func BenchmarkIndexSearch(b *testing.B) {
+	_, x := EncodeDeltaUint32Indexed(benchEncoded, benchUint32DataSorted, 0, 16)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Search(benchUint32DataSorted[i%benchSize])
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"sort"
+)
+
+// DeltaList describes Count nondecreasing uint32, e.g. the document ids of a
+// posting list, encoded by EncodeDeltaUint32 with the initial value Previous.
+type DeltaList struct {
+	Encoded  []byte
+	Count    int
+	Previous uint32
+}
+
+// gallopBlockSize is the number of values decoded at a time from a list that
+// is galloped through, it is small so that few values beyond those sought are
+// decoded.
+const gallopBlockSize = 16
+
+// decode returns the distinct values of the list in increasing order.
+func (l DeltaList) decode() []uint32 {
+	data := make([]uint32, l.Count)
+	DecodeDeltaUint32(data, l.Encoded, l.Previous)
+	return uniqueUint32(data)
+}
+
+// IntersectDeltaUint32 appends the values present in all of lists to dst in
+// increasing order and returns the extended slice.  Lists of similar length
+// are intersected by comparing blocks of values with SIMD instructions where
+// available, while a much longer list is galloped through in its encoded
+// form, decoding only the blocks that may hold the values sought.
+func IntersectDeltaUint32(dst []uint32, lists ...DeltaList) []uint32 {
+	return append(dst, intersectDeltaLists(lists)...)
+}
+
+// UnionDeltaUint32 appends the values present in any of lists to dst in
+// increasing order and returns the extended slice.
+func UnionDeltaUint32(dst []uint32, lists ...DeltaList) []uint32 {
+	return append(dst, unionDeltaLists(lists)...)
+}
+
+// DifferenceDeltaUint32 appends the values of list missing from all of others
+// to dst in increasing order and returns the extended slice.
+func DifferenceDeltaUint32(dst []uint32, list DeltaList, others ...DeltaList) []uint32 {
+	return append(dst, differenceDeltaLists(list, others)...)
+}
+
+// EncodeIntersectDeltaUint32 delta encodes the values present in all of lists
+// into encoded with the initial value 0 and returns the resulting DeltaList.
+// encoded must hold MaxSize32 of the Count of the shortest list.
+func EncodeIntersectDeltaUint32(encoded []byte, lists ...DeltaList) DeltaList {
+	return encodeDeltaList(encoded, intersectDeltaLists(lists))
+}
+
+// EncodeUnionDeltaUint32 delta encodes the values present in any of lists
+// into encoded with the initial value 0 and returns the resulting DeltaList.
+// encoded must hold MaxSize32 of the sum of the Counts of lists.
+func EncodeUnionDeltaUint32(encoded []byte, lists ...DeltaList) DeltaList {
+	return encodeDeltaList(encoded, unionDeltaLists(lists))
+}
+
+// EncodeDifferenceDeltaUint32 delta encodes the values of list missing from
+// all of others into encoded with the initial value 0 and returns the
+// resulting DeltaList.  encoded must hold MaxSize32(list.Count).
+func EncodeDifferenceDeltaUint32(encoded []byte, list DeltaList, others ...DeltaList) DeltaList {
+	return encodeDeltaList(encoded, differenceDeltaLists(list, others))
+}
+
+func encodeDeltaList(encoded []byte, data []uint32) DeltaList {
+	size := EncodeDeltaUint32(encoded, data, 0)
+	return DeltaList{Encoded: encoded[:size], Count: len(data)}
+}
+
+func intersectDeltaLists(lists []DeltaList) []uint32 {
+	if len(lists) == 0 {
+		return nil
+	}
+	// Intersect the shortest lists first to keep the intermediate result small.
+	order := make([]int, len(lists))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return lists[order[i]].Count < lists[order[j]].Count })
+	result := lists[order[0]].decode()
+	scratch := make([]uint32, len(result))
+	for _, i := range order[1:] {
+		if len(result) == 0 {
+			break
+		}
+		l := lists[i]
+		if len(result)*gallopRatio < l.Count {
+			result = result[:intersectDeltaGallop(result, result, l)]
+			continue
+		}
+		n := intersectSorted(scratch, result, l.decode())
+		result, scratch = scratch[:n], result[:cap(result)]
+	}
+	return result
+}
+
+// intersectDeltaGallop writes the values of the strictly increasing small
+// found in l to dst and returns the number of values written.  dst must hold
+// at least len(small) values and may alias small.
+func intersectDeltaGallop(dst, small []uint32, l DeltaList) int {
+	c := newDeltaCursor(l, gallopBlockSize)
+	k := 0
+	for _, v := range small {
+		if !c.seek(v) {
+			break
+		}
+		if c.values[0] == v {
+			dst[k] = v
+			k++
+		}
+	}
+	return k
+}
+
+// unionDeltaLists decodes the lists one after another into a single buffer,
+// then merges adjacent runs pairwise a level at a time, alternating between
+// that buffer and a second one, so each value is copied about log2(len(lists))
+// times.
+func unionDeltaLists(lists []DeltaList) []uint32 {
+	total := 0
+	for _, l := range lists {
+		total += l.Count
+	}
+	buf := make([]uint32, total)
+	// runs holds the boundaries of the sorted runs in buf.
+	runs := make([]int, 1, len(lists)+1)
+	n := 0
+	for _, l := range lists {
+		data := buf[n : n+l.Count]
+		DecodeDeltaUint32(data, l.Encoded, l.Previous)
+		n += len(uniqueUint32(data))
+		runs = append(runs, n)
+	}
+	tmp := make([]uint32, n)
+	for len(runs) > 2 {
+		// Merging runs k and k+1 only overwrites boundaries already read.
+		merged, m := runs[:1], 0
+		for k := 0; k+1 < len(runs); k += 2 {
+			if k+2 < len(runs) {
+				m += unionSorted(tmp[m:], buf[runs[k]:runs[k+1]], buf[runs[k+1]:runs[k+2]])
+			} else {
+				m += copy(tmp[m:], buf[runs[k]:runs[k+1]])
+			}
+			merged = append(merged, m)
+		}
+		runs, buf, tmp = merged, tmp, buf
+	}
+	return buf[:runs[len(runs)-1]]
+}
+
+func differenceDeltaLists(list DeltaList, others []DeltaList) []uint32 {
+	result := list.decode()
+	for _, l := range others {
+		if len(result) == 0 {
+			break
+		}
+		if len(result)*gallopRatio < l.Count {
+			result = result[:differenceDeltaGallop(result, result, l)]
+			continue
+		}
+		result = result[:differenceSorted(result, result, l.decode())]
+	}
+	return result
+}
+
+// differenceDeltaGallop writes the values of the strictly increasing a
+// missing from l to dst and returns the number of values written.  dst must
+// hold at least len(a) values and may alias a.
+func differenceDeltaGallop(dst, a []uint32, l DeltaList) int {
+	c := newDeltaCursor(l, gallopBlockSize)
+	k := 0
+	for i, v := range a {
+		if !c.seek(v) {
+			return k + copy(dst[k:], a[i:])
+		}
+		if c.values[0] != v {
+			dst[k] = v
+			k++
+		}
+	}
+	return k
+}
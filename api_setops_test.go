@@ -0,0 +1,189 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// makePostingList returns size sorted values drawn from [0, universe) with
+// repeats, along with the DeltaList encoding them.
+func makePostingList(r *rand.Rand, size int, universe uint32) ([]uint32, DeltaList) {
+	data := make([]uint32, size)
+	for i := range data {
+		data[i] = uint32(r.Int63n(int64(universe)))
+	}
+	sort.Slice(data, func(i, j int) bool { return data[i] < data[j] })
+	previous := uint32(0)
+	if size > 0 {
+		previous = data[0] / 2
+	}
+	encoded := make([]byte, MaxSize32(size))
+	n := EncodeDeltaUint32(encoded, data, previous)
+	return data, DeltaList{Encoded: encoded[:n], Count: size, Previous: previous}
+}
+
+// expectedSetOp returns the sorted values v for which keep(number of lists
+// containing v, whether the first list contains v) holds.
+func expectedSetOp(lists [][]uint32, keep func(count int, first bool) bool) []uint32 {
+	counts := map[uint32]int{}
+	first := map[uint32]bool{}
+	for i, l := range lists {
+		seen := map[uint32]bool{}
+		for _, v := range l {
+			if !seen[v] {
+				seen[v] = true
+				counts[v]++
+				if i == 0 {
+					first[v] = true
+				}
+			}
+		}
+	}
+	result := []uint32{}
+	for v, c := range counts {
+		if keep(c, first[v]) {
+			result = append(result, v)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+func testSetOps(t *testing.T, r *rand.Rand, sizes []int, universe uint32) {
+	var data [][]uint32
+	var lists []DeltaList
+	for _, size := range sizes {
+		d, l := makePostingList(r, size, universe)
+		data = append(data, d)
+		lists = append(lists, l)
+	}
+	n := len(lists)
+	intersection := expectedSetOp(data, func(c int, first bool) bool { return c == n })
+	union := expectedSetOp(data, func(c int, first bool) bool { return c > 0 })
+	difference := expectedSetOp(data, func(c int, first bool) bool { return first && c == 1 })
+
+	if got := IntersectDeltaUint32([]uint32{}, lists...); !reflect.DeepEqual(got, intersection) {
+		t.Errorf("sizes %v: IntersectDeltaUint32 got %d values, expected %d", sizes, len(got), len(intersection))
+	}
+	if got := UnionDeltaUint32([]uint32{}, lists...); !reflect.DeepEqual(got, union) {
+		t.Errorf("sizes %v: UnionDeltaUint32 got %d values, expected %d", sizes, len(got), len(union))
+	}
+	if got := DifferenceDeltaUint32([]uint32{}, lists[0], lists[1:]...); !reflect.DeepEqual(got, difference) {
+		t.Errorf("sizes %v: DifferenceDeltaUint32 got %d values, expected %d", sizes, len(got), len(difference))
+	}
+
+	total := 0
+	for _, size := range sizes {
+		total += size
+	}
+	encoded := func() []byte { return make([]byte, MaxSize32(total)) }
+	for _, tc := range []struct {
+		name     string
+		list     DeltaList
+		expected []uint32
+	}{
+		{"EncodeIntersectDeltaUint32", EncodeIntersectDeltaUint32(encoded(), lists...), intersection},
+		{"EncodeUnionDeltaUint32", EncodeUnionDeltaUint32(encoded(), lists...), union},
+		{"EncodeDifferenceDeltaUint32", EncodeDifferenceDeltaUint32(encoded(), lists[0], lists[1:]...), difference},
+	} {
+		got := make([]uint32, tc.list.Count)
+		n := DecodeDeltaUint32(got, tc.list.Encoded, tc.list.Previous)
+		if n != len(tc.list.Encoded) || !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("sizes %v: %s got %d values, expected %d", sizes, tc.name, len(got), len(tc.expected))
+		}
+	}
+}
+
+func TestSetOpsDeltaUint32(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, sizes := range [][]int{
+		{0, 0},
+		{0, 100},
+		{1, 1},
+		{100, 100},
+		{1000, 1000, 1000},
+		{10, 10000},
+		{10000, 10, 500},
+		{3, 4099},
+		{50, 100003, 7001},
+		{4, 5, 6, 7},
+		{30, 10, 40, 10, 50, 90, 20, 60, 50},
+	} {
+		for _, universe := range []uint32{16, 1000, 100000, 1 << 31} {
+			testSetOps(t, r, sizes, universe)
+		}
+	}
+}
+
+func TestGallop(t *testing.T) {
+	data := []uint32{1, 3, 5, 7, 9, 11, 13, 15, 17}
+	for target := uint32(0); target < 20; target++ {
+		expected := sort.Search(len(data), func(i int) bool { return data[i] >= target })
+		if got := gallop(data, target); got != expected {
+			t.Errorf("got gallop(%d): %d, expected: %d", target, got, expected)
+		}
+	}
+	if got := gallop(nil, 1); got != 0 {
+		t.Errorf("got gallop of empty data: %d, expected: 0", got)
+	}
+}
+
+func BenchmarkIntersectDeltaUint32(b *testing.B) {
+	r := rand.New(rand.NewSource(42))
+	_, x := makePostingList(r, benchSize, 4*benchSize)
+	_, y := makePostingList(r, benchSize, 4*benchSize)
+	dst := make([]uint32, 0, benchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = IntersectDeltaUint32(dst[:0], x, y)
+	}
+}
+
+func BenchmarkIntersectDeltaUint32Skewed(b *testing.B) {
+	r := rand.New(rand.NewSource(42))
+	_, x := makePostingList(r, benchSize/100, 4*benchSize)
+	_, y := makePostingList(r, benchSize, 4*benchSize)
+	dst := make([]uint32, 0, benchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = IntersectDeltaUint32(dst[:0], x, y)
+	}
+}
+
+func BenchmarkUnionDeltaUint32(b *testing.B) {
+	r := rand.New(rand.NewSource(42))
+	lists := make([]DeltaList, 16)
+	for i := range lists {
+		_, lists[i] = makePostingList(r, benchSize/len(lists), 4*benchSize)
+	}
+	dst := make([]uint32, 0, benchSize)
+	b.SetBytes(int64(4 * benchSize))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = UnionDeltaUint32(dst[:0], lists...)
+	}
+}
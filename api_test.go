@@ -98,7 +98,7 @@ func init() {
 // testRoundTripUint32 tests that encoder and decdoder correctly round trip a slice
 // of inputData of length size.  If width is in (1, 2, 3, 4) the input values
 // will be truncated to that width bytes and the encoded size will be verified.
-func testRoundTripUint32(t *testing.T, encoder func([]byte, []uint32) int, decoder func([]uint32, []byte), data []uint32, expectedSize int) {
+func testRoundTripUint32(t *testing.T, encoder func([]byte, []uint32) int, decoder func([]uint32, []byte) int, data []uint32, expectedSize int) {
 	encodedRaw := make([]byte, MaxSize32(len(data)))
 	encodedSize := encoder(encodedRaw, data)
 	if expectedSize >= 0 && encodedSize != expectedSize {
@@ -107,7 +107,10 @@ func testRoundTripUint32(t *testing.T, encoder func([]byte, []uint32) int, decod
 	encoded := make([]byte, encodedSize, encodedSize) // ensure the encoded size is precise
 	copy(encoded, encodedRaw)
 	decodedData := make([]uint32, len(data), len(data))
-	decoder(decodedData, encoded)
+	decodedSize := decoder(decodedData, encoded)
+	if decodedSize != encodedSize {
+		t.Errorf("got decodedSize: %d, expected: %d", decodedSize, encodedSize)
+	}
 	for i := range data {
 		if decodedData[i] != data[i] {
 			t.Errorf("got decodedData[%d]: %d, expected: %d", i, decodedData[i], data[i])
@@ -118,7 +121,7 @@ func testRoundTripUint32(t *testing.T, encoder func([]byte, []uint32) int, decod
 // testRoundTripInt32 tests that encoder and decdoder correctly round trip a slice
 // of inputData of length size.  If width is in (1, 2, 3, 4) the input values
 // will be truncated to that width bytes and the encoded size will be verified.
-func testRoundTripInt32(t *testing.T, encoder func([]byte, []int32) int, decoder func([]int32, []byte), data []int32, expectedSize int) {
+func testRoundTripInt32(t *testing.T, encoder func([]byte, []int32) int, decoder func([]int32, []byte) int, data []int32, expectedSize int) {
 	encodedRaw := make([]byte, MaxSize32(len(data)))
 	encodedSize := encoder(encodedRaw, data)
 	if expectedSize >= 0 && encodedSize != expectedSize {
@@ -127,7 +130,10 @@ func testRoundTripInt32(t *testing.T, encoder func([]byte, []int32) int, decoder
 	encoded := make([]byte, encodedSize, encodedSize) // ensure the encoded size is precise
 	copy(encoded, encodedRaw)
 	decodedData := make([]int32, len(data), len(data))
-	decoder(decodedData, encoded)
+	decodedSize := decoder(decodedData, encoded)
+	if decodedSize != encodedSize {
+		t.Errorf("got decodedSize: %d, expected: %d", decodedSize, encodedSize)
+	}
 	for i := range data {
 		if decodedData[i] != data[i] {
 			t.Errorf("got decodedData[%d]: %d, expected: %d", i, decodedData[i], data[i])
@@ -153,9 +159,53 @@ func makeUniformDeltaInt32(size int, previous, delta int32) []int32 {
 	return uniformDelta
 }
 
+// makeRandomUint32 returns size uint32 with byte widths chosen uniformly
+// at random so that every control byte is exercised.
+func makeRandomUint32(r *rand.Rand, size int) []uint32 {
+	data := make([]uint32, size, size)
+	for i := range data {
+		data[i] = r.Uint32() >> (8 * uint(r.Intn(4)))
+	}
+	return data
+}
+
+// makeRandomDeltaUint32 returns size uint32 starting from previous with deltas
+// whose byte widths are chosen uniformly at random.
+func makeRandomDeltaUint32(r *rand.Rand, size int, previous uint32) []uint32 {
+	data := make([]uint32, size, size)
+	for i := range data {
+		previous += r.Uint32() >> (8 * uint(r.Intn(4)))
+		data[i] = previous
+	}
+	return data
+}
+
+// makeRandomInt32 returns size int32 whose zigzag encodings have byte widths
+// chosen uniformly at random.
+func makeRandomInt32(r *rand.Rand, size int) []int32 {
+	data := make([]int32, size, size)
+	for i := range data {
+		v := r.Uint32() >> (8 * uint(r.Intn(4)))
+		data[i] = int32((v >> 1) ^ -(v & 1))
+	}
+	return data
+}
+
+// makeRandomDeltaInt32 returns size int32 starting from previous with deltas
+// whose zigzag encodings have byte widths chosen uniformly at random.
+func makeRandomDeltaInt32(r *rand.Rand, size int, previous int32) []int32 {
+	data := make([]int32, size, size)
+	for i := range data {
+		v := r.Uint32() >> (8 * uint(r.Intn(4)))
+		previous += int32((v >> 1) ^ -(v & 1))
+		data[i] = previous
+	}
+	return data
+}
+
 // uint32
 
-func testUniformAndRandomUint32(t *testing.T, encoder func([]byte, []uint32) int, decoder func([]uint32, []byte)) {
+func testUniformAndRandomUint32(t *testing.T, encoder func([]byte, []uint32) int, decoder func([]uint32, []byte) int) {
 	for _, size := range testSizes {
 		expectedSize := (size+3)/4 + size
 		testRoundTripUint32(t, encoder, decoder, oneByteUint32Data[0:size:size], expectedSize)
@@ -169,7 +219,7 @@ func testUniformAndRandomUint32(t *testing.T, encoder func([]byte, []uint32) int
 	}
 }
 
-func testUniformDeltaAndRandomUint32(t *testing.T, encoder func([]byte, []uint32) int, decoder func([]uint32, []byte)) {
+func testUniformDeltaAndRandomUint32(t *testing.T, encoder func([]byte, []uint32) int, decoder func([]uint32, []byte) int) {
 	for _, size := range testSizes {
 		expectedSize := (size+3)/4 + size
 		testRoundTripUint32(t, encoder, decoder, oneByteDeltaUint32Data[0:size:size], expectedSize)
@@ -190,8 +240,8 @@ func TestRoundTripUint32(t *testing.T) {
 func EncodeDeltaUint32Test(encoded []byte, data []uint32) int {
 	return EncodeDeltaUint32(encoded, data, 0)
 }
-func DecodeDeltaUint32Test(data []uint32, encoded []byte) {
-	DecodeDeltaUint32(data, encoded, 0)
+func DecodeDeltaUint32Test(data []uint32, encoded []byte) int {
+	return DecodeDeltaUint32(data, encoded, 0)
 }
 
 func TestRoundTripDeltaUint32(t *testing.T) {
@@ -200,7 +250,7 @@ func TestRoundTripDeltaUint32(t *testing.T) {
 
 // int32
 
-func testUniformAndRandomInt32(t *testing.T, encoder func([]byte, []int32) int, decoder func([]int32, []byte)) {
+func testUniformAndRandomInt32(t *testing.T, encoder func([]byte, []int32) int, decoder func([]int32, []byte) int) {
 	for _, size := range testSizes {
 		expectedSize := (size+3)/4 + size
 		testRoundTripInt32(t, encoder, decoder, oneByteInt32Data[0:size:size], expectedSize)
@@ -214,7 +264,7 @@ func testUniformAndRandomInt32(t *testing.T, encoder func([]byte, []int32) int,
 	}
 }
 
-func testUniformDeltaAndRandomInt32(t *testing.T, encoder func([]byte, []int32) int, decoder func([]int32, []byte)) {
+func testUniformDeltaAndRandomInt32(t *testing.T, encoder func([]byte, []int32) int, decoder func([]int32, []byte) int) {
 	for _, size := range testSizes {
 		expectedSize := (size+3)/4 + size
 		testRoundTripInt32(t, encoder, decoder, oneByteDeltaInt32Data[0:size:size], expectedSize)
@@ -235,14 +285,38 @@ func TestRoundTripInt32(t *testing.T) {
 func EncodeDeltaInt32Test(encoded []byte, data []int32) int {
 	return EncodeDeltaInt32(encoded, data, 0)
 }
-func DecodeDeltaInt32Test(data []int32, encoded []byte) {
-	DecodeDeltaInt32(data, encoded, 0)
+func DecodeDeltaInt32Test(data []int32, encoded []byte) int {
+	return DecodeDeltaInt32(data, encoded, 0)
 }
 
 func TestRoundTripDeltaInt32(t *testing.T) {
 	testUniformDeltaAndRandomInt32(t, EncodeDeltaInt32Test, DecodeDeltaInt32Test)
 }
 
+func TestDecodeConsecutiveBlocks(t *testing.T) {
+	// encode blocks of every test size back to back in a single buffer
+	var encoded []byte
+	for _, size := range testSizes {
+		block := make([]byte, MaxSize32(size))
+		encodedSize := EncodeUint32(block, benchUint32Data[:size])
+		encoded = append(encoded, block[:encodedSize]...)
+	}
+	// walk the blocks using the number of bytes consumed
+	for _, size := range testSizes {
+		decoded := make([]uint32, size)
+		n := DecodeUint32(decoded, encoded)
+		for i := range decoded {
+			if decoded[i] != benchUint32Data[i] {
+				t.Fatalf("size %d: got decodedData[%d]: %d, expected: %d", size, i, decoded[i], benchUint32Data[i])
+			}
+		}
+		encoded = encoded[n:]
+	}
+	if len(encoded) != 0 {
+		t.Errorf("got %d bytes remaining, expected 0", len(encoded))
+	}
+}
+
 func BenchmarkCopy32(b *testing.B) {
 	b.SetBytes(int64(4 * benchSize))
 	dummySink := make([]uint32, benchSize)
@@ -0,0 +1,245 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+)
+
+// The container format is a self-describing frame around Stream VByte encoded
+// data laid out as
+//
+//	magic    [4]byte "SVB\x00"
+//	version  byte
+//	flags    byte
+//	count    uvarint
+//	previous uvarint, only present with FlagDelta
+//	data     Stream VByte encoded count values
+//	checksum [4]byte little endian CRC32C of all preceding bytes, only present with FlagChecksum
+const (
+	containerMagic   = "SVB\x00"
+	containerVersion = 1
+)
+
+// Flags describe the transforms applied to the values in a container.
+type Flags uint8
+
+const (
+	// FlagDelta indicates the values were delta encoded starting from previous.
+	FlagDelta Flags = 1 << iota
+	// FlagZigzag indicates the values are signed and were zigzag encoded.
+	FlagZigzag
+	// FlagChecksum indicates the container ends with a CRC32C checksum.
+	FlagChecksum
+
+	knownFlags = FlagDelta | FlagZigzag | FlagChecksum
+)
+
+var (
+	// ErrInvalidHeader is returned when a container does not start with a valid header.
+	ErrInvalidHeader = errors.New("streamvbyte: invalid container header")
+	// ErrChecksum is returned when the checksum of a container does not match its contents.
+	ErrChecksum = errors.New("streamvbyte: container checksum mismatch")
+	// ErrValueType is returned when unmarshaling a container into the wrong value type.
+	ErrValueType = errors.New("streamvbyte: container holds a different value type")
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// Header describes the contents of a container.
+type Header struct {
+	// Count is the number of encoded values.
+	Count int
+	// Flags are the transforms applied to the values.
+	Flags Flags
+	// Previous is the initial value for delta encoding, for signed values it
+	// holds the bits of the int32.
+	Previous uint32
+	// Size is the length of the header in bytes.
+	Size int
+}
+
+// appendHeader appends the container header for count values to dst.
+func appendHeader(dst []byte, count int, flags Flags, previous uint32) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	dst = append(dst, containerMagic...)
+	dst = append(dst, containerVersion, byte(flags))
+	dst = append(dst, buf[:binary.PutUvarint(buf[:], uint64(count))]...)
+	if flags&FlagDelta != 0 {
+		dst = append(dst, buf[:binary.PutUvarint(buf[:], uint64(previous))]...)
+	}
+	return dst
+}
+
+// ParseHeader parses the header at the start of the container b.
+func ParseHeader(b []byte) (Header, error) {
+	h := Header{}
+	if len(b) < len(containerMagic)+2 || string(b[:len(containerMagic)]) != containerMagic {
+		return h, ErrInvalidHeader
+	}
+	if b[len(containerMagic)] != containerVersion {
+		return h, ErrInvalidHeader
+	}
+	h.Flags = Flags(b[len(containerMagic)+1])
+	if h.Flags&^knownFlags != 0 {
+		return h, ErrInvalidHeader
+	}
+	h.Size = len(containerMagic) + 2
+	count, n := binary.Uvarint(b[h.Size:])
+	if n <= 0 || count > uint64(maxInt) {
+		return h, ErrInvalidHeader
+	}
+	h.Count = int(count)
+	h.Size += n
+	if h.Flags&FlagDelta != 0 {
+		previous, n := binary.Uvarint(b[h.Size:])
+		if n <= 0 || previous > 1<<32-1 {
+			return h, ErrInvalidHeader
+		}
+		h.Previous = uint32(previous)
+		h.Size += n
+	}
+	return h, nil
+}
+
+const maxInt = int(^uint(0) >> 1)
+
+// marshal frames the Stream VByte encoding of count values written by encode.
+func marshal(count int, flags Flags, previous uint32, checksum bool, encode func([]byte) int) []byte {
+	if checksum {
+		flags |= FlagChecksum
+	}
+	b := appendHeader(make([]byte, 0, 16+MaxSize32(count)+crc32.Size), count, flags, previous)
+	headerSize := len(b)
+	b = b[:headerSize+MaxSize32(count)]
+	b = b[:headerSize+encode(b[headerSize:])]
+	if checksum {
+		var sum [crc32.Size]byte
+		binary.LittleEndian.PutUint32(sum[:], crc32.Checksum(b, castagnoli))
+		b = append(b, sum[:]...)
+	}
+	return b
+}
+
+// unmarshal validates the container b holding signed or unsigned values and
+// returns its header along with the Stream VByte encoded data.
+func unmarshal(b []byte, signed bool) (Header, []byte, error) {
+	h, err := ParseHeader(b)
+	if err != nil {
+		return h, nil, err
+	}
+	if (h.Flags&FlagZigzag != 0) != signed {
+		return h, nil, ErrValueType
+	}
+	encoded := b[h.Size:]
+	if h.Flags&FlagChecksum != 0 {
+		if len(encoded) < crc32.Size {
+			return h, nil, ErrTruncated
+		}
+		end := len(b) - crc32.Size
+		if crc32.Checksum(b[:end], castagnoli) != binary.LittleEndian.Uint32(b[end:]) {
+			return h, nil, ErrChecksum
+		}
+		encoded = b[h.Size:end]
+	}
+	// Every value takes at least one data byte, so check before allocating.
+	if h.Count > len(encoded) {
+		return h, nil, ErrTruncated
+	}
+	size, err := checkEncoded(h.Count, encoded)
+	if err != nil {
+		return h, nil, err
+	}
+	if size != len(encoded) {
+		return h, nil, ErrCorrupt
+	}
+	return h, encoded, nil
+}
+
+// MarshalUint32 returns a self-describing container holding data.  If
+// checksum is true a CRC32C of the container is appended.
+func MarshalUint32(data []uint32, checksum bool) []byte {
+	return marshal(len(data), 0, 0, checksum, func(encoded []byte) int {
+		return EncodeUint32(encoded, data)
+	})
+}
+
+// MarshalDeltaUint32 returns a self-describing container holding data
+// delta encoded from the initial value previous.  If checksum is true a
+// CRC32C of the container is appended.
+func MarshalDeltaUint32(data []uint32, previous uint32, checksum bool) []byte {
+	return marshal(len(data), FlagDelta, previous, checksum, func(encoded []byte) int {
+		return EncodeDeltaUint32(encoded, data, previous)
+	})
+}
+
+// MarshalInt32 returns a self-describing container holding data.  If
+// checksum is true a CRC32C of the container is appended.
+func MarshalInt32(data []int32, checksum bool) []byte {
+	return marshal(len(data), FlagZigzag, 0, checksum, func(encoded []byte) int {
+		return EncodeInt32(encoded, data)
+	})
+}
+
+// MarshalDeltaInt32 returns a self-describing container holding data
+// delta encoded from the initial value previous.  If checksum is true a
+// CRC32C of the container is appended.
+func MarshalDeltaInt32(data []int32, previous int32, checksum bool) []byte {
+	return marshal(len(data), FlagDelta|FlagZigzag, uint32(previous), checksum, func(encoded []byte) int {
+		return EncodeDeltaInt32(encoded, data, previous)
+	})
+}
+
+// UnmarshalUint32 decodes the uint32 held in the container b, which
+// may have been created by MarshalUint32 or MarshalDeltaUint32.
+func UnmarshalUint32(b []byte) ([]uint32, error) {
+	h, encoded, err := unmarshal(b, false)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]uint32, h.Count)
+	if h.Flags&FlagDelta != 0 {
+		DecodeDeltaUint32(data, encoded, h.Previous)
+	} else {
+		DecodeUint32(data, encoded)
+	}
+	return data, nil
+}
+
+// UnmarshalInt32 decodes the int32 held in the container b, which
+// may have been created by MarshalInt32 or MarshalDeltaInt32.
+func UnmarshalInt32(b []byte) ([]int32, error) {
+	h, encoded, err := unmarshal(b, true)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]int32, h.Count)
+	if h.Flags&FlagDelta != 0 {
+		DecodeDeltaInt32(data, encoded, int32(h.Previous))
+	} else {
+		DecodeInt32(data, encoded)
+	}
+	return data, nil
+}
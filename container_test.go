@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMarshalUint32(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeRandomDeltaUint32(r, size, 7)
+		for _, checksum := range []bool{false, true} {
+			for _, b := range [][]byte{MarshalUint32(data, checksum), MarshalDeltaUint32(data, 7, checksum)} {
+				decoded, err := UnmarshalUint32(b)
+				if err != nil {
+					t.Fatalf("size %d: got error %v", size, err)
+				}
+				if len(decoded) != len(data) {
+					t.Fatalf("got len %d, expected %d", len(decoded), len(data))
+				}
+				for i := range data {
+					if decoded[i] != data[i] {
+						t.Fatalf("got decodedData[%d]: %d, expected: %d", i, decoded[i], data[i])
+					}
+				}
+				if _, err := UnmarshalInt32(b); err != ErrValueType {
+					t.Errorf("got %v, expected %v", err, ErrValueType)
+				}
+			}
+		}
+	}
+}
+
+func TestMarshalInt32(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeRandomDeltaInt32(r, size, -7)
+		for _, checksum := range []bool{false, true} {
+			for _, b := range [][]byte{MarshalInt32(data, checksum), MarshalDeltaInt32(data, -7, checksum)} {
+				decoded, err := UnmarshalInt32(b)
+				if err != nil {
+					t.Fatalf("size %d: got error %v", size, err)
+				}
+				if len(decoded) != len(data) {
+					t.Fatalf("got len %d, expected %d", len(decoded), len(data))
+				}
+				for i := range data {
+					if decoded[i] != data[i] {
+						t.Fatalf("got decodedData[%d]: %d, expected: %d", i, decoded[i], data[i])
+					}
+				}
+				if _, err := UnmarshalUint32(b); err != ErrValueType {
+					t.Errorf("got %v, expected %v", err, ErrValueType)
+				}
+			}
+		}
+	}
+}
+
+func TestParseHeader(t *testing.T) {
+	b := MarshalDeltaInt32([]int32{1, 2, 3}, -1, true)
+	h, err := ParseHeader(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Count != 3 || h.Flags != FlagDelta|FlagZigzag|FlagChecksum || int32(h.Previous) != -1 {
+		t.Errorf("got header %+v", h)
+	}
+	b[0] = 'X'
+	if _, err := ParseHeader(b); err != ErrInvalidHeader {
+		t.Errorf("got %v, expected %v", err, ErrInvalidHeader)
+	}
+}
+
+func TestUnmarshalChecksum(t *testing.T) {
+	b := MarshalUint32(benchUint32Data[:100], true)
+	for i := len(containerMagic) + 2; i < len(b); i++ {
+		b[i] ^= 0x10
+		if _, err := UnmarshalUint32(b); err == nil {
+			t.Errorf("corrupted byte %d: got nil error", i)
+		}
+		b[i] ^= 0x10
+	}
+	b[len(b)-1] ^= 0x01
+	if _, err := UnmarshalUint32(b); err != ErrChecksum {
+		t.Errorf("got %v, expected %v", err, ErrChecksum)
+	}
+}
+
+func TestUnmarshalTruncated(t *testing.T) {
+	for _, checksum := range []bool{false, true} {
+		b := MarshalDeltaUint32(benchUint32Data[:100], 1, checksum)
+		for end := 0; end < len(b); end++ {
+			if _, err := UnmarshalUint32(b[:end]); err == nil {
+				t.Errorf("truncated to %d: got nil error", end)
+			}
+		}
+		if _, err := UnmarshalUint32(append(b, 0)); err == nil {
+			t.Errorf("trailing byte: got nil error")
+		}
+	}
+}
@@ -1,3 +1,4 @@
+//go:build !amd64
 // +build !amd64
 
 /*
@@ -24,22 +25,30 @@ SOFTWARE.
 
 package streamvbyte
 
-func decodeUint32(data []uint32, encoded []byte) {
-	decodeUint32scalar(data, encoded)
-	return
+func decodeUint32(data []uint32, encoded []byte) int {
+	if activeKernel() >= KernelSWAR {
+		return decodeUint32SWAR(data, encoded)
+	}
+	return decodeUint32scalar(data, encoded)
 }
 
-func decodeDeltaUint32(data []uint32, encoded []byte, previous uint32) {
-	decodeDeltaUint32scalar(data, encoded, previous)
-	return
+func decodeDeltaUint32(data []uint32, encoded []byte, previous uint32) int {
+	if activeKernel() >= KernelSWAR {
+		return decodeDeltaUint32SWAR(data, encoded, previous)
+	}
+	return decodeDeltaUint32scalar(data, encoded, previous)
 }
 
-func decodeInt32(data []int32, encoded []byte) {
-	decodeInt32scalar(data, encoded)
-	return
+func decodeInt32(data []int32, encoded []byte) int {
+	if activeKernel() >= KernelSWAR {
+		return decodeInt32SWAR(data, encoded)
+	}
+	return decodeInt32scalar(data, encoded)
 }
 
-func decodeDeltaInt32(data []int32, encoded []byte, previous int32) {
-	decodeDeltaInt32scalar(data, encoded, previous)
-	return
+func decodeDeltaInt32(data []int32, encoded []byte, previous int32) int {
+	if activeKernel() >= KernelSWAR {
+		return decodeDeltaInt32SWAR(data, encoded, previous)
+	}
+	return decodeDeltaInt32scalar(data, encoded, previous)
 }
@@ -0,0 +1,42 @@
+//go:build !amd64
+// +build !amd64
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+func decodeUint32_0124(data []uint32, encoded []byte) int {
+	return decodeUint32_0124scalar(data, encoded)
+}
+
+func decodeDeltaUint32_0124(data []uint32, encoded []byte, previous uint32) int {
+	return decodeDeltaUint32_0124scalar(data, encoded, previous)
+}
+
+func decodeInt32_0124(data []int32, encoded []byte) int {
+	return decodeInt32_0124scalar(data, encoded)
+}
+
+func decodeDeltaInt32_0124(data []int32, encoded []byte, previous int32) int {
+	return decodeDeltaInt32_0124scalar(data, encoded, previous)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"testing"
+
+	"golang.org/x/sys/cpu"
+)
+
+func TestRoundTripUint32_0124SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	testUniformAndRandomUint32_0124(t, encodeUint32_0124scalar, decodeUint32_0124SSE3)
+}
+
+func TestRoundTripInt32_0124SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	testUniformAndRandomInt32_0124(t, encodeInt32_0124scalar, decodeInt32_0124SSE3)
+}
+
+func TestDifferentialDecode0124SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		uint32Data := makeRandomDeltaUint32(r, size, 7)
+		encoded := make([]byte, MaxSize32(size))
+		encoded = encoded[:encodeDeltaUint32_0124scalar(encoded, uint32Data, 7)]
+		expectedUint32, decodedUint32 := make([]uint32, size), make([]uint32, size)
+		expectedSize := decodeDeltaUint32_0124scalar(expectedUint32, encoded, 7)
+		if decodedSize := decodeDeltaUint32_0124SSE3(decodedUint32, encoded, 7); decodedSize != expectedSize {
+			t.Errorf("got decodedSize: %d, expected: %d", decodedSize, expectedSize)
+		}
+
+		int32Data := makeRandomDeltaInt32(r, size, -7)
+		encoded = encoded[:cap(encoded)]
+		encoded = encoded[:encodeDeltaInt32_0124scalar(encoded, int32Data, -7)]
+		expectedInt32, decodedInt32 := make([]int32, size), make([]int32, size)
+		expectedSize = decodeDeltaInt32_0124scalar(expectedInt32, encoded, -7)
+		if decodedSize := decodeDeltaInt32_0124SSE3(decodedInt32, encoded, -7); decodedSize != expectedSize {
+			t.Errorf("got decodedSize: %d, expected: %d", decodedSize, expectedSize)
+		}
+		for i := range uint32Data {
+			if decodedUint32[i] != expectedUint32[i] || decodedInt32[i] != expectedInt32[i] {
+				t.Fatalf("size %d: mismatch at %d", size, i)
+			}
+		}
+	}
+}
+
+func BenchmarkDecodeUint32_0124SSE3(b *testing.B) {
+	if !cpu.X86.HasSSSE3 {
+		b.Skip("CPU does not support SSSE3 instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = encodeUint32_0124scalar(benchEncoded, benchUint32Data)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeUint32_0124SSE3(benchUint32Data, benchEncoded)
+	}
+}
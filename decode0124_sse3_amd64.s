@@ -0,0 +1,1359 @@
+// Code generated by command: go run gen_decode0124_sse3.go -out decode0124_sse3_amd64.s. DO NOT EDIT.
+
+#include "textflag.h"
+
+DATA dataByteCount0124<>+0(SB)/1, $0x00
+DATA dataByteCount0124<>+1(SB)/1, $0x01
+DATA dataByteCount0124<>+2(SB)/1, $0x02
+DATA dataByteCount0124<>+3(SB)/1, $0x04
+DATA dataByteCount0124<>+4(SB)/1, $0x01
+DATA dataByteCount0124<>+5(SB)/1, $0x02
+DATA dataByteCount0124<>+6(SB)/1, $0x03
+DATA dataByteCount0124<>+7(SB)/1, $0x05
+DATA dataByteCount0124<>+8(SB)/1, $0x02
+DATA dataByteCount0124<>+9(SB)/1, $0x03
+DATA dataByteCount0124<>+10(SB)/1, $0x04
+DATA dataByteCount0124<>+11(SB)/1, $0x06
+DATA dataByteCount0124<>+12(SB)/1, $0x04
+DATA dataByteCount0124<>+13(SB)/1, $0x05
+DATA dataByteCount0124<>+14(SB)/1, $0x06
+DATA dataByteCount0124<>+15(SB)/1, $0x08
+DATA dataByteCount0124<>+16(SB)/1, $0x01
+DATA dataByteCount0124<>+17(SB)/1, $0x02
+DATA dataByteCount0124<>+18(SB)/1, $0x03
+DATA dataByteCount0124<>+19(SB)/1, $0x05
+DATA dataByteCount0124<>+20(SB)/1, $0x02
+DATA dataByteCount0124<>+21(SB)/1, $0x03
+DATA dataByteCount0124<>+22(SB)/1, $0x04
+DATA dataByteCount0124<>+23(SB)/1, $0x06
+DATA dataByteCount0124<>+24(SB)/1, $0x03
+DATA dataByteCount0124<>+25(SB)/1, $0x04
+DATA dataByteCount0124<>+26(SB)/1, $0x05
+DATA dataByteCount0124<>+27(SB)/1, $0x07
+DATA dataByteCount0124<>+28(SB)/1, $0x05
+DATA dataByteCount0124<>+29(SB)/1, $0x06
+DATA dataByteCount0124<>+30(SB)/1, $0x07
+DATA dataByteCount0124<>+31(SB)/1, $0x09
+DATA dataByteCount0124<>+32(SB)/1, $0x02
+DATA dataByteCount0124<>+33(SB)/1, $0x03
+DATA dataByteCount0124<>+34(SB)/1, $0x04
+DATA dataByteCount0124<>+35(SB)/1, $0x06
+DATA dataByteCount0124<>+36(SB)/1, $0x03
+DATA dataByteCount0124<>+37(SB)/1, $0x04
+DATA dataByteCount0124<>+38(SB)/1, $0x05
+DATA dataByteCount0124<>+39(SB)/1, $0x07
+DATA dataByteCount0124<>+40(SB)/1, $0x04
+DATA dataByteCount0124<>+41(SB)/1, $0x05
+DATA dataByteCount0124<>+42(SB)/1, $0x06
+DATA dataByteCount0124<>+43(SB)/1, $0x08
+DATA dataByteCount0124<>+44(SB)/1, $0x06
+DATA dataByteCount0124<>+45(SB)/1, $0x07
+DATA dataByteCount0124<>+46(SB)/1, $0x08
+DATA dataByteCount0124<>+47(SB)/1, $0x0a
+DATA dataByteCount0124<>+48(SB)/1, $0x04
+DATA dataByteCount0124<>+49(SB)/1, $0x05
+DATA dataByteCount0124<>+50(SB)/1, $0x06
+DATA dataByteCount0124<>+51(SB)/1, $0x08
+DATA dataByteCount0124<>+52(SB)/1, $0x05
+DATA dataByteCount0124<>+53(SB)/1, $0x06
+DATA dataByteCount0124<>+54(SB)/1, $0x07
+DATA dataByteCount0124<>+55(SB)/1, $0x09
+DATA dataByteCount0124<>+56(SB)/1, $0x06
+DATA dataByteCount0124<>+57(SB)/1, $0x07
+DATA dataByteCount0124<>+58(SB)/1, $0x08
+DATA dataByteCount0124<>+59(SB)/1, $0x0a
+DATA dataByteCount0124<>+60(SB)/1, $0x08
+DATA dataByteCount0124<>+61(SB)/1, $0x09
+DATA dataByteCount0124<>+62(SB)/1, $0x0a
+DATA dataByteCount0124<>+63(SB)/1, $0x0c
+DATA dataByteCount0124<>+64(SB)/1, $0x01
+DATA dataByteCount0124<>+65(SB)/1, $0x02
+DATA dataByteCount0124<>+66(SB)/1, $0x03
+DATA dataByteCount0124<>+67(SB)/1, $0x05
+DATA dataByteCount0124<>+68(SB)/1, $0x02
+DATA dataByteCount0124<>+69(SB)/1, $0x03
+DATA dataByteCount0124<>+70(SB)/1, $0x04
+DATA dataByteCount0124<>+71(SB)/1, $0x06
+DATA dataByteCount0124<>+72(SB)/1, $0x03
+DATA dataByteCount0124<>+73(SB)/1, $0x04
+DATA dataByteCount0124<>+74(SB)/1, $0x05
+DATA dataByteCount0124<>+75(SB)/1, $0x07
+DATA dataByteCount0124<>+76(SB)/1, $0x05
+DATA dataByteCount0124<>+77(SB)/1, $0x06
+DATA dataByteCount0124<>+78(SB)/1, $0x07
+DATA dataByteCount0124<>+79(SB)/1, $0x09
+DATA dataByteCount0124<>+80(SB)/1, $0x02
+DATA dataByteCount0124<>+81(SB)/1, $0x03
+DATA dataByteCount0124<>+82(SB)/1, $0x04
+DATA dataByteCount0124<>+83(SB)/1, $0x06
+DATA dataByteCount0124<>+84(SB)/1, $0x03
+DATA dataByteCount0124<>+85(SB)/1, $0x04
+DATA dataByteCount0124<>+86(SB)/1, $0x05
+DATA dataByteCount0124<>+87(SB)/1, $0x07
+DATA dataByteCount0124<>+88(SB)/1, $0x04
+DATA dataByteCount0124<>+89(SB)/1, $0x05
+DATA dataByteCount0124<>+90(SB)/1, $0x06
+DATA dataByteCount0124<>+91(SB)/1, $0x08
+DATA dataByteCount0124<>+92(SB)/1, $0x06
+DATA dataByteCount0124<>+93(SB)/1, $0x07
+DATA dataByteCount0124<>+94(SB)/1, $0x08
+DATA dataByteCount0124<>+95(SB)/1, $0x0a
+DATA dataByteCount0124<>+96(SB)/1, $0x03
+DATA dataByteCount0124<>+97(SB)/1, $0x04
+DATA dataByteCount0124<>+98(SB)/1, $0x05
+DATA dataByteCount0124<>+99(SB)/1, $0x07
+DATA dataByteCount0124<>+100(SB)/1, $0x04
+DATA dataByteCount0124<>+101(SB)/1, $0x05
+DATA dataByteCount0124<>+102(SB)/1, $0x06
+DATA dataByteCount0124<>+103(SB)/1, $0x08
+DATA dataByteCount0124<>+104(SB)/1, $0x05
+DATA dataByteCount0124<>+105(SB)/1, $0x06
+DATA dataByteCount0124<>+106(SB)/1, $0x07
+DATA dataByteCount0124<>+107(SB)/1, $0x09
+DATA dataByteCount0124<>+108(SB)/1, $0x07
+DATA dataByteCount0124<>+109(SB)/1, $0x08
+DATA dataByteCount0124<>+110(SB)/1, $0x09
+DATA dataByteCount0124<>+111(SB)/1, $0x0b
+DATA dataByteCount0124<>+112(SB)/1, $0x05
+DATA dataByteCount0124<>+113(SB)/1, $0x06
+DATA dataByteCount0124<>+114(SB)/1, $0x07
+DATA dataByteCount0124<>+115(SB)/1, $0x09
+DATA dataByteCount0124<>+116(SB)/1, $0x06
+DATA dataByteCount0124<>+117(SB)/1, $0x07
+DATA dataByteCount0124<>+118(SB)/1, $0x08
+DATA dataByteCount0124<>+119(SB)/1, $0x0a
+DATA dataByteCount0124<>+120(SB)/1, $0x07
+DATA dataByteCount0124<>+121(SB)/1, $0x08
+DATA dataByteCount0124<>+122(SB)/1, $0x09
+DATA dataByteCount0124<>+123(SB)/1, $0x0b
+DATA dataByteCount0124<>+124(SB)/1, $0x09
+DATA dataByteCount0124<>+125(SB)/1, $0x0a
+DATA dataByteCount0124<>+126(SB)/1, $0x0b
+DATA dataByteCount0124<>+127(SB)/1, $0x0d
+DATA dataByteCount0124<>+128(SB)/1, $0x02
+DATA dataByteCount0124<>+129(SB)/1, $0x03
+DATA dataByteCount0124<>+130(SB)/1, $0x04
+DATA dataByteCount0124<>+131(SB)/1, $0x06
+DATA dataByteCount0124<>+132(SB)/1, $0x03
+DATA dataByteCount0124<>+133(SB)/1, $0x04
+DATA dataByteCount0124<>+134(SB)/1, $0x05
+DATA dataByteCount0124<>+135(SB)/1, $0x07
+DATA dataByteCount0124<>+136(SB)/1, $0x04
+DATA dataByteCount0124<>+137(SB)/1, $0x05
+DATA dataByteCount0124<>+138(SB)/1, $0x06
+DATA dataByteCount0124<>+139(SB)/1, $0x08
+DATA dataByteCount0124<>+140(SB)/1, $0x06
+DATA dataByteCount0124<>+141(SB)/1, $0x07
+DATA dataByteCount0124<>+142(SB)/1, $0x08
+DATA dataByteCount0124<>+143(SB)/1, $0x0a
+DATA dataByteCount0124<>+144(SB)/1, $0x03
+DATA dataByteCount0124<>+145(SB)/1, $0x04
+DATA dataByteCount0124<>+146(SB)/1, $0x05
+DATA dataByteCount0124<>+147(SB)/1, $0x07
+DATA dataByteCount0124<>+148(SB)/1, $0x04
+DATA dataByteCount0124<>+149(SB)/1, $0x05
+DATA dataByteCount0124<>+150(SB)/1, $0x06
+DATA dataByteCount0124<>+151(SB)/1, $0x08
+DATA dataByteCount0124<>+152(SB)/1, $0x05
+DATA dataByteCount0124<>+153(SB)/1, $0x06
+DATA dataByteCount0124<>+154(SB)/1, $0x07
+DATA dataByteCount0124<>+155(SB)/1, $0x09
+DATA dataByteCount0124<>+156(SB)/1, $0x07
+DATA dataByteCount0124<>+157(SB)/1, $0x08
+DATA dataByteCount0124<>+158(SB)/1, $0x09
+DATA dataByteCount0124<>+159(SB)/1, $0x0b
+DATA dataByteCount0124<>+160(SB)/1, $0x04
+DATA dataByteCount0124<>+161(SB)/1, $0x05
+DATA dataByteCount0124<>+162(SB)/1, $0x06
+DATA dataByteCount0124<>+163(SB)/1, $0x08
+DATA dataByteCount0124<>+164(SB)/1, $0x05
+DATA dataByteCount0124<>+165(SB)/1, $0x06
+DATA dataByteCount0124<>+166(SB)/1, $0x07
+DATA dataByteCount0124<>+167(SB)/1, $0x09
+DATA dataByteCount0124<>+168(SB)/1, $0x06
+DATA dataByteCount0124<>+169(SB)/1, $0x07
+DATA dataByteCount0124<>+170(SB)/1, $0x08
+DATA dataByteCount0124<>+171(SB)/1, $0x0a
+DATA dataByteCount0124<>+172(SB)/1, $0x08
+DATA dataByteCount0124<>+173(SB)/1, $0x09
+DATA dataByteCount0124<>+174(SB)/1, $0x0a
+DATA dataByteCount0124<>+175(SB)/1, $0x0c
+DATA dataByteCount0124<>+176(SB)/1, $0x06
+DATA dataByteCount0124<>+177(SB)/1, $0x07
+DATA dataByteCount0124<>+178(SB)/1, $0x08
+DATA dataByteCount0124<>+179(SB)/1, $0x0a
+DATA dataByteCount0124<>+180(SB)/1, $0x07
+DATA dataByteCount0124<>+181(SB)/1, $0x08
+DATA dataByteCount0124<>+182(SB)/1, $0x09
+DATA dataByteCount0124<>+183(SB)/1, $0x0b
+DATA dataByteCount0124<>+184(SB)/1, $0x08
+DATA dataByteCount0124<>+185(SB)/1, $0x09
+DATA dataByteCount0124<>+186(SB)/1, $0x0a
+DATA dataByteCount0124<>+187(SB)/1, $0x0c
+DATA dataByteCount0124<>+188(SB)/1, $0x0a
+DATA dataByteCount0124<>+189(SB)/1, $0x0b
+DATA dataByteCount0124<>+190(SB)/1, $0x0c
+DATA dataByteCount0124<>+191(SB)/1, $0x0e
+DATA dataByteCount0124<>+192(SB)/1, $0x04
+DATA dataByteCount0124<>+193(SB)/1, $0x05
+DATA dataByteCount0124<>+194(SB)/1, $0x06
+DATA dataByteCount0124<>+195(SB)/1, $0x08
+DATA dataByteCount0124<>+196(SB)/1, $0x05
+DATA dataByteCount0124<>+197(SB)/1, $0x06
+DATA dataByteCount0124<>+198(SB)/1, $0x07
+DATA dataByteCount0124<>+199(SB)/1, $0x09
+DATA dataByteCount0124<>+200(SB)/1, $0x06
+DATA dataByteCount0124<>+201(SB)/1, $0x07
+DATA dataByteCount0124<>+202(SB)/1, $0x08
+DATA dataByteCount0124<>+203(SB)/1, $0x0a
+DATA dataByteCount0124<>+204(SB)/1, $0x08
+DATA dataByteCount0124<>+205(SB)/1, $0x09
+DATA dataByteCount0124<>+206(SB)/1, $0x0a
+DATA dataByteCount0124<>+207(SB)/1, $0x0c
+DATA dataByteCount0124<>+208(SB)/1, $0x05
+DATA dataByteCount0124<>+209(SB)/1, $0x06
+DATA dataByteCount0124<>+210(SB)/1, $0x07
+DATA dataByteCount0124<>+211(SB)/1, $0x09
+DATA dataByteCount0124<>+212(SB)/1, $0x06
+DATA dataByteCount0124<>+213(SB)/1, $0x07
+DATA dataByteCount0124<>+214(SB)/1, $0x08
+DATA dataByteCount0124<>+215(SB)/1, $0x0a
+DATA dataByteCount0124<>+216(SB)/1, $0x07
+DATA dataByteCount0124<>+217(SB)/1, $0x08
+DATA dataByteCount0124<>+218(SB)/1, $0x09
+DATA dataByteCount0124<>+219(SB)/1, $0x0b
+DATA dataByteCount0124<>+220(SB)/1, $0x09
+DATA dataByteCount0124<>+221(SB)/1, $0x0a
+DATA dataByteCount0124<>+222(SB)/1, $0x0b
+DATA dataByteCount0124<>+223(SB)/1, $0x0d
+DATA dataByteCount0124<>+224(SB)/1, $0x06
+DATA dataByteCount0124<>+225(SB)/1, $0x07
+DATA dataByteCount0124<>+226(SB)/1, $0x08
+DATA dataByteCount0124<>+227(SB)/1, $0x0a
+DATA dataByteCount0124<>+228(SB)/1, $0x07
+DATA dataByteCount0124<>+229(SB)/1, $0x08
+DATA dataByteCount0124<>+230(SB)/1, $0x09
+DATA dataByteCount0124<>+231(SB)/1, $0x0b
+DATA dataByteCount0124<>+232(SB)/1, $0x08
+DATA dataByteCount0124<>+233(SB)/1, $0x09
+DATA dataByteCount0124<>+234(SB)/1, $0x0a
+DATA dataByteCount0124<>+235(SB)/1, $0x0c
+DATA dataByteCount0124<>+236(SB)/1, $0x0a
+DATA dataByteCount0124<>+237(SB)/1, $0x0b
+DATA dataByteCount0124<>+238(SB)/1, $0x0c
+DATA dataByteCount0124<>+239(SB)/1, $0x0e
+DATA dataByteCount0124<>+240(SB)/1, $0x08
+DATA dataByteCount0124<>+241(SB)/1, $0x09
+DATA dataByteCount0124<>+242(SB)/1, $0x0a
+DATA dataByteCount0124<>+243(SB)/1, $0x0c
+DATA dataByteCount0124<>+244(SB)/1, $0x09
+DATA dataByteCount0124<>+245(SB)/1, $0x0a
+DATA dataByteCount0124<>+246(SB)/1, $0x0b
+DATA dataByteCount0124<>+247(SB)/1, $0x0d
+DATA dataByteCount0124<>+248(SB)/1, $0x0a
+DATA dataByteCount0124<>+249(SB)/1, $0x0b
+DATA dataByteCount0124<>+250(SB)/1, $0x0c
+DATA dataByteCount0124<>+251(SB)/1, $0x0e
+DATA dataByteCount0124<>+252(SB)/1, $0x0c
+DATA dataByteCount0124<>+253(SB)/1, $0x0d
+DATA dataByteCount0124<>+254(SB)/1, $0x0e
+DATA dataByteCount0124<>+255(SB)/1, $0x10
+GLOBL dataByteCount0124<>(SB), RODATA|NOPTR, $256
+
+DATA dataByteMask0124<>+0(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+8(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+16(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+24(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+32(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+40(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+48(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+56(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+64(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+72(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+80(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+88(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+96(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+104(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+112(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+120(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+128(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+136(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+144(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+152(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+160(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+168(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+176(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+184(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+192(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+200(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+208(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+216(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+224(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+232(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+240(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+248(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+256(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+264(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+272(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+280(SB)/8, $0xffffffffffffff01
+DATA dataByteMask0124<>+288(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+296(SB)/8, $0xffffffffffffff02
+DATA dataByteMask0124<>+304(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+312(SB)/8, $0xffffffffffffff04
+DATA dataByteMask0124<>+320(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+328(SB)/8, $0xffffffffffffff01
+DATA dataByteMask0124<>+336(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+344(SB)/8, $0xffffffffffffff02
+DATA dataByteMask0124<>+352(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+360(SB)/8, $0xffffffffffffff03
+DATA dataByteMask0124<>+368(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+376(SB)/8, $0xffffffffffffff05
+DATA dataByteMask0124<>+384(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+392(SB)/8, $0xffffffffffffff02
+DATA dataByteMask0124<>+400(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+408(SB)/8, $0xffffffffffffff03
+DATA dataByteMask0124<>+416(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+424(SB)/8, $0xffffffffffffff04
+DATA dataByteMask0124<>+432(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+440(SB)/8, $0xffffffffffffff06
+DATA dataByteMask0124<>+448(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+456(SB)/8, $0xffffffffffffff04
+DATA dataByteMask0124<>+464(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+472(SB)/8, $0xffffffffffffff05
+DATA dataByteMask0124<>+480(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+488(SB)/8, $0xffffffffffffff06
+DATA dataByteMask0124<>+496(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+504(SB)/8, $0xffffffffffffff08
+DATA dataByteMask0124<>+512(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+520(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+528(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+536(SB)/8, $0xffffffffffff0201
+DATA dataByteMask0124<>+544(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+552(SB)/8, $0xffffffffffff0302
+DATA dataByteMask0124<>+560(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+568(SB)/8, $0xffffffffffff0504
+DATA dataByteMask0124<>+576(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+584(SB)/8, $0xffffffffffff0201
+DATA dataByteMask0124<>+592(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+600(SB)/8, $0xffffffffffff0302
+DATA dataByteMask0124<>+608(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+616(SB)/8, $0xffffffffffff0403
+DATA dataByteMask0124<>+624(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+632(SB)/8, $0xffffffffffff0605
+DATA dataByteMask0124<>+640(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+648(SB)/8, $0xffffffffffff0302
+DATA dataByteMask0124<>+656(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+664(SB)/8, $0xffffffffffff0403
+DATA dataByteMask0124<>+672(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+680(SB)/8, $0xffffffffffff0504
+DATA dataByteMask0124<>+688(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+696(SB)/8, $0xffffffffffff0706
+DATA dataByteMask0124<>+704(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+712(SB)/8, $0xffffffffffff0504
+DATA dataByteMask0124<>+720(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+728(SB)/8, $0xffffffffffff0605
+DATA dataByteMask0124<>+736(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+744(SB)/8, $0xffffffffffff0706
+DATA dataByteMask0124<>+752(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+760(SB)/8, $0xffffffffffff0908
+DATA dataByteMask0124<>+768(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+776(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+784(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+792(SB)/8, $0xffffffff04030201
+DATA dataByteMask0124<>+800(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+808(SB)/8, $0xffffffff05040302
+DATA dataByteMask0124<>+816(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+824(SB)/8, $0xffffffff07060504
+DATA dataByteMask0124<>+832(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+840(SB)/8, $0xffffffff04030201
+DATA dataByteMask0124<>+848(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+856(SB)/8, $0xffffffff05040302
+DATA dataByteMask0124<>+864(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+872(SB)/8, $0xffffffff06050403
+DATA dataByteMask0124<>+880(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+888(SB)/8, $0xffffffff08070605
+DATA dataByteMask0124<>+896(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+904(SB)/8, $0xffffffff05040302
+DATA dataByteMask0124<>+912(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+920(SB)/8, $0xffffffff06050403
+DATA dataByteMask0124<>+928(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+936(SB)/8, $0xffffffff07060504
+DATA dataByteMask0124<>+944(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+952(SB)/8, $0xffffffff09080706
+DATA dataByteMask0124<>+960(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+968(SB)/8, $0xffffffff07060504
+DATA dataByteMask0124<>+976(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+984(SB)/8, $0xffffffff08070605
+DATA dataByteMask0124<>+992(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+1000(SB)/8, $0xffffffff09080706
+DATA dataByteMask0124<>+1008(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+1016(SB)/8, $0xffffffff0b0a0908
+DATA dataByteMask0124<>+1024(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+1032(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+1040(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+1048(SB)/8, $0xffffff01ffffffff
+DATA dataByteMask0124<>+1056(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+1064(SB)/8, $0xffffff02ffffffff
+DATA dataByteMask0124<>+1072(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+1080(SB)/8, $0xffffff04ffffffff
+DATA dataByteMask0124<>+1088(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+1096(SB)/8, $0xffffff01ffffffff
+DATA dataByteMask0124<>+1104(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+1112(SB)/8, $0xffffff02ffffffff
+DATA dataByteMask0124<>+1120(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+1128(SB)/8, $0xffffff03ffffffff
+DATA dataByteMask0124<>+1136(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+1144(SB)/8, $0xffffff05ffffffff
+DATA dataByteMask0124<>+1152(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+1160(SB)/8, $0xffffff02ffffffff
+DATA dataByteMask0124<>+1168(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+1176(SB)/8, $0xffffff03ffffffff
+DATA dataByteMask0124<>+1184(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+1192(SB)/8, $0xffffff04ffffffff
+DATA dataByteMask0124<>+1200(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+1208(SB)/8, $0xffffff06ffffffff
+DATA dataByteMask0124<>+1216(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+1224(SB)/8, $0xffffff04ffffffff
+DATA dataByteMask0124<>+1232(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+1240(SB)/8, $0xffffff05ffffffff
+DATA dataByteMask0124<>+1248(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+1256(SB)/8, $0xffffff06ffffffff
+DATA dataByteMask0124<>+1264(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+1272(SB)/8, $0xffffff08ffffffff
+DATA dataByteMask0124<>+1280(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+1288(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+1296(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+1304(SB)/8, $0xffffff02ffffff01
+DATA dataByteMask0124<>+1312(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+1320(SB)/8, $0xffffff03ffffff02
+DATA dataByteMask0124<>+1328(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+1336(SB)/8, $0xffffff05ffffff04
+DATA dataByteMask0124<>+1344(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+1352(SB)/8, $0xffffff02ffffff01
+DATA dataByteMask0124<>+1360(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+1368(SB)/8, $0xffffff03ffffff02
+DATA dataByteMask0124<>+1376(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+1384(SB)/8, $0xffffff04ffffff03
+DATA dataByteMask0124<>+1392(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+1400(SB)/8, $0xffffff06ffffff05
+DATA dataByteMask0124<>+1408(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+1416(SB)/8, $0xffffff03ffffff02
+DATA dataByteMask0124<>+1424(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+1432(SB)/8, $0xffffff04ffffff03
+DATA dataByteMask0124<>+1440(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+1448(SB)/8, $0xffffff05ffffff04
+DATA dataByteMask0124<>+1456(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+1464(SB)/8, $0xffffff07ffffff06
+DATA dataByteMask0124<>+1472(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+1480(SB)/8, $0xffffff05ffffff04
+DATA dataByteMask0124<>+1488(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+1496(SB)/8, $0xffffff06ffffff05
+DATA dataByteMask0124<>+1504(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+1512(SB)/8, $0xffffff07ffffff06
+DATA dataByteMask0124<>+1520(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+1528(SB)/8, $0xffffff09ffffff08
+DATA dataByteMask0124<>+1536(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+1544(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+1552(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+1560(SB)/8, $0xffffff03ffff0201
+DATA dataByteMask0124<>+1568(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+1576(SB)/8, $0xffffff04ffff0302
+DATA dataByteMask0124<>+1584(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+1592(SB)/8, $0xffffff06ffff0504
+DATA dataByteMask0124<>+1600(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+1608(SB)/8, $0xffffff03ffff0201
+DATA dataByteMask0124<>+1616(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+1624(SB)/8, $0xffffff04ffff0302
+DATA dataByteMask0124<>+1632(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+1640(SB)/8, $0xffffff05ffff0403
+DATA dataByteMask0124<>+1648(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+1656(SB)/8, $0xffffff07ffff0605
+DATA dataByteMask0124<>+1664(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+1672(SB)/8, $0xffffff04ffff0302
+DATA dataByteMask0124<>+1680(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+1688(SB)/8, $0xffffff05ffff0403
+DATA dataByteMask0124<>+1696(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+1704(SB)/8, $0xffffff06ffff0504
+DATA dataByteMask0124<>+1712(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+1720(SB)/8, $0xffffff08ffff0706
+DATA dataByteMask0124<>+1728(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+1736(SB)/8, $0xffffff06ffff0504
+DATA dataByteMask0124<>+1744(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+1752(SB)/8, $0xffffff07ffff0605
+DATA dataByteMask0124<>+1760(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+1768(SB)/8, $0xffffff08ffff0706
+DATA dataByteMask0124<>+1776(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+1784(SB)/8, $0xffffff0affff0908
+DATA dataByteMask0124<>+1792(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+1800(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+1808(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+1816(SB)/8, $0xffffff0504030201
+DATA dataByteMask0124<>+1824(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+1832(SB)/8, $0xffffff0605040302
+DATA dataByteMask0124<>+1840(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+1848(SB)/8, $0xffffff0807060504
+DATA dataByteMask0124<>+1856(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+1864(SB)/8, $0xffffff0504030201
+DATA dataByteMask0124<>+1872(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+1880(SB)/8, $0xffffff0605040302
+DATA dataByteMask0124<>+1888(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+1896(SB)/8, $0xffffff0706050403
+DATA dataByteMask0124<>+1904(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+1912(SB)/8, $0xffffff0908070605
+DATA dataByteMask0124<>+1920(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+1928(SB)/8, $0xffffff0605040302
+DATA dataByteMask0124<>+1936(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+1944(SB)/8, $0xffffff0706050403
+DATA dataByteMask0124<>+1952(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+1960(SB)/8, $0xffffff0807060504
+DATA dataByteMask0124<>+1968(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+1976(SB)/8, $0xffffff0a09080706
+DATA dataByteMask0124<>+1984(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+1992(SB)/8, $0xffffff0807060504
+DATA dataByteMask0124<>+2000(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+2008(SB)/8, $0xffffff0908070605
+DATA dataByteMask0124<>+2016(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+2024(SB)/8, $0xffffff0a09080706
+DATA dataByteMask0124<>+2032(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+2040(SB)/8, $0xffffff0c0b0a0908
+DATA dataByteMask0124<>+2048(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+2056(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+2064(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+2072(SB)/8, $0xffff0201ffffffff
+DATA dataByteMask0124<>+2080(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+2088(SB)/8, $0xffff0302ffffffff
+DATA dataByteMask0124<>+2096(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+2104(SB)/8, $0xffff0504ffffffff
+DATA dataByteMask0124<>+2112(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+2120(SB)/8, $0xffff0201ffffffff
+DATA dataByteMask0124<>+2128(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+2136(SB)/8, $0xffff0302ffffffff
+DATA dataByteMask0124<>+2144(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+2152(SB)/8, $0xffff0403ffffffff
+DATA dataByteMask0124<>+2160(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+2168(SB)/8, $0xffff0605ffffffff
+DATA dataByteMask0124<>+2176(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+2184(SB)/8, $0xffff0302ffffffff
+DATA dataByteMask0124<>+2192(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+2200(SB)/8, $0xffff0403ffffffff
+DATA dataByteMask0124<>+2208(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+2216(SB)/8, $0xffff0504ffffffff
+DATA dataByteMask0124<>+2224(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+2232(SB)/8, $0xffff0706ffffffff
+DATA dataByteMask0124<>+2240(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+2248(SB)/8, $0xffff0504ffffffff
+DATA dataByteMask0124<>+2256(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+2264(SB)/8, $0xffff0605ffffffff
+DATA dataByteMask0124<>+2272(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+2280(SB)/8, $0xffff0706ffffffff
+DATA dataByteMask0124<>+2288(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+2296(SB)/8, $0xffff0908ffffffff
+DATA dataByteMask0124<>+2304(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+2312(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+2320(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+2328(SB)/8, $0xffff0302ffffff01
+DATA dataByteMask0124<>+2336(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+2344(SB)/8, $0xffff0403ffffff02
+DATA dataByteMask0124<>+2352(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+2360(SB)/8, $0xffff0605ffffff04
+DATA dataByteMask0124<>+2368(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+2376(SB)/8, $0xffff0302ffffff01
+DATA dataByteMask0124<>+2384(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+2392(SB)/8, $0xffff0403ffffff02
+DATA dataByteMask0124<>+2400(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+2408(SB)/8, $0xffff0504ffffff03
+DATA dataByteMask0124<>+2416(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+2424(SB)/8, $0xffff0706ffffff05
+DATA dataByteMask0124<>+2432(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+2440(SB)/8, $0xffff0403ffffff02
+DATA dataByteMask0124<>+2448(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+2456(SB)/8, $0xffff0504ffffff03
+DATA dataByteMask0124<>+2464(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+2472(SB)/8, $0xffff0605ffffff04
+DATA dataByteMask0124<>+2480(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+2488(SB)/8, $0xffff0807ffffff06
+DATA dataByteMask0124<>+2496(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+2504(SB)/8, $0xffff0605ffffff04
+DATA dataByteMask0124<>+2512(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+2520(SB)/8, $0xffff0706ffffff05
+DATA dataByteMask0124<>+2528(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+2536(SB)/8, $0xffff0807ffffff06
+DATA dataByteMask0124<>+2544(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+2552(SB)/8, $0xffff0a09ffffff08
+DATA dataByteMask0124<>+2560(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+2568(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+2576(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+2584(SB)/8, $0xffff0403ffff0201
+DATA dataByteMask0124<>+2592(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+2600(SB)/8, $0xffff0504ffff0302
+DATA dataByteMask0124<>+2608(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+2616(SB)/8, $0xffff0706ffff0504
+DATA dataByteMask0124<>+2624(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+2632(SB)/8, $0xffff0403ffff0201
+DATA dataByteMask0124<>+2640(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+2648(SB)/8, $0xffff0504ffff0302
+DATA dataByteMask0124<>+2656(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+2664(SB)/8, $0xffff0605ffff0403
+DATA dataByteMask0124<>+2672(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+2680(SB)/8, $0xffff0807ffff0605
+DATA dataByteMask0124<>+2688(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+2696(SB)/8, $0xffff0504ffff0302
+DATA dataByteMask0124<>+2704(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+2712(SB)/8, $0xffff0605ffff0403
+DATA dataByteMask0124<>+2720(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+2728(SB)/8, $0xffff0706ffff0504
+DATA dataByteMask0124<>+2736(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+2744(SB)/8, $0xffff0908ffff0706
+DATA dataByteMask0124<>+2752(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+2760(SB)/8, $0xffff0706ffff0504
+DATA dataByteMask0124<>+2768(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+2776(SB)/8, $0xffff0807ffff0605
+DATA dataByteMask0124<>+2784(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+2792(SB)/8, $0xffff0908ffff0706
+DATA dataByteMask0124<>+2800(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+2808(SB)/8, $0xffff0b0affff0908
+DATA dataByteMask0124<>+2816(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+2824(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+2832(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+2840(SB)/8, $0xffff060504030201
+DATA dataByteMask0124<>+2848(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+2856(SB)/8, $0xffff070605040302
+DATA dataByteMask0124<>+2864(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+2872(SB)/8, $0xffff090807060504
+DATA dataByteMask0124<>+2880(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+2888(SB)/8, $0xffff060504030201
+DATA dataByteMask0124<>+2896(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+2904(SB)/8, $0xffff070605040302
+DATA dataByteMask0124<>+2912(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+2920(SB)/8, $0xffff080706050403
+DATA dataByteMask0124<>+2928(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+2936(SB)/8, $0xffff0a0908070605
+DATA dataByteMask0124<>+2944(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+2952(SB)/8, $0xffff070605040302
+DATA dataByteMask0124<>+2960(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+2968(SB)/8, $0xffff080706050403
+DATA dataByteMask0124<>+2976(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+2984(SB)/8, $0xffff090807060504
+DATA dataByteMask0124<>+2992(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+3000(SB)/8, $0xffff0b0a09080706
+DATA dataByteMask0124<>+3008(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+3016(SB)/8, $0xffff090807060504
+DATA dataByteMask0124<>+3024(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+3032(SB)/8, $0xffff0a0908070605
+DATA dataByteMask0124<>+3040(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+3048(SB)/8, $0xffff0b0a09080706
+DATA dataByteMask0124<>+3056(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+3064(SB)/8, $0xffff0d0c0b0a0908
+DATA dataByteMask0124<>+3072(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+3080(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+3088(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+3096(SB)/8, $0x04030201ffffffff
+DATA dataByteMask0124<>+3104(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+3112(SB)/8, $0x05040302ffffffff
+DATA dataByteMask0124<>+3120(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+3128(SB)/8, $0x07060504ffffffff
+DATA dataByteMask0124<>+3136(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+3144(SB)/8, $0x04030201ffffffff
+DATA dataByteMask0124<>+3152(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+3160(SB)/8, $0x05040302ffffffff
+DATA dataByteMask0124<>+3168(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+3176(SB)/8, $0x06050403ffffffff
+DATA dataByteMask0124<>+3184(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+3192(SB)/8, $0x08070605ffffffff
+DATA dataByteMask0124<>+3200(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+3208(SB)/8, $0x05040302ffffffff
+DATA dataByteMask0124<>+3216(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+3224(SB)/8, $0x06050403ffffffff
+DATA dataByteMask0124<>+3232(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+3240(SB)/8, $0x07060504ffffffff
+DATA dataByteMask0124<>+3248(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+3256(SB)/8, $0x09080706ffffffff
+DATA dataByteMask0124<>+3264(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+3272(SB)/8, $0x07060504ffffffff
+DATA dataByteMask0124<>+3280(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+3288(SB)/8, $0x08070605ffffffff
+DATA dataByteMask0124<>+3296(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+3304(SB)/8, $0x09080706ffffffff
+DATA dataByteMask0124<>+3312(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+3320(SB)/8, $0x0b0a0908ffffffff
+DATA dataByteMask0124<>+3328(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+3336(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+3344(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+3352(SB)/8, $0x05040302ffffff01
+DATA dataByteMask0124<>+3360(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+3368(SB)/8, $0x06050403ffffff02
+DATA dataByteMask0124<>+3376(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+3384(SB)/8, $0x08070605ffffff04
+DATA dataByteMask0124<>+3392(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+3400(SB)/8, $0x05040302ffffff01
+DATA dataByteMask0124<>+3408(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+3416(SB)/8, $0x06050403ffffff02
+DATA dataByteMask0124<>+3424(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+3432(SB)/8, $0x07060504ffffff03
+DATA dataByteMask0124<>+3440(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+3448(SB)/8, $0x09080706ffffff05
+DATA dataByteMask0124<>+3456(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+3464(SB)/8, $0x06050403ffffff02
+DATA dataByteMask0124<>+3472(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+3480(SB)/8, $0x07060504ffffff03
+DATA dataByteMask0124<>+3488(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+3496(SB)/8, $0x08070605ffffff04
+DATA dataByteMask0124<>+3504(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+3512(SB)/8, $0x0a090807ffffff06
+DATA dataByteMask0124<>+3520(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+3528(SB)/8, $0x08070605ffffff04
+DATA dataByteMask0124<>+3536(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+3544(SB)/8, $0x09080706ffffff05
+DATA dataByteMask0124<>+3552(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+3560(SB)/8, $0x0a090807ffffff06
+DATA dataByteMask0124<>+3568(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+3576(SB)/8, $0x0c0b0a09ffffff08
+DATA dataByteMask0124<>+3584(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+3592(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+3600(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+3608(SB)/8, $0x06050403ffff0201
+DATA dataByteMask0124<>+3616(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+3624(SB)/8, $0x07060504ffff0302
+DATA dataByteMask0124<>+3632(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+3640(SB)/8, $0x09080706ffff0504
+DATA dataByteMask0124<>+3648(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+3656(SB)/8, $0x06050403ffff0201
+DATA dataByteMask0124<>+3664(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+3672(SB)/8, $0x07060504ffff0302
+DATA dataByteMask0124<>+3680(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+3688(SB)/8, $0x08070605ffff0403
+DATA dataByteMask0124<>+3696(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+3704(SB)/8, $0x0a090807ffff0605
+DATA dataByteMask0124<>+3712(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+3720(SB)/8, $0x07060504ffff0302
+DATA dataByteMask0124<>+3728(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+3736(SB)/8, $0x08070605ffff0403
+DATA dataByteMask0124<>+3744(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+3752(SB)/8, $0x09080706ffff0504
+DATA dataByteMask0124<>+3760(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+3768(SB)/8, $0x0b0a0908ffff0706
+DATA dataByteMask0124<>+3776(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+3784(SB)/8, $0x09080706ffff0504
+DATA dataByteMask0124<>+3792(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+3800(SB)/8, $0x0a090807ffff0605
+DATA dataByteMask0124<>+3808(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+3816(SB)/8, $0x0b0a0908ffff0706
+DATA dataByteMask0124<>+3824(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+3832(SB)/8, $0x0d0c0b0affff0908
+DATA dataByteMask0124<>+3840(SB)/8, $0xffffffffffffffff
+DATA dataByteMask0124<>+3848(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+3856(SB)/8, $0xffffffffffffff00
+DATA dataByteMask0124<>+3864(SB)/8, $0x0807060504030201
+DATA dataByteMask0124<>+3872(SB)/8, $0xffffffffffff0100
+DATA dataByteMask0124<>+3880(SB)/8, $0x0908070605040302
+DATA dataByteMask0124<>+3888(SB)/8, $0xffffffff03020100
+DATA dataByteMask0124<>+3896(SB)/8, $0x0b0a090807060504
+DATA dataByteMask0124<>+3904(SB)/8, $0xffffff00ffffffff
+DATA dataByteMask0124<>+3912(SB)/8, $0x0807060504030201
+DATA dataByteMask0124<>+3920(SB)/8, $0xffffff01ffffff00
+DATA dataByteMask0124<>+3928(SB)/8, $0x0908070605040302
+DATA dataByteMask0124<>+3936(SB)/8, $0xffffff02ffff0100
+DATA dataByteMask0124<>+3944(SB)/8, $0x0a09080706050403
+DATA dataByteMask0124<>+3952(SB)/8, $0xffffff0403020100
+DATA dataByteMask0124<>+3960(SB)/8, $0x0c0b0a0908070605
+DATA dataByteMask0124<>+3968(SB)/8, $0xffff0100ffffffff
+DATA dataByteMask0124<>+3976(SB)/8, $0x0908070605040302
+DATA dataByteMask0124<>+3984(SB)/8, $0xffff0201ffffff00
+DATA dataByteMask0124<>+3992(SB)/8, $0x0a09080706050403
+DATA dataByteMask0124<>+4000(SB)/8, $0xffff0302ffff0100
+DATA dataByteMask0124<>+4008(SB)/8, $0x0b0a090807060504
+DATA dataByteMask0124<>+4016(SB)/8, $0xffff050403020100
+DATA dataByteMask0124<>+4024(SB)/8, $0x0d0c0b0a09080706
+DATA dataByteMask0124<>+4032(SB)/8, $0x03020100ffffffff
+DATA dataByteMask0124<>+4040(SB)/8, $0x0b0a090807060504
+DATA dataByteMask0124<>+4048(SB)/8, $0x04030201ffffff00
+DATA dataByteMask0124<>+4056(SB)/8, $0x0c0b0a0908070605
+DATA dataByteMask0124<>+4064(SB)/8, $0x05040302ffff0100
+DATA dataByteMask0124<>+4072(SB)/8, $0x0d0c0b0a09080706
+DATA dataByteMask0124<>+4080(SB)/8, $0x0706050403020100
+DATA dataByteMask0124<>+4088(SB)/8, $0x0f0e0d0c0b0a0908
+GLOBL dataByteMask0124<>(SB), RODATA|NOPTR, $4096
+
+// func decodeUint32_0124SSE3(data []uint32, encoded []byte) int
+// Requires: SSE2, SSSE3
+TEXT ·decodeUint32_0124SSE3(SB), NOSPLIT, $0-56
+	MOVQ encoded_base+24(FP), AX
+	MOVQ encoded_cap+40(FP), CX
+
+	// Revert to scalar processing if we are within 16 bytes of the end.
+	SUBQ $0x10, CX
+	MOVQ data_base+0(FP), DX
+	MOVQ data_len+8(FP), BX
+
+	// Revert to scalar processing if we have less than 4 values to process.
+	MOVQ BX, SI
+	SUBQ $0x04, SI
+
+	// Initialize the control index.
+	XORQ DI, DI
+
+	// Initialize the data index. (len(data) + 3) >> 2
+	MOVQ BX, R8
+	ADDQ $0x03, R8
+	SHRQ $0x02, R8
+
+	// Initialize the output index.
+	XORQ R9, R9
+
+	// The byte count lookup table.
+	LEAQ dataByteCount0124<>+0(SB), R10
+
+	// The byte mask lookup table.
+	LEAQ dataByteMask0124<>+0(SB), R11
+
+simd:
+	// Check if less than 16 encoded bytes remain and jump to scalar.
+	CMPQ R8, CX
+	JGT  scalar
+
+	// Check if less than 4 values remain and jump to scalar.
+	CMPQ R9, SI
+	JGT  scalar
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R12
+	INCQ    DI
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(R8*1), X0
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R12*1), R13
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R12
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R12*1), X0
+
+	// Store 4 uint32.
+	MOVOU X0, (DX)(R9*4)
+
+	// Increment the indices.
+	ADDQ $0x04, R9
+	ADDQ R13, R8
+	JMP  simd
+
+scalar:
+	// Process a single value at a time.
+scalarLoop:
+	CMPQ R9, BX
+	JE   done
+
+	// Determine if we need to load a new control byte.
+	TESTQ $0x00000003, R9
+	JNE   loadBytes
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R14
+	INCQ    DI
+
+loadBytes:
+	// A zero code has no data bytes.
+	XORL CX, CX
+
+	// Switch on the low two bits of the control byte.
+	MOVQ R14, SI
+	ANDQ $0x03, SI
+	JE   shiftControl
+	CMPQ SI, $0x01
+	JE   oneByte
+	CMPQ SI, $0x02
+	JE   twoByte
+	MOVL (AX)(R8*1), CX
+	ADDQ $0x04, R8
+	JMP  shiftControl
+
+twoByte:
+	MOVWLZX (AX)(R8*1), CX
+	ADDQ    $0x02, R8
+	JMP     shiftControl
+
+oneByte:
+	MOVBLZX (AX)(R8*1), CX
+	INCQ    R8
+
+shiftControl:
+	// Shift control byte to get next value.
+	SHRQ $0x02, R14
+	MOVL CX, (DX)(R9*4)
+	INCQ R9
+	JMP  scalarLoop
+
+done:
+	MOVQ R8, ret+48(FP)
+	RET
+
+// func decodeDeltaUint32_0124SSE3(data []uint32, encoded []byte, previous uint32) int
+// Requires: SSE2, SSSE3
+TEXT ·decodeDeltaUint32_0124SSE3(SB), NOSPLIT, $0-64
+	MOVQ encoded_base+24(FP), AX
+	MOVQ encoded_cap+40(FP), CX
+
+	// Revert to scalar processing if we are within 16 bytes of the end.
+	SUBQ $0x10, CX
+	MOVQ data_base+0(FP), DX
+	MOVQ data_len+8(FP), BX
+
+	// Revert to scalar processing if we have less than 4 values to process.
+	MOVQ BX, SI
+	SUBQ $0x04, SI
+
+	// Initialize the control index.
+	XORQ DI, DI
+
+	// Initialize the data index. (len(data) + 3) >> 2
+	MOVQ BX, R8
+	ADDQ $0x03, R8
+	SHRQ $0x02, R8
+
+	// Initialize the output index.
+	XORQ R9, R9
+
+	// The byte count lookup table.
+	LEAQ dataByteCount0124<>+0(SB), R10
+
+	// The byte mask lookup table.
+	LEAQ   dataByteMask0124<>+0(SB), R11
+	MOVL   previous+48(FP), R12
+	MOVD   R12, X0
+	PSHUFD $0x00, X0, X0
+
+simd:
+	// Check if less than 16 encoded bytes remain and jump to scalar.
+	CMPQ R8, CX
+	JGT  scalar
+
+	// Check if less than 4 values remain and jump to scalar.
+	CMPQ R9, SI
+	JGT  scalar
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R12
+	INCQ    DI
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(R8*1), X1
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R12*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R12
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R12*1), X1
+
+	// Calculate prefix sum.
+	MOVOU X1, X2
+
+	// (0, 0, delta_0, delta_1)
+	PSLLDQ $0x08, X2
+
+	// (delta_0, delta_1, delta_2 + delta_0, delta_3 + delta_1)
+	PADDD X2, X1
+	MOVOU X1, X2
+
+	// (0, delta_0, delta_1, delta_2 + delta_0)
+	PSLLDQ $0x04, X2
+
+	// (delta_0, delta_0 + delta_1, delta_0 + delta_1 + delta_2, delta_0 + delta_1 + delta_2 + delta_delta_3)
+	PADDD X2, X1
+
+	// Add the previous last decoded value to all lanes.
+	PADDD X0, X1
+
+	// Propagate last decoded value to all lanes of previous.
+	PSHUFD $0xff, X1, X0
+
+	// Store 4 uint32.
+	MOVOU X1, (DX)(R9*4)
+
+	// Increment the indices.
+	ADDQ $0x04, R9
+	ADDQ R14, R8
+	JMP  simd
+
+scalar:
+	MOVD X0, R12
+
+	// Process a single value at a time.
+scalarLoop:
+	CMPQ R9, BX
+	JE   done
+
+	// Determine if we need to load a new control byte.
+	TESTQ $0x00000003, R9
+	JNE   loadBytes
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R13
+	INCQ    DI
+
+loadBytes:
+	// A zero code has no data bytes.
+	XORL CX, CX
+
+	// Switch on the low two bits of the control byte.
+	MOVQ R13, SI
+	ANDQ $0x03, SI
+	JE   shiftControl
+	CMPQ SI, $0x01
+	JE   oneByte
+	CMPQ SI, $0x02
+	JE   twoByte
+	MOVL (AX)(R8*1), CX
+	ADDQ $0x04, R8
+	JMP  shiftControl
+
+twoByte:
+	MOVWLZX (AX)(R8*1), CX
+	ADDQ    $0x02, R8
+	JMP     shiftControl
+
+oneByte:
+	MOVBLZX (AX)(R8*1), CX
+	INCQ    R8
+
+shiftControl:
+	// Shift control byte to get next value.
+	SHRQ $0x02, R13
+
+	// Add the previous decoded value to the delta.
+	ADDL CX, R12
+	MOVL R12, CX
+	MOVL CX, (DX)(R9*4)
+	INCQ R9
+	JMP  scalarLoop
+
+done:
+	MOVQ R8, ret+56(FP)
+	RET
+
+// func decodeInt32_0124SSE3(data []int32, encoded []byte) int
+// Requires: SSE2, SSSE3
+TEXT ·decodeInt32_0124SSE3(SB), NOSPLIT, $0-56
+	MOVQ encoded_base+24(FP), AX
+	MOVQ encoded_cap+40(FP), CX
+
+	// Revert to scalar processing if we are within 16 bytes of the end.
+	SUBQ $0x10, CX
+	MOVQ data_base+0(FP), DX
+	MOVQ data_len+8(FP), BX
+
+	// Revert to scalar processing if we have less than 4 values to process.
+	MOVQ BX, SI
+	SUBQ $0x04, SI
+
+	// Initialize the control index.
+	XORQ DI, DI
+
+	// Initialize the data index. (len(data) + 3) >> 2
+	MOVQ BX, R8
+	ADDQ $0x03, R8
+	SHRQ $0x02, R8
+
+	// Initialize the output index.
+	XORQ R9, R9
+
+	// The byte count lookup table.
+	LEAQ dataByteCount0124<>+0(SB), R10
+
+	// The byte mask lookup table.
+	LEAQ dataByteMask0124<>+0(SB), R11
+
+simd:
+	// Check if less than 16 encoded bytes remain and jump to scalar.
+	CMPQ R8, CX
+	JGT  scalar
+
+	// Check if less than 4 values remain and jump to scalar.
+	CMPQ R9, SI
+	JGT  scalar
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R12
+	INCQ    DI
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(R8*1), X0
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R12*1), R13
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R12
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R12*1), X0
+
+	// Zigzag decode.
+	MOVOU X0, X1
+
+	// (x >> 1)
+	PSRLL $0x01, X1
+
+	// Set to all ones.
+	PCMPEQL X2, X2
+
+	// Shift to one in each lane.
+	PSRLL $0x1f, X2
+
+	// (x & 1)
+	PAND X0, X2
+
+	// Set to all zeroes.
+	PXOR X0, X0
+
+	// -(x & 1)
+	PSUBL X2, X0
+
+	// (x >> 1) ^ - (x & 1)
+	PXOR X1, X0
+
+	// Store 4 uint32.
+	MOVOU X0, (DX)(R9*4)
+
+	// Increment the indices.
+	ADDQ $0x04, R9
+	ADDQ R13, R8
+	JMP  simd
+
+scalar:
+	// Process a single value at a time.
+scalarLoop:
+	CMPQ R9, BX
+	JE   done
+
+	// Determine if we need to load a new control byte.
+	TESTQ $0x00000003, R9
+	JNE   loadBytes
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R14
+	INCQ    DI
+
+loadBytes:
+	// A zero code has no data bytes.
+	XORL CX, CX
+
+	// Switch on the low two bits of the control byte.
+	MOVQ R14, SI
+	ANDQ $0x03, SI
+	JE   shiftControl
+	CMPQ SI, $0x01
+	JE   oneByte
+	CMPQ SI, $0x02
+	JE   twoByte
+	MOVL (AX)(R8*1), CX
+	ADDQ $0x04, R8
+	JMP  shiftControl
+
+twoByte:
+	MOVWLZX (AX)(R8*1), CX
+	ADDQ    $0x02, R8
+	JMP     shiftControl
+
+oneByte:
+	MOVBLZX (AX)(R8*1), CX
+	INCQ    R8
+
+shiftControl:
+	// Shift control byte to get next value.
+	SHRQ $0x02, R14
+
+	// Zigzag decode.
+	MOVL CX, SI
+	SHRL $0x01, SI
+	ANDL $0x01, CX
+	NEGL CX
+	XORL SI, CX
+	MOVL CX, (DX)(R9*4)
+	INCQ R9
+	JMP  scalarLoop
+
+done:
+	MOVQ R8, ret+48(FP)
+	RET
+
+// func decodeDeltaInt32_0124SSE3(data []int32, encoded []byte, previous int32) int
+// Requires: SSE2, SSSE3
+TEXT ·decodeDeltaInt32_0124SSE3(SB), NOSPLIT, $0-64
+	MOVQ encoded_base+24(FP), AX
+	MOVQ encoded_cap+40(FP), CX
+
+	// Revert to scalar processing if we are within 16 bytes of the end.
+	SUBQ $0x10, CX
+	MOVQ data_base+0(FP), DX
+	MOVQ data_len+8(FP), BX
+
+	// Revert to scalar processing if we have less than 4 values to process.
+	MOVQ BX, SI
+	SUBQ $0x04, SI
+
+	// Initialize the control index.
+	XORQ DI, DI
+
+	// Initialize the data index. (len(data) + 3) >> 2
+	MOVQ BX, R8
+	ADDQ $0x03, R8
+	SHRQ $0x02, R8
+
+	// Initialize the output index.
+	XORQ R9, R9
+
+	// The byte count lookup table.
+	LEAQ dataByteCount0124<>+0(SB), R10
+
+	// The byte mask lookup table.
+	LEAQ   dataByteMask0124<>+0(SB), R11
+	MOVL   previous+48(FP), R12
+	MOVD   R12, X0
+	PSHUFD $0x00, X0, X0
+
+simd:
+	// Check if less than 16 encoded bytes remain and jump to scalar.
+	CMPQ R8, CX
+	JGT  scalar
+
+	// Check if less than 4 values remain and jump to scalar.
+	CMPQ R9, SI
+	JGT  scalar
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R12
+	INCQ    DI
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(R8*1), X1
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R12*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R12
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R12*1), X1
+
+	// Zigzag decode.
+	MOVOU X1, X2
+
+	// (x >> 1)
+	PSRLL $0x01, X2
+
+	// Set to all ones.
+	PCMPEQL X3, X3
+
+	// Shift to one in each lane.
+	PSRLL $0x1f, X3
+
+	// (x & 1)
+	PAND X1, X3
+
+	// Set to all zeroes.
+	PXOR X1, X1
+
+	// -(x & 1)
+	PSUBL X3, X1
+
+	// (x >> 1) ^ - (x & 1)
+	PXOR X2, X1
+
+	// Calculate prefix sum.
+	MOVOU X1, X2
+
+	// (0, 0, delta_0, delta_1)
+	PSLLDQ $0x08, X2
+
+	// (delta_0, delta_1, delta_2 + delta_0, delta_3 + delta_1)
+	PADDD X2, X1
+	MOVOU X1, X2
+
+	// (0, delta_0, delta_1, delta_2 + delta_0)
+	PSLLDQ $0x04, X2
+
+	// (delta_0, delta_0 + delta_1, delta_0 + delta_1 + delta_2, delta_0 + delta_1 + delta_2 + delta_delta_3)
+	PADDD X2, X1
+
+	// Add the previous last decoded value to all lanes.
+	PADDD X0, X1
+
+	// Propagate last decoded value to all lanes of previous.
+	PSHUFD $0xff, X1, X0
+
+	// Store 4 uint32.
+	MOVOU X1, (DX)(R9*4)
+
+	// Increment the indices.
+	ADDQ $0x04, R9
+	ADDQ R14, R8
+	JMP  simd
+
+scalar:
+	MOVD X0, R12
+
+	// Process a single value at a time.
+scalarLoop:
+	CMPQ R9, BX
+	JE   done
+
+	// Determine if we need to load a new control byte.
+	TESTQ $0x00000003, R9
+	JNE   loadBytes
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R13
+	INCQ    DI
+
+loadBytes:
+	// A zero code has no data bytes.
+	XORL CX, CX
+
+	// Switch on the low two bits of the control byte.
+	MOVQ R13, SI
+	ANDQ $0x03, SI
+	JE   shiftControl
+	CMPQ SI, $0x01
+	JE   oneByte
+	CMPQ SI, $0x02
+	JE   twoByte
+	MOVL (AX)(R8*1), CX
+	ADDQ $0x04, R8
+	JMP  shiftControl
+
+twoByte:
+	MOVWLZX (AX)(R8*1), CX
+	ADDQ    $0x02, R8
+	JMP     shiftControl
+
+oneByte:
+	MOVBLZX (AX)(R8*1), CX
+	INCQ    R8
+
+shiftControl:
+	// Shift control byte to get next value.
+	SHRQ $0x02, R13
+
+	// Zigzag decode.
+	MOVL CX, SI
+	SHRL $0x01, SI
+	ANDL $0x01, CX
+	NEGL CX
+	XORL SI, CX
+
+	// Add the previous decoded value to the delta.
+	ADDL CX, R12
+	MOVL R12, CX
+	MOVL CX, (DX)(R9*4)
+	INCQ R9
+	JMP  scalarLoop
+
+done:
+	MOVQ R8, ret+56(FP)
+	RET
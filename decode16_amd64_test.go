@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"testing"
+
+	"golang.org/x/sys/cpu"
+)
+
+func TestRoundTripUint16SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	testUniformAndRandomUint16(t, encodeUint16scalar, decodeUint16SSE3, makeUniformUint16)
+}
+
+func decodeDeltaUint16SSE3Test(data []uint16, encoded []byte) int {
+	return decodeDeltaUint16SSE3(data, encoded, 0)
+}
+
+func TestRoundTripDeltaUint16SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	testUniformAndRandomUint16(t, encodeDeltaUint16scalarTest, decodeDeltaUint16SSE3Test, makeUniformDeltaUint16)
+}
+
+func TestRoundTripInt16SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	testUniformAndRandomInt16(t, encodeInt16scalar, decodeInt16SSE3, makeUniformInt16)
+}
+
+func decodeDeltaInt16SSE3Test(data []int16, encoded []byte) int {
+	return decodeDeltaInt16SSE3(data, encoded, 0)
+}
+
+func TestRoundTripDeltaInt16SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	testUniformAndRandomInt16(t, encodeDeltaInt16scalarTest, decodeDeltaInt16SSE3Test, makeUniformDeltaInt16)
+}
+
+func TestDifferentialDecodeUint16SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	size := 10000
+	data := benchUint16Data[:size]
+	encoded := make([]byte, MaxSize16(size))
+	encoded = encoded[:encodeDeltaUint16scalar(encoded, data, 7)]
+	expected, decoded := make([]uint16, size), make([]uint16, size)
+	expectedSize := decodeDeltaUint16scalar(expected, encoded, 7)
+	if decodedSize := decodeDeltaUint16SSE3(decoded, encoded, 7); decodedSize != expectedSize {
+		t.Errorf("got decodedSize: %d, expected: %d", decodedSize, expectedSize)
+	}
+	for i := range expected {
+		if decoded[i] != expected[i] {
+			t.Fatalf("got decoded[%d]: %d, expected: %d", i, decoded[i], expected[i])
+		}
+	}
+}
+
+func BenchmarkDecodeUint16SSE3(b *testing.B) {
+	if !cpu.X86.HasSSSE3 {
+		b.Skip("CPU does not support SSSE3 instructions")
+	}
+	b.SetBytes(int64(2 * benchSize))
+	benchEncodedSize = encodeUint16scalar(benchEncoded16, benchUint16Data)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeUint16SSE3(benchUint16Data, benchEncoded16)
+	}
+}
+
+func BenchmarkDecodeDeltaUint16SSE3(b *testing.B) {
+	if !cpu.X86.HasSSSE3 {
+		b.Skip("CPU does not support SSSE3 instructions")
+	}
+	b.SetBytes(int64(2 * benchSize))
+	benchEncodedSize = encodeDeltaUint16scalar(benchEncoded16, benchUint16DataSorted, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeDeltaUint16SSE3(benchUint16DataSorted, benchEncoded16, 0)
+	}
+}
@@ -0,0 +1,1301 @@
+// Code generated by command: go run gen_decode16_sse3.go -out decode16_sse3_amd64.s. DO NOT EDIT.
+
+#include "textflag.h"
+
+DATA dataByteCount16<>+0(SB)/1, $0x08
+DATA dataByteCount16<>+1(SB)/1, $0x09
+DATA dataByteCount16<>+2(SB)/1, $0x09
+DATA dataByteCount16<>+3(SB)/1, $0x0a
+DATA dataByteCount16<>+4(SB)/1, $0x09
+DATA dataByteCount16<>+5(SB)/1, $0x0a
+DATA dataByteCount16<>+6(SB)/1, $0x0a
+DATA dataByteCount16<>+7(SB)/1, $0x0b
+DATA dataByteCount16<>+8(SB)/1, $0x09
+DATA dataByteCount16<>+9(SB)/1, $0x0a
+DATA dataByteCount16<>+10(SB)/1, $0x0a
+DATA dataByteCount16<>+11(SB)/1, $0x0b
+DATA dataByteCount16<>+12(SB)/1, $0x0a
+DATA dataByteCount16<>+13(SB)/1, $0x0b
+DATA dataByteCount16<>+14(SB)/1, $0x0b
+DATA dataByteCount16<>+15(SB)/1, $0x0c
+DATA dataByteCount16<>+16(SB)/1, $0x09
+DATA dataByteCount16<>+17(SB)/1, $0x0a
+DATA dataByteCount16<>+18(SB)/1, $0x0a
+DATA dataByteCount16<>+19(SB)/1, $0x0b
+DATA dataByteCount16<>+20(SB)/1, $0x0a
+DATA dataByteCount16<>+21(SB)/1, $0x0b
+DATA dataByteCount16<>+22(SB)/1, $0x0b
+DATA dataByteCount16<>+23(SB)/1, $0x0c
+DATA dataByteCount16<>+24(SB)/1, $0x0a
+DATA dataByteCount16<>+25(SB)/1, $0x0b
+DATA dataByteCount16<>+26(SB)/1, $0x0b
+DATA dataByteCount16<>+27(SB)/1, $0x0c
+DATA dataByteCount16<>+28(SB)/1, $0x0b
+DATA dataByteCount16<>+29(SB)/1, $0x0c
+DATA dataByteCount16<>+30(SB)/1, $0x0c
+DATA dataByteCount16<>+31(SB)/1, $0x0d
+DATA dataByteCount16<>+32(SB)/1, $0x09
+DATA dataByteCount16<>+33(SB)/1, $0x0a
+DATA dataByteCount16<>+34(SB)/1, $0x0a
+DATA dataByteCount16<>+35(SB)/1, $0x0b
+DATA dataByteCount16<>+36(SB)/1, $0x0a
+DATA dataByteCount16<>+37(SB)/1, $0x0b
+DATA dataByteCount16<>+38(SB)/1, $0x0b
+DATA dataByteCount16<>+39(SB)/1, $0x0c
+DATA dataByteCount16<>+40(SB)/1, $0x0a
+DATA dataByteCount16<>+41(SB)/1, $0x0b
+DATA dataByteCount16<>+42(SB)/1, $0x0b
+DATA dataByteCount16<>+43(SB)/1, $0x0c
+DATA dataByteCount16<>+44(SB)/1, $0x0b
+DATA dataByteCount16<>+45(SB)/1, $0x0c
+DATA dataByteCount16<>+46(SB)/1, $0x0c
+DATA dataByteCount16<>+47(SB)/1, $0x0d
+DATA dataByteCount16<>+48(SB)/1, $0x0a
+DATA dataByteCount16<>+49(SB)/1, $0x0b
+DATA dataByteCount16<>+50(SB)/1, $0x0b
+DATA dataByteCount16<>+51(SB)/1, $0x0c
+DATA dataByteCount16<>+52(SB)/1, $0x0b
+DATA dataByteCount16<>+53(SB)/1, $0x0c
+DATA dataByteCount16<>+54(SB)/1, $0x0c
+DATA dataByteCount16<>+55(SB)/1, $0x0d
+DATA dataByteCount16<>+56(SB)/1, $0x0b
+DATA dataByteCount16<>+57(SB)/1, $0x0c
+DATA dataByteCount16<>+58(SB)/1, $0x0c
+DATA dataByteCount16<>+59(SB)/1, $0x0d
+DATA dataByteCount16<>+60(SB)/1, $0x0c
+DATA dataByteCount16<>+61(SB)/1, $0x0d
+DATA dataByteCount16<>+62(SB)/1, $0x0d
+DATA dataByteCount16<>+63(SB)/1, $0x0e
+DATA dataByteCount16<>+64(SB)/1, $0x09
+DATA dataByteCount16<>+65(SB)/1, $0x0a
+DATA dataByteCount16<>+66(SB)/1, $0x0a
+DATA dataByteCount16<>+67(SB)/1, $0x0b
+DATA dataByteCount16<>+68(SB)/1, $0x0a
+DATA dataByteCount16<>+69(SB)/1, $0x0b
+DATA dataByteCount16<>+70(SB)/1, $0x0b
+DATA dataByteCount16<>+71(SB)/1, $0x0c
+DATA dataByteCount16<>+72(SB)/1, $0x0a
+DATA dataByteCount16<>+73(SB)/1, $0x0b
+DATA dataByteCount16<>+74(SB)/1, $0x0b
+DATA dataByteCount16<>+75(SB)/1, $0x0c
+DATA dataByteCount16<>+76(SB)/1, $0x0b
+DATA dataByteCount16<>+77(SB)/1, $0x0c
+DATA dataByteCount16<>+78(SB)/1, $0x0c
+DATA dataByteCount16<>+79(SB)/1, $0x0d
+DATA dataByteCount16<>+80(SB)/1, $0x0a
+DATA dataByteCount16<>+81(SB)/1, $0x0b
+DATA dataByteCount16<>+82(SB)/1, $0x0b
+DATA dataByteCount16<>+83(SB)/1, $0x0c
+DATA dataByteCount16<>+84(SB)/1, $0x0b
+DATA dataByteCount16<>+85(SB)/1, $0x0c
+DATA dataByteCount16<>+86(SB)/1, $0x0c
+DATA dataByteCount16<>+87(SB)/1, $0x0d
+DATA dataByteCount16<>+88(SB)/1, $0x0b
+DATA dataByteCount16<>+89(SB)/1, $0x0c
+DATA dataByteCount16<>+90(SB)/1, $0x0c
+DATA dataByteCount16<>+91(SB)/1, $0x0d
+DATA dataByteCount16<>+92(SB)/1, $0x0c
+DATA dataByteCount16<>+93(SB)/1, $0x0d
+DATA dataByteCount16<>+94(SB)/1, $0x0d
+DATA dataByteCount16<>+95(SB)/1, $0x0e
+DATA dataByteCount16<>+96(SB)/1, $0x0a
+DATA dataByteCount16<>+97(SB)/1, $0x0b
+DATA dataByteCount16<>+98(SB)/1, $0x0b
+DATA dataByteCount16<>+99(SB)/1, $0x0c
+DATA dataByteCount16<>+100(SB)/1, $0x0b
+DATA dataByteCount16<>+101(SB)/1, $0x0c
+DATA dataByteCount16<>+102(SB)/1, $0x0c
+DATA dataByteCount16<>+103(SB)/1, $0x0d
+DATA dataByteCount16<>+104(SB)/1, $0x0b
+DATA dataByteCount16<>+105(SB)/1, $0x0c
+DATA dataByteCount16<>+106(SB)/1, $0x0c
+DATA dataByteCount16<>+107(SB)/1, $0x0d
+DATA dataByteCount16<>+108(SB)/1, $0x0c
+DATA dataByteCount16<>+109(SB)/1, $0x0d
+DATA dataByteCount16<>+110(SB)/1, $0x0d
+DATA dataByteCount16<>+111(SB)/1, $0x0e
+DATA dataByteCount16<>+112(SB)/1, $0x0b
+DATA dataByteCount16<>+113(SB)/1, $0x0c
+DATA dataByteCount16<>+114(SB)/1, $0x0c
+DATA dataByteCount16<>+115(SB)/1, $0x0d
+DATA dataByteCount16<>+116(SB)/1, $0x0c
+DATA dataByteCount16<>+117(SB)/1, $0x0d
+DATA dataByteCount16<>+118(SB)/1, $0x0d
+DATA dataByteCount16<>+119(SB)/1, $0x0e
+DATA dataByteCount16<>+120(SB)/1, $0x0c
+DATA dataByteCount16<>+121(SB)/1, $0x0d
+DATA dataByteCount16<>+122(SB)/1, $0x0d
+DATA dataByteCount16<>+123(SB)/1, $0x0e
+DATA dataByteCount16<>+124(SB)/1, $0x0d
+DATA dataByteCount16<>+125(SB)/1, $0x0e
+DATA dataByteCount16<>+126(SB)/1, $0x0e
+DATA dataByteCount16<>+127(SB)/1, $0x0f
+DATA dataByteCount16<>+128(SB)/1, $0x09
+DATA dataByteCount16<>+129(SB)/1, $0x0a
+DATA dataByteCount16<>+130(SB)/1, $0x0a
+DATA dataByteCount16<>+131(SB)/1, $0x0b
+DATA dataByteCount16<>+132(SB)/1, $0x0a
+DATA dataByteCount16<>+133(SB)/1, $0x0b
+DATA dataByteCount16<>+134(SB)/1, $0x0b
+DATA dataByteCount16<>+135(SB)/1, $0x0c
+DATA dataByteCount16<>+136(SB)/1, $0x0a
+DATA dataByteCount16<>+137(SB)/1, $0x0b
+DATA dataByteCount16<>+138(SB)/1, $0x0b
+DATA dataByteCount16<>+139(SB)/1, $0x0c
+DATA dataByteCount16<>+140(SB)/1, $0x0b
+DATA dataByteCount16<>+141(SB)/1, $0x0c
+DATA dataByteCount16<>+142(SB)/1, $0x0c
+DATA dataByteCount16<>+143(SB)/1, $0x0d
+DATA dataByteCount16<>+144(SB)/1, $0x0a
+DATA dataByteCount16<>+145(SB)/1, $0x0b
+DATA dataByteCount16<>+146(SB)/1, $0x0b
+DATA dataByteCount16<>+147(SB)/1, $0x0c
+DATA dataByteCount16<>+148(SB)/1, $0x0b
+DATA dataByteCount16<>+149(SB)/1, $0x0c
+DATA dataByteCount16<>+150(SB)/1, $0x0c
+DATA dataByteCount16<>+151(SB)/1, $0x0d
+DATA dataByteCount16<>+152(SB)/1, $0x0b
+DATA dataByteCount16<>+153(SB)/1, $0x0c
+DATA dataByteCount16<>+154(SB)/1, $0x0c
+DATA dataByteCount16<>+155(SB)/1, $0x0d
+DATA dataByteCount16<>+156(SB)/1, $0x0c
+DATA dataByteCount16<>+157(SB)/1, $0x0d
+DATA dataByteCount16<>+158(SB)/1, $0x0d
+DATA dataByteCount16<>+159(SB)/1, $0x0e
+DATA dataByteCount16<>+160(SB)/1, $0x0a
+DATA dataByteCount16<>+161(SB)/1, $0x0b
+DATA dataByteCount16<>+162(SB)/1, $0x0b
+DATA dataByteCount16<>+163(SB)/1, $0x0c
+DATA dataByteCount16<>+164(SB)/1, $0x0b
+DATA dataByteCount16<>+165(SB)/1, $0x0c
+DATA dataByteCount16<>+166(SB)/1, $0x0c
+DATA dataByteCount16<>+167(SB)/1, $0x0d
+DATA dataByteCount16<>+168(SB)/1, $0x0b
+DATA dataByteCount16<>+169(SB)/1, $0x0c
+DATA dataByteCount16<>+170(SB)/1, $0x0c
+DATA dataByteCount16<>+171(SB)/1, $0x0d
+DATA dataByteCount16<>+172(SB)/1, $0x0c
+DATA dataByteCount16<>+173(SB)/1, $0x0d
+DATA dataByteCount16<>+174(SB)/1, $0x0d
+DATA dataByteCount16<>+175(SB)/1, $0x0e
+DATA dataByteCount16<>+176(SB)/1, $0x0b
+DATA dataByteCount16<>+177(SB)/1, $0x0c
+DATA dataByteCount16<>+178(SB)/1, $0x0c
+DATA dataByteCount16<>+179(SB)/1, $0x0d
+DATA dataByteCount16<>+180(SB)/1, $0x0c
+DATA dataByteCount16<>+181(SB)/1, $0x0d
+DATA dataByteCount16<>+182(SB)/1, $0x0d
+DATA dataByteCount16<>+183(SB)/1, $0x0e
+DATA dataByteCount16<>+184(SB)/1, $0x0c
+DATA dataByteCount16<>+185(SB)/1, $0x0d
+DATA dataByteCount16<>+186(SB)/1, $0x0d
+DATA dataByteCount16<>+187(SB)/1, $0x0e
+DATA dataByteCount16<>+188(SB)/1, $0x0d
+DATA dataByteCount16<>+189(SB)/1, $0x0e
+DATA dataByteCount16<>+190(SB)/1, $0x0e
+DATA dataByteCount16<>+191(SB)/1, $0x0f
+DATA dataByteCount16<>+192(SB)/1, $0x0a
+DATA dataByteCount16<>+193(SB)/1, $0x0b
+DATA dataByteCount16<>+194(SB)/1, $0x0b
+DATA dataByteCount16<>+195(SB)/1, $0x0c
+DATA dataByteCount16<>+196(SB)/1, $0x0b
+DATA dataByteCount16<>+197(SB)/1, $0x0c
+DATA dataByteCount16<>+198(SB)/1, $0x0c
+DATA dataByteCount16<>+199(SB)/1, $0x0d
+DATA dataByteCount16<>+200(SB)/1, $0x0b
+DATA dataByteCount16<>+201(SB)/1, $0x0c
+DATA dataByteCount16<>+202(SB)/1, $0x0c
+DATA dataByteCount16<>+203(SB)/1, $0x0d
+DATA dataByteCount16<>+204(SB)/1, $0x0c
+DATA dataByteCount16<>+205(SB)/1, $0x0d
+DATA dataByteCount16<>+206(SB)/1, $0x0d
+DATA dataByteCount16<>+207(SB)/1, $0x0e
+DATA dataByteCount16<>+208(SB)/1, $0x0b
+DATA dataByteCount16<>+209(SB)/1, $0x0c
+DATA dataByteCount16<>+210(SB)/1, $0x0c
+DATA dataByteCount16<>+211(SB)/1, $0x0d
+DATA dataByteCount16<>+212(SB)/1, $0x0c
+DATA dataByteCount16<>+213(SB)/1, $0x0d
+DATA dataByteCount16<>+214(SB)/1, $0x0d
+DATA dataByteCount16<>+215(SB)/1, $0x0e
+DATA dataByteCount16<>+216(SB)/1, $0x0c
+DATA dataByteCount16<>+217(SB)/1, $0x0d
+DATA dataByteCount16<>+218(SB)/1, $0x0d
+DATA dataByteCount16<>+219(SB)/1, $0x0e
+DATA dataByteCount16<>+220(SB)/1, $0x0d
+DATA dataByteCount16<>+221(SB)/1, $0x0e
+DATA dataByteCount16<>+222(SB)/1, $0x0e
+DATA dataByteCount16<>+223(SB)/1, $0x0f
+DATA dataByteCount16<>+224(SB)/1, $0x0b
+DATA dataByteCount16<>+225(SB)/1, $0x0c
+DATA dataByteCount16<>+226(SB)/1, $0x0c
+DATA dataByteCount16<>+227(SB)/1, $0x0d
+DATA dataByteCount16<>+228(SB)/1, $0x0c
+DATA dataByteCount16<>+229(SB)/1, $0x0d
+DATA dataByteCount16<>+230(SB)/1, $0x0d
+DATA dataByteCount16<>+231(SB)/1, $0x0e
+DATA dataByteCount16<>+232(SB)/1, $0x0c
+DATA dataByteCount16<>+233(SB)/1, $0x0d
+DATA dataByteCount16<>+234(SB)/1, $0x0d
+DATA dataByteCount16<>+235(SB)/1, $0x0e
+DATA dataByteCount16<>+236(SB)/1, $0x0d
+DATA dataByteCount16<>+237(SB)/1, $0x0e
+DATA dataByteCount16<>+238(SB)/1, $0x0e
+DATA dataByteCount16<>+239(SB)/1, $0x0f
+DATA dataByteCount16<>+240(SB)/1, $0x0c
+DATA dataByteCount16<>+241(SB)/1, $0x0d
+DATA dataByteCount16<>+242(SB)/1, $0x0d
+DATA dataByteCount16<>+243(SB)/1, $0x0e
+DATA dataByteCount16<>+244(SB)/1, $0x0d
+DATA dataByteCount16<>+245(SB)/1, $0x0e
+DATA dataByteCount16<>+246(SB)/1, $0x0e
+DATA dataByteCount16<>+247(SB)/1, $0x0f
+DATA dataByteCount16<>+248(SB)/1, $0x0d
+DATA dataByteCount16<>+249(SB)/1, $0x0e
+DATA dataByteCount16<>+250(SB)/1, $0x0e
+DATA dataByteCount16<>+251(SB)/1, $0x0f
+DATA dataByteCount16<>+252(SB)/1, $0x0e
+DATA dataByteCount16<>+253(SB)/1, $0x0f
+DATA dataByteCount16<>+254(SB)/1, $0x0f
+DATA dataByteCount16<>+255(SB)/1, $0x10
+GLOBL dataByteCount16<>(SB), RODATA|NOPTR, $256
+
+DATA dataByteMask16<>+0(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+8(SB)/8, $0xff07ff06ff05ff04
+DATA dataByteMask16<>+16(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+24(SB)/8, $0xff08ff07ff06ff05
+DATA dataByteMask16<>+32(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+40(SB)/8, $0xff08ff07ff06ff05
+DATA dataByteMask16<>+48(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+56(SB)/8, $0xff09ff08ff07ff06
+DATA dataByteMask16<>+64(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+72(SB)/8, $0xff08ff07ff06ff05
+DATA dataByteMask16<>+80(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+88(SB)/8, $0xff09ff08ff07ff06
+DATA dataByteMask16<>+96(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+104(SB)/8, $0xff09ff08ff07ff06
+DATA dataByteMask16<>+112(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+120(SB)/8, $0xff0aff09ff08ff07
+DATA dataByteMask16<>+128(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+136(SB)/8, $0xff08ff07ff06ff05
+DATA dataByteMask16<>+144(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+152(SB)/8, $0xff09ff08ff07ff06
+DATA dataByteMask16<>+160(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+168(SB)/8, $0xff09ff08ff07ff06
+DATA dataByteMask16<>+176(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+184(SB)/8, $0xff0aff09ff08ff07
+DATA dataByteMask16<>+192(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+200(SB)/8, $0xff09ff08ff07ff06
+DATA dataByteMask16<>+208(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+216(SB)/8, $0xff0aff09ff08ff07
+DATA dataByteMask16<>+224(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+232(SB)/8, $0xff0aff09ff08ff07
+DATA dataByteMask16<>+240(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+248(SB)/8, $0xff0bff0aff09ff08
+DATA dataByteMask16<>+256(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+264(SB)/8, $0xff08ff07ff060504
+DATA dataByteMask16<>+272(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+280(SB)/8, $0xff09ff08ff070605
+DATA dataByteMask16<>+288(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+296(SB)/8, $0xff09ff08ff070605
+DATA dataByteMask16<>+304(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+312(SB)/8, $0xff0aff09ff080706
+DATA dataByteMask16<>+320(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+328(SB)/8, $0xff09ff08ff070605
+DATA dataByteMask16<>+336(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+344(SB)/8, $0xff0aff09ff080706
+DATA dataByteMask16<>+352(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+360(SB)/8, $0xff0aff09ff080706
+DATA dataByteMask16<>+368(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+376(SB)/8, $0xff0bff0aff090807
+DATA dataByteMask16<>+384(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+392(SB)/8, $0xff09ff08ff070605
+DATA dataByteMask16<>+400(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+408(SB)/8, $0xff0aff09ff080706
+DATA dataByteMask16<>+416(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+424(SB)/8, $0xff0aff09ff080706
+DATA dataByteMask16<>+432(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+440(SB)/8, $0xff0bff0aff090807
+DATA dataByteMask16<>+448(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+456(SB)/8, $0xff0aff09ff080706
+DATA dataByteMask16<>+464(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+472(SB)/8, $0xff0bff0aff090807
+DATA dataByteMask16<>+480(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+488(SB)/8, $0xff0bff0aff090807
+DATA dataByteMask16<>+496(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+504(SB)/8, $0xff0cff0bff0a0908
+DATA dataByteMask16<>+512(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+520(SB)/8, $0xff08ff070605ff04
+DATA dataByteMask16<>+528(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+536(SB)/8, $0xff09ff080706ff05
+DATA dataByteMask16<>+544(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+552(SB)/8, $0xff09ff080706ff05
+DATA dataByteMask16<>+560(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+568(SB)/8, $0xff0aff090807ff06
+DATA dataByteMask16<>+576(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+584(SB)/8, $0xff09ff080706ff05
+DATA dataByteMask16<>+592(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+600(SB)/8, $0xff0aff090807ff06
+DATA dataByteMask16<>+608(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+616(SB)/8, $0xff0aff090807ff06
+DATA dataByteMask16<>+624(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+632(SB)/8, $0xff0bff0a0908ff07
+DATA dataByteMask16<>+640(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+648(SB)/8, $0xff09ff080706ff05
+DATA dataByteMask16<>+656(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+664(SB)/8, $0xff0aff090807ff06
+DATA dataByteMask16<>+672(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+680(SB)/8, $0xff0aff090807ff06
+DATA dataByteMask16<>+688(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+696(SB)/8, $0xff0bff0a0908ff07
+DATA dataByteMask16<>+704(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+712(SB)/8, $0xff0aff090807ff06
+DATA dataByteMask16<>+720(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+728(SB)/8, $0xff0bff0a0908ff07
+DATA dataByteMask16<>+736(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+744(SB)/8, $0xff0bff0a0908ff07
+DATA dataByteMask16<>+752(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+760(SB)/8, $0xff0cff0b0a09ff08
+DATA dataByteMask16<>+768(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+776(SB)/8, $0xff09ff0807060504
+DATA dataByteMask16<>+784(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+792(SB)/8, $0xff0aff0908070605
+DATA dataByteMask16<>+800(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+808(SB)/8, $0xff0aff0908070605
+DATA dataByteMask16<>+816(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+824(SB)/8, $0xff0bff0a09080706
+DATA dataByteMask16<>+832(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+840(SB)/8, $0xff0aff0908070605
+DATA dataByteMask16<>+848(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+856(SB)/8, $0xff0bff0a09080706
+DATA dataByteMask16<>+864(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+872(SB)/8, $0xff0bff0a09080706
+DATA dataByteMask16<>+880(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+888(SB)/8, $0xff0cff0b0a090807
+DATA dataByteMask16<>+896(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+904(SB)/8, $0xff0aff0908070605
+DATA dataByteMask16<>+912(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+920(SB)/8, $0xff0bff0a09080706
+DATA dataByteMask16<>+928(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+936(SB)/8, $0xff0bff0a09080706
+DATA dataByteMask16<>+944(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+952(SB)/8, $0xff0cff0b0a090807
+DATA dataByteMask16<>+960(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+968(SB)/8, $0xff0bff0a09080706
+DATA dataByteMask16<>+976(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+984(SB)/8, $0xff0cff0b0a090807
+DATA dataByteMask16<>+992(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+1000(SB)/8, $0xff0cff0b0a090807
+DATA dataByteMask16<>+1008(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+1016(SB)/8, $0xff0dff0c0b0a0908
+DATA dataByteMask16<>+1024(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+1032(SB)/8, $0xff080706ff05ff04
+DATA dataByteMask16<>+1040(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+1048(SB)/8, $0xff090807ff06ff05
+DATA dataByteMask16<>+1056(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+1064(SB)/8, $0xff090807ff06ff05
+DATA dataByteMask16<>+1072(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+1080(SB)/8, $0xff0a0908ff07ff06
+DATA dataByteMask16<>+1088(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+1096(SB)/8, $0xff090807ff06ff05
+DATA dataByteMask16<>+1104(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+1112(SB)/8, $0xff0a0908ff07ff06
+DATA dataByteMask16<>+1120(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+1128(SB)/8, $0xff0a0908ff07ff06
+DATA dataByteMask16<>+1136(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+1144(SB)/8, $0xff0b0a09ff08ff07
+DATA dataByteMask16<>+1152(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+1160(SB)/8, $0xff090807ff06ff05
+DATA dataByteMask16<>+1168(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+1176(SB)/8, $0xff0a0908ff07ff06
+DATA dataByteMask16<>+1184(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+1192(SB)/8, $0xff0a0908ff07ff06
+DATA dataByteMask16<>+1200(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+1208(SB)/8, $0xff0b0a09ff08ff07
+DATA dataByteMask16<>+1216(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+1224(SB)/8, $0xff0a0908ff07ff06
+DATA dataByteMask16<>+1232(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+1240(SB)/8, $0xff0b0a09ff08ff07
+DATA dataByteMask16<>+1248(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+1256(SB)/8, $0xff0b0a09ff08ff07
+DATA dataByteMask16<>+1264(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+1272(SB)/8, $0xff0c0b0aff09ff08
+DATA dataByteMask16<>+1280(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+1288(SB)/8, $0xff090807ff060504
+DATA dataByteMask16<>+1296(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+1304(SB)/8, $0xff0a0908ff070605
+DATA dataByteMask16<>+1312(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+1320(SB)/8, $0xff0a0908ff070605
+DATA dataByteMask16<>+1328(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+1336(SB)/8, $0xff0b0a09ff080706
+DATA dataByteMask16<>+1344(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+1352(SB)/8, $0xff0a0908ff070605
+DATA dataByteMask16<>+1360(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+1368(SB)/8, $0xff0b0a09ff080706
+DATA dataByteMask16<>+1376(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+1384(SB)/8, $0xff0b0a09ff080706
+DATA dataByteMask16<>+1392(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+1400(SB)/8, $0xff0c0b0aff090807
+DATA dataByteMask16<>+1408(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+1416(SB)/8, $0xff0a0908ff070605
+DATA dataByteMask16<>+1424(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+1432(SB)/8, $0xff0b0a09ff080706
+DATA dataByteMask16<>+1440(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+1448(SB)/8, $0xff0b0a09ff080706
+DATA dataByteMask16<>+1456(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+1464(SB)/8, $0xff0c0b0aff090807
+DATA dataByteMask16<>+1472(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+1480(SB)/8, $0xff0b0a09ff080706
+DATA dataByteMask16<>+1488(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+1496(SB)/8, $0xff0c0b0aff090807
+DATA dataByteMask16<>+1504(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+1512(SB)/8, $0xff0c0b0aff090807
+DATA dataByteMask16<>+1520(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+1528(SB)/8, $0xff0d0c0bff0a0908
+DATA dataByteMask16<>+1536(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+1544(SB)/8, $0xff0908070605ff04
+DATA dataByteMask16<>+1552(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+1560(SB)/8, $0xff0a09080706ff05
+DATA dataByteMask16<>+1568(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+1576(SB)/8, $0xff0a09080706ff05
+DATA dataByteMask16<>+1584(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+1592(SB)/8, $0xff0b0a090807ff06
+DATA dataByteMask16<>+1600(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+1608(SB)/8, $0xff0a09080706ff05
+DATA dataByteMask16<>+1616(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+1624(SB)/8, $0xff0b0a090807ff06
+DATA dataByteMask16<>+1632(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+1640(SB)/8, $0xff0b0a090807ff06
+DATA dataByteMask16<>+1648(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+1656(SB)/8, $0xff0c0b0a0908ff07
+DATA dataByteMask16<>+1664(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+1672(SB)/8, $0xff0a09080706ff05
+DATA dataByteMask16<>+1680(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+1688(SB)/8, $0xff0b0a090807ff06
+DATA dataByteMask16<>+1696(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+1704(SB)/8, $0xff0b0a090807ff06
+DATA dataByteMask16<>+1712(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+1720(SB)/8, $0xff0c0b0a0908ff07
+DATA dataByteMask16<>+1728(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+1736(SB)/8, $0xff0b0a090807ff06
+DATA dataByteMask16<>+1744(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+1752(SB)/8, $0xff0c0b0a0908ff07
+DATA dataByteMask16<>+1760(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+1768(SB)/8, $0xff0c0b0a0908ff07
+DATA dataByteMask16<>+1776(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+1784(SB)/8, $0xff0d0c0b0a09ff08
+DATA dataByteMask16<>+1792(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+1800(SB)/8, $0xff0a090807060504
+DATA dataByteMask16<>+1808(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+1816(SB)/8, $0xff0b0a0908070605
+DATA dataByteMask16<>+1824(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+1832(SB)/8, $0xff0b0a0908070605
+DATA dataByteMask16<>+1840(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+1848(SB)/8, $0xff0c0b0a09080706
+DATA dataByteMask16<>+1856(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+1864(SB)/8, $0xff0b0a0908070605
+DATA dataByteMask16<>+1872(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+1880(SB)/8, $0xff0c0b0a09080706
+DATA dataByteMask16<>+1888(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+1896(SB)/8, $0xff0c0b0a09080706
+DATA dataByteMask16<>+1904(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+1912(SB)/8, $0xff0d0c0b0a090807
+DATA dataByteMask16<>+1920(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+1928(SB)/8, $0xff0b0a0908070605
+DATA dataByteMask16<>+1936(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+1944(SB)/8, $0xff0c0b0a09080706
+DATA dataByteMask16<>+1952(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+1960(SB)/8, $0xff0c0b0a09080706
+DATA dataByteMask16<>+1968(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+1976(SB)/8, $0xff0d0c0b0a090807
+DATA dataByteMask16<>+1984(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+1992(SB)/8, $0xff0c0b0a09080706
+DATA dataByteMask16<>+2000(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+2008(SB)/8, $0xff0d0c0b0a090807
+DATA dataByteMask16<>+2016(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+2024(SB)/8, $0xff0d0c0b0a090807
+DATA dataByteMask16<>+2032(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+2040(SB)/8, $0xff0e0d0c0b0a0908
+DATA dataByteMask16<>+2048(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+2056(SB)/8, $0x0807ff06ff05ff04
+DATA dataByteMask16<>+2064(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+2072(SB)/8, $0x0908ff07ff06ff05
+DATA dataByteMask16<>+2080(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+2088(SB)/8, $0x0908ff07ff06ff05
+DATA dataByteMask16<>+2096(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+2104(SB)/8, $0x0a09ff08ff07ff06
+DATA dataByteMask16<>+2112(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+2120(SB)/8, $0x0908ff07ff06ff05
+DATA dataByteMask16<>+2128(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+2136(SB)/8, $0x0a09ff08ff07ff06
+DATA dataByteMask16<>+2144(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+2152(SB)/8, $0x0a09ff08ff07ff06
+DATA dataByteMask16<>+2160(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+2168(SB)/8, $0x0b0aff09ff08ff07
+DATA dataByteMask16<>+2176(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+2184(SB)/8, $0x0908ff07ff06ff05
+DATA dataByteMask16<>+2192(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+2200(SB)/8, $0x0a09ff08ff07ff06
+DATA dataByteMask16<>+2208(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+2216(SB)/8, $0x0a09ff08ff07ff06
+DATA dataByteMask16<>+2224(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+2232(SB)/8, $0x0b0aff09ff08ff07
+DATA dataByteMask16<>+2240(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+2248(SB)/8, $0x0a09ff08ff07ff06
+DATA dataByteMask16<>+2256(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+2264(SB)/8, $0x0b0aff09ff08ff07
+DATA dataByteMask16<>+2272(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+2280(SB)/8, $0x0b0aff09ff08ff07
+DATA dataByteMask16<>+2288(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+2296(SB)/8, $0x0c0bff0aff09ff08
+DATA dataByteMask16<>+2304(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+2312(SB)/8, $0x0908ff07ff060504
+DATA dataByteMask16<>+2320(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+2328(SB)/8, $0x0a09ff08ff070605
+DATA dataByteMask16<>+2336(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+2344(SB)/8, $0x0a09ff08ff070605
+DATA dataByteMask16<>+2352(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+2360(SB)/8, $0x0b0aff09ff080706
+DATA dataByteMask16<>+2368(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+2376(SB)/8, $0x0a09ff08ff070605
+DATA dataByteMask16<>+2384(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+2392(SB)/8, $0x0b0aff09ff080706
+DATA dataByteMask16<>+2400(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+2408(SB)/8, $0x0b0aff09ff080706
+DATA dataByteMask16<>+2416(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+2424(SB)/8, $0x0c0bff0aff090807
+DATA dataByteMask16<>+2432(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+2440(SB)/8, $0x0a09ff08ff070605
+DATA dataByteMask16<>+2448(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+2456(SB)/8, $0x0b0aff09ff080706
+DATA dataByteMask16<>+2464(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+2472(SB)/8, $0x0b0aff09ff080706
+DATA dataByteMask16<>+2480(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+2488(SB)/8, $0x0c0bff0aff090807
+DATA dataByteMask16<>+2496(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+2504(SB)/8, $0x0b0aff09ff080706
+DATA dataByteMask16<>+2512(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+2520(SB)/8, $0x0c0bff0aff090807
+DATA dataByteMask16<>+2528(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+2536(SB)/8, $0x0c0bff0aff090807
+DATA dataByteMask16<>+2544(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+2552(SB)/8, $0x0d0cff0bff0a0908
+DATA dataByteMask16<>+2560(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+2568(SB)/8, $0x0908ff070605ff04
+DATA dataByteMask16<>+2576(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+2584(SB)/8, $0x0a09ff080706ff05
+DATA dataByteMask16<>+2592(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+2600(SB)/8, $0x0a09ff080706ff05
+DATA dataByteMask16<>+2608(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+2616(SB)/8, $0x0b0aff090807ff06
+DATA dataByteMask16<>+2624(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+2632(SB)/8, $0x0a09ff080706ff05
+DATA dataByteMask16<>+2640(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+2648(SB)/8, $0x0b0aff090807ff06
+DATA dataByteMask16<>+2656(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+2664(SB)/8, $0x0b0aff090807ff06
+DATA dataByteMask16<>+2672(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+2680(SB)/8, $0x0c0bff0a0908ff07
+DATA dataByteMask16<>+2688(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+2696(SB)/8, $0x0a09ff080706ff05
+DATA dataByteMask16<>+2704(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+2712(SB)/8, $0x0b0aff090807ff06
+DATA dataByteMask16<>+2720(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+2728(SB)/8, $0x0b0aff090807ff06
+DATA dataByteMask16<>+2736(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+2744(SB)/8, $0x0c0bff0a0908ff07
+DATA dataByteMask16<>+2752(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+2760(SB)/8, $0x0b0aff090807ff06
+DATA dataByteMask16<>+2768(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+2776(SB)/8, $0x0c0bff0a0908ff07
+DATA dataByteMask16<>+2784(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+2792(SB)/8, $0x0c0bff0a0908ff07
+DATA dataByteMask16<>+2800(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+2808(SB)/8, $0x0d0cff0b0a09ff08
+DATA dataByteMask16<>+2816(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+2824(SB)/8, $0x0a09ff0807060504
+DATA dataByteMask16<>+2832(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+2840(SB)/8, $0x0b0aff0908070605
+DATA dataByteMask16<>+2848(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+2856(SB)/8, $0x0b0aff0908070605
+DATA dataByteMask16<>+2864(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+2872(SB)/8, $0x0c0bff0a09080706
+DATA dataByteMask16<>+2880(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+2888(SB)/8, $0x0b0aff0908070605
+DATA dataByteMask16<>+2896(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+2904(SB)/8, $0x0c0bff0a09080706
+DATA dataByteMask16<>+2912(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+2920(SB)/8, $0x0c0bff0a09080706
+DATA dataByteMask16<>+2928(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+2936(SB)/8, $0x0d0cff0b0a090807
+DATA dataByteMask16<>+2944(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+2952(SB)/8, $0x0b0aff0908070605
+DATA dataByteMask16<>+2960(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+2968(SB)/8, $0x0c0bff0a09080706
+DATA dataByteMask16<>+2976(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+2984(SB)/8, $0x0c0bff0a09080706
+DATA dataByteMask16<>+2992(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+3000(SB)/8, $0x0d0cff0b0a090807
+DATA dataByteMask16<>+3008(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+3016(SB)/8, $0x0c0bff0a09080706
+DATA dataByteMask16<>+3024(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+3032(SB)/8, $0x0d0cff0b0a090807
+DATA dataByteMask16<>+3040(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+3048(SB)/8, $0x0d0cff0b0a090807
+DATA dataByteMask16<>+3056(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+3064(SB)/8, $0x0e0dff0c0b0a0908
+DATA dataByteMask16<>+3072(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+3080(SB)/8, $0x09080706ff05ff04
+DATA dataByteMask16<>+3088(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+3096(SB)/8, $0x0a090807ff06ff05
+DATA dataByteMask16<>+3104(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+3112(SB)/8, $0x0a090807ff06ff05
+DATA dataByteMask16<>+3120(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+3128(SB)/8, $0x0b0a0908ff07ff06
+DATA dataByteMask16<>+3136(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+3144(SB)/8, $0x0a090807ff06ff05
+DATA dataByteMask16<>+3152(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+3160(SB)/8, $0x0b0a0908ff07ff06
+DATA dataByteMask16<>+3168(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+3176(SB)/8, $0x0b0a0908ff07ff06
+DATA dataByteMask16<>+3184(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+3192(SB)/8, $0x0c0b0a09ff08ff07
+DATA dataByteMask16<>+3200(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+3208(SB)/8, $0x0a090807ff06ff05
+DATA dataByteMask16<>+3216(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+3224(SB)/8, $0x0b0a0908ff07ff06
+DATA dataByteMask16<>+3232(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+3240(SB)/8, $0x0b0a0908ff07ff06
+DATA dataByteMask16<>+3248(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+3256(SB)/8, $0x0c0b0a09ff08ff07
+DATA dataByteMask16<>+3264(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+3272(SB)/8, $0x0b0a0908ff07ff06
+DATA dataByteMask16<>+3280(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+3288(SB)/8, $0x0c0b0a09ff08ff07
+DATA dataByteMask16<>+3296(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+3304(SB)/8, $0x0c0b0a09ff08ff07
+DATA dataByteMask16<>+3312(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+3320(SB)/8, $0x0d0c0b0aff09ff08
+DATA dataByteMask16<>+3328(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+3336(SB)/8, $0x0a090807ff060504
+DATA dataByteMask16<>+3344(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+3352(SB)/8, $0x0b0a0908ff070605
+DATA dataByteMask16<>+3360(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+3368(SB)/8, $0x0b0a0908ff070605
+DATA dataByteMask16<>+3376(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+3384(SB)/8, $0x0c0b0a09ff080706
+DATA dataByteMask16<>+3392(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+3400(SB)/8, $0x0b0a0908ff070605
+DATA dataByteMask16<>+3408(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+3416(SB)/8, $0x0c0b0a09ff080706
+DATA dataByteMask16<>+3424(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+3432(SB)/8, $0x0c0b0a09ff080706
+DATA dataByteMask16<>+3440(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+3448(SB)/8, $0x0d0c0b0aff090807
+DATA dataByteMask16<>+3456(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+3464(SB)/8, $0x0b0a0908ff070605
+DATA dataByteMask16<>+3472(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+3480(SB)/8, $0x0c0b0a09ff080706
+DATA dataByteMask16<>+3488(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+3496(SB)/8, $0x0c0b0a09ff080706
+DATA dataByteMask16<>+3504(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+3512(SB)/8, $0x0d0c0b0aff090807
+DATA dataByteMask16<>+3520(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+3528(SB)/8, $0x0c0b0a09ff080706
+DATA dataByteMask16<>+3536(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+3544(SB)/8, $0x0d0c0b0aff090807
+DATA dataByteMask16<>+3552(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+3560(SB)/8, $0x0d0c0b0aff090807
+DATA dataByteMask16<>+3568(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+3576(SB)/8, $0x0e0d0c0bff0a0908
+DATA dataByteMask16<>+3584(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+3592(SB)/8, $0x0a0908070605ff04
+DATA dataByteMask16<>+3600(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+3608(SB)/8, $0x0b0a09080706ff05
+DATA dataByteMask16<>+3616(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+3624(SB)/8, $0x0b0a09080706ff05
+DATA dataByteMask16<>+3632(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+3640(SB)/8, $0x0c0b0a090807ff06
+DATA dataByteMask16<>+3648(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+3656(SB)/8, $0x0b0a09080706ff05
+DATA dataByteMask16<>+3664(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+3672(SB)/8, $0x0c0b0a090807ff06
+DATA dataByteMask16<>+3680(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+3688(SB)/8, $0x0c0b0a090807ff06
+DATA dataByteMask16<>+3696(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+3704(SB)/8, $0x0d0c0b0a0908ff07
+DATA dataByteMask16<>+3712(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+3720(SB)/8, $0x0b0a09080706ff05
+DATA dataByteMask16<>+3728(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+3736(SB)/8, $0x0c0b0a090807ff06
+DATA dataByteMask16<>+3744(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+3752(SB)/8, $0x0c0b0a090807ff06
+DATA dataByteMask16<>+3760(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+3768(SB)/8, $0x0d0c0b0a0908ff07
+DATA dataByteMask16<>+3776(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+3784(SB)/8, $0x0c0b0a090807ff06
+DATA dataByteMask16<>+3792(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+3800(SB)/8, $0x0d0c0b0a0908ff07
+DATA dataByteMask16<>+3808(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+3816(SB)/8, $0x0d0c0b0a0908ff07
+DATA dataByteMask16<>+3824(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+3832(SB)/8, $0x0e0d0c0b0a09ff08
+DATA dataByteMask16<>+3840(SB)/8, $0xff03ff02ff01ff00
+DATA dataByteMask16<>+3848(SB)/8, $0x0b0a090807060504
+DATA dataByteMask16<>+3856(SB)/8, $0xff04ff03ff020100
+DATA dataByteMask16<>+3864(SB)/8, $0x0c0b0a0908070605
+DATA dataByteMask16<>+3872(SB)/8, $0xff04ff030201ff00
+DATA dataByteMask16<>+3880(SB)/8, $0x0c0b0a0908070605
+DATA dataByteMask16<>+3888(SB)/8, $0xff05ff0403020100
+DATA dataByteMask16<>+3896(SB)/8, $0x0d0c0b0a09080706
+DATA dataByteMask16<>+3904(SB)/8, $0xff040302ff01ff00
+DATA dataByteMask16<>+3912(SB)/8, $0x0c0b0a0908070605
+DATA dataByteMask16<>+3920(SB)/8, $0xff050403ff020100
+DATA dataByteMask16<>+3928(SB)/8, $0x0d0c0b0a09080706
+DATA dataByteMask16<>+3936(SB)/8, $0xff0504030201ff00
+DATA dataByteMask16<>+3944(SB)/8, $0x0d0c0b0a09080706
+DATA dataByteMask16<>+3952(SB)/8, $0xff06050403020100
+DATA dataByteMask16<>+3960(SB)/8, $0x0e0d0c0b0a090807
+DATA dataByteMask16<>+3968(SB)/8, $0x0403ff02ff01ff00
+DATA dataByteMask16<>+3976(SB)/8, $0x0c0b0a0908070605
+DATA dataByteMask16<>+3984(SB)/8, $0x0504ff03ff020100
+DATA dataByteMask16<>+3992(SB)/8, $0x0d0c0b0a09080706
+DATA dataByteMask16<>+4000(SB)/8, $0x0504ff030201ff00
+DATA dataByteMask16<>+4008(SB)/8, $0x0d0c0b0a09080706
+DATA dataByteMask16<>+4016(SB)/8, $0x0605ff0403020100
+DATA dataByteMask16<>+4024(SB)/8, $0x0e0d0c0b0a090807
+DATA dataByteMask16<>+4032(SB)/8, $0x05040302ff01ff00
+DATA dataByteMask16<>+4040(SB)/8, $0x0d0c0b0a09080706
+DATA dataByteMask16<>+4048(SB)/8, $0x06050403ff020100
+DATA dataByteMask16<>+4056(SB)/8, $0x0e0d0c0b0a090807
+DATA dataByteMask16<>+4064(SB)/8, $0x060504030201ff00
+DATA dataByteMask16<>+4072(SB)/8, $0x0e0d0c0b0a090807
+DATA dataByteMask16<>+4080(SB)/8, $0x0706050403020100
+DATA dataByteMask16<>+4088(SB)/8, $0x0f0e0d0c0b0a0908
+GLOBL dataByteMask16<>(SB), RODATA|NOPTR, $4096
+
+// func decodeUint16SSE3(data []uint16, encoded []byte) int
+// Requires: SSE2, SSSE3
+TEXT ·decodeUint16SSE3(SB), NOSPLIT, $0-56
+	MOVQ encoded_base+24(FP), AX
+	MOVQ encoded_cap+40(FP), CX
+
+	// Revert to scalar processing if we are within 16 bytes of the end.
+	SUBQ $0x10, CX
+	MOVQ data_base+0(FP), DX
+	MOVQ data_len+8(FP), BX
+
+	// Revert to scalar processing if we have less than 8 values to process.
+	MOVQ BX, SI
+	SUBQ $0x08, SI
+
+	// Initialize the control index.
+	XORQ DI, DI
+
+	// Initialize the data index. (len(data) + 7) >> 3
+	MOVQ BX, R8
+	ADDQ $0x07, R8
+	SHRQ $0x03, R8
+
+	// Initialize the output index.
+	XORQ R9, R9
+
+	// The byte count lookup table.
+	LEAQ dataByteCount16<>+0(SB), R10
+
+	// The byte mask lookup table.
+	LEAQ dataByteMask16<>+0(SB), R11
+
+simd:
+	// Check if less than 16 encoded bytes remain and jump to scalar.
+	CMPQ R8, CX
+	JGT  scalar
+
+	// Check if less than 8 values remain and jump to scalar.
+	CMPQ R9, SI
+	JGT  scalar
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R12
+	INCQ    DI
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(R8*1), X0
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R12*1), R13
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R12
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R12*1), X0
+
+	// Store 8 uint16.
+	MOVOU X0, (DX)(R9*2)
+
+	// Increment the indices.
+	ADDQ $0x08, R9
+	ADDQ R13, R8
+	JMP  simd
+
+scalar:
+	// Process a single value at a time.
+scalarLoop:
+	CMPQ R9, BX
+	JE   done
+
+	// Determine if we need to load a new control byte.
+	TESTQ $0x00000007, R9
+	JNE   loadBytes
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R14
+	INCQ    DI
+
+loadBytes:
+	// Test the low bit of the control byte.
+	TESTQ   $0x00000001, R14
+	JNE     twoByte
+	MOVBLZX (AX)(R8*1), CX
+	INCQ    R8
+	JMP     shiftControl
+
+twoByte:
+	MOVWLZX (AX)(R8*1), CX
+	ADDQ    $0x02, R8
+
+shiftControl:
+	// Shift control byte to get next value.
+	SHRQ $0x01, R14
+	MOVW CX, (DX)(R9*2)
+	INCQ R9
+	JMP  scalarLoop
+
+done:
+	MOVQ R8, ret+48(FP)
+	RET
+
+// func decodeDeltaUint16SSE3(data []uint16, encoded []byte, previous uint16) int
+// Requires: SSE2, SSSE3
+TEXT ·decodeDeltaUint16SSE3(SB), NOSPLIT, $0-64
+	MOVQ encoded_base+24(FP), AX
+	MOVQ encoded_cap+40(FP), CX
+
+	// Revert to scalar processing if we are within 16 bytes of the end.
+	SUBQ $0x10, CX
+	MOVQ data_base+0(FP), DX
+	MOVQ data_len+8(FP), BX
+
+	// Revert to scalar processing if we have less than 8 values to process.
+	MOVQ BX, SI
+	SUBQ $0x08, SI
+
+	// Initialize the control index.
+	XORQ DI, DI
+
+	// Initialize the data index. (len(data) + 7) >> 3
+	MOVQ BX, R8
+	ADDQ $0x07, R8
+	SHRQ $0x03, R8
+
+	// Initialize the output index.
+	XORQ R9, R9
+
+	// The byte count lookup table.
+	LEAQ dataByteCount16<>+0(SB), R10
+
+	// The byte mask lookup table.
+	LEAQ    dataByteMask16<>+0(SB), R11
+	MOVWLZX previous+48(FP), R12
+	MOVD    R12, X0
+	PSHUFLW $0x00, X0, X0
+	PSHUFD  $0x00, X0, X0
+
+simd:
+	// Check if less than 16 encoded bytes remain and jump to scalar.
+	CMPQ R8, CX
+	JGT  scalar
+
+	// Check if less than 8 values remain and jump to scalar.
+	CMPQ R9, SI
+	JGT  scalar
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R12
+	INCQ    DI
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(R8*1), X1
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R12*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R12
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R12*1), X1
+
+	// Calculate prefix sum.
+	MOVOU  X1, X2
+	PSLLDQ $0x02, X2
+	PADDW  X2, X1
+	MOVOU  X1, X2
+	PSLLDQ $0x04, X2
+	PADDW  X2, X1
+	MOVOU  X1, X2
+	PSLLDQ $0x08, X2
+	PADDW  X2, X1
+
+	// Add the previous last decoded value to all lanes.
+	PADDW X0, X1
+
+	// Propagate last decoded value to all lanes of previous.
+	PSHUFHW $0xff, X1, X0
+	PSHUFD  $0xff, X0, X0
+
+	// Store 8 uint16.
+	MOVOU X1, (DX)(R9*2)
+
+	// Increment the indices.
+	ADDQ $0x08, R9
+	ADDQ R14, R8
+	JMP  simd
+
+scalar:
+	MOVD X0, R12
+
+	// Process a single value at a time.
+scalarLoop:
+	CMPQ R9, BX
+	JE   done
+
+	// Determine if we need to load a new control byte.
+	TESTQ $0x00000007, R9
+	JNE   loadBytes
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R13
+	INCQ    DI
+
+loadBytes:
+	// Test the low bit of the control byte.
+	TESTQ   $0x00000001, R13
+	JNE     twoByte
+	MOVBLZX (AX)(R8*1), CX
+	INCQ    R8
+	JMP     shiftControl
+
+twoByte:
+	MOVWLZX (AX)(R8*1), CX
+	ADDQ    $0x02, R8
+
+shiftControl:
+	// Shift control byte to get next value.
+	SHRQ $0x01, R13
+
+	// Add the previous decoded value to the delta.
+	ADDL CX, R12
+	MOVL R12, CX
+	MOVW CX, (DX)(R9*2)
+	INCQ R9
+	JMP  scalarLoop
+
+done:
+	MOVQ R8, ret+56(FP)
+	RET
+
+// func decodeInt16SSE3(data []int16, encoded []byte) int
+// Requires: SSE2, SSSE3
+TEXT ·decodeInt16SSE3(SB), NOSPLIT, $0-56
+	MOVQ encoded_base+24(FP), AX
+	MOVQ encoded_cap+40(FP), CX
+
+	// Revert to scalar processing if we are within 16 bytes of the end.
+	SUBQ $0x10, CX
+	MOVQ data_base+0(FP), DX
+	MOVQ data_len+8(FP), BX
+
+	// Revert to scalar processing if we have less than 8 values to process.
+	MOVQ BX, SI
+	SUBQ $0x08, SI
+
+	// Initialize the control index.
+	XORQ DI, DI
+
+	// Initialize the data index. (len(data) + 7) >> 3
+	MOVQ BX, R8
+	ADDQ $0x07, R8
+	SHRQ $0x03, R8
+
+	// Initialize the output index.
+	XORQ R9, R9
+
+	// The byte count lookup table.
+	LEAQ dataByteCount16<>+0(SB), R10
+
+	// The byte mask lookup table.
+	LEAQ dataByteMask16<>+0(SB), R11
+
+simd:
+	// Check if less than 16 encoded bytes remain and jump to scalar.
+	CMPQ R8, CX
+	JGT  scalar
+
+	// Check if less than 8 values remain and jump to scalar.
+	CMPQ R9, SI
+	JGT  scalar
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R12
+	INCQ    DI
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(R8*1), X0
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R12*1), R13
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R12
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R12*1), X0
+
+	// Zigzag decode.
+	MOVOU X0, X1
+
+	// (x >> 1)
+	PSRLW $0x01, X1
+
+	// Set to all ones.
+	PCMPEQW X2, X2
+
+	// Shift to one in each lane.
+	PSRLW $0x0f, X2
+
+	// (x & 1)
+	PAND X0, X2
+
+	// Set to all zeroes.
+	PXOR X0, X0
+
+	// -(x & 1)
+	PSUBW X2, X0
+
+	// (x >> 1) ^ - (x & 1)
+	PXOR X1, X0
+
+	// Store 8 uint16.
+	MOVOU X0, (DX)(R9*2)
+
+	// Increment the indices.
+	ADDQ $0x08, R9
+	ADDQ R13, R8
+	JMP  simd
+
+scalar:
+	// Process a single value at a time.
+scalarLoop:
+	CMPQ R9, BX
+	JE   done
+
+	// Determine if we need to load a new control byte.
+	TESTQ $0x00000007, R9
+	JNE   loadBytes
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R14
+	INCQ    DI
+
+loadBytes:
+	// Test the low bit of the control byte.
+	TESTQ   $0x00000001, R14
+	JNE     twoByte
+	MOVBLZX (AX)(R8*1), CX
+	INCQ    R8
+	JMP     shiftControl
+
+twoByte:
+	MOVWLZX (AX)(R8*1), CX
+	ADDQ    $0x02, R8
+
+shiftControl:
+	// Shift control byte to get next value.
+	SHRQ $0x01, R14
+
+	// Zigzag decode.
+	MOVL CX, SI
+	SHRL $0x01, SI
+	ANDL $0x01, CX
+	NEGL CX
+	XORL SI, CX
+	MOVW CX, (DX)(R9*2)
+	INCQ R9
+	JMP  scalarLoop
+
+done:
+	MOVQ R8, ret+48(FP)
+	RET
+
+// func decodeDeltaInt16SSE3(data []int16, encoded []byte, previous int16) int
+// Requires: SSE2, SSSE3
+TEXT ·decodeDeltaInt16SSE3(SB), NOSPLIT, $0-64
+	MOVQ encoded_base+24(FP), AX
+	MOVQ encoded_cap+40(FP), CX
+
+	// Revert to scalar processing if we are within 16 bytes of the end.
+	SUBQ $0x10, CX
+	MOVQ data_base+0(FP), DX
+	MOVQ data_len+8(FP), BX
+
+	// Revert to scalar processing if we have less than 8 values to process.
+	MOVQ BX, SI
+	SUBQ $0x08, SI
+
+	// Initialize the control index.
+	XORQ DI, DI
+
+	// Initialize the data index. (len(data) + 7) >> 3
+	MOVQ BX, R8
+	ADDQ $0x07, R8
+	SHRQ $0x03, R8
+
+	// Initialize the output index.
+	XORQ R9, R9
+
+	// The byte count lookup table.
+	LEAQ dataByteCount16<>+0(SB), R10
+
+	// The byte mask lookup table.
+	LEAQ    dataByteMask16<>+0(SB), R11
+	MOVWLSX previous+48(FP), R12
+	MOVD    R12, X0
+	PSHUFLW $0x00, X0, X0
+	PSHUFD  $0x00, X0, X0
+
+simd:
+	// Check if less than 16 encoded bytes remain and jump to scalar.
+	CMPQ R8, CX
+	JGT  scalar
+
+	// Check if less than 8 values remain and jump to scalar.
+	CMPQ R9, SI
+	JGT  scalar
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R12
+	INCQ    DI
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(R8*1), X1
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R12*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R12
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R12*1), X1
+
+	// Zigzag decode.
+	MOVOU X1, X2
+
+	// (x >> 1)
+	PSRLW $0x01, X2
+
+	// Set to all ones.
+	PCMPEQW X3, X3
+
+	// Shift to one in each lane.
+	PSRLW $0x0f, X3
+
+	// (x & 1)
+	PAND X1, X3
+
+	// Set to all zeroes.
+	PXOR X1, X1
+
+	// -(x & 1)
+	PSUBW X3, X1
+
+	// (x >> 1) ^ - (x & 1)
+	PXOR X2, X1
+
+	// Calculate prefix sum.
+	MOVOU  X1, X2
+	PSLLDQ $0x02, X2
+	PADDW  X2, X1
+	MOVOU  X1, X2
+	PSLLDQ $0x04, X2
+	PADDW  X2, X1
+	MOVOU  X1, X2
+	PSLLDQ $0x08, X2
+	PADDW  X2, X1
+
+	// Add the previous last decoded value to all lanes.
+	PADDW X0, X1
+
+	// Propagate last decoded value to all lanes of previous.
+	PSHUFHW $0xff, X1, X0
+	PSHUFD  $0xff, X0, X0
+
+	// Store 8 uint16.
+	MOVOU X1, (DX)(R9*2)
+
+	// Increment the indices.
+	ADDQ $0x08, R9
+	ADDQ R14, R8
+	JMP  simd
+
+scalar:
+	MOVD X0, R12
+
+	// Process a single value at a time.
+scalarLoop:
+	CMPQ R9, BX
+	JE   done
+
+	// Determine if we need to load a new control byte.
+	TESTQ $0x00000007, R9
+	JNE   loadBytes
+
+	// Load control byte.
+	MOVBQZX (AX)(DI*1), R13
+	INCQ    DI
+
+loadBytes:
+	// Test the low bit of the control byte.
+	TESTQ   $0x00000001, R13
+	JNE     twoByte
+	MOVBLZX (AX)(R8*1), CX
+	INCQ    R8
+	JMP     shiftControl
+
+twoByte:
+	MOVWLZX (AX)(R8*1), CX
+	ADDQ    $0x02, R8
+
+shiftControl:
+	// Shift control byte to get next value.
+	SHRQ $0x01, R13
+
+	// Zigzag decode.
+	MOVL CX, SI
+	SHRL $0x01, SI
+	ANDL $0x01, CX
+	NEGL CX
+	XORL SI, CX
+
+	// Add the previous decoded value to the delta.
+	ADDL CX, R12
+	MOVL R12, CX
+	MOVW CX, (DX)(R9*2)
+	INCQ R9
+	JMP  scalarLoop
+
+done:
+	MOVQ R8, ret+56(FP)
+	RET
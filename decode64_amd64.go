@@ -0,0 +1,65 @@
+//go:generate go run gen_decode64_sse3.go -out decode64_sse3_amd64.s
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+// uint64
+
+func decodeUint64(data []uint64, encoded []byte) int {
+	if activeKernel() >= KernelSSE3 {
+		return decodeUint64SSE3(data, encoded)
+	}
+	return decodeUint64scalar(data, encoded)
+}
+
+func decodeUint64SSE3(data []uint64, encoded []byte) int
+
+func decodeDeltaUint64(data []uint64, encoded []byte, previous uint64) int {
+	if activeKernel() >= KernelSSE3 {
+		return decodeDeltaUint64SSE3(data, encoded, previous)
+	}
+	return decodeDeltaUint64scalar(data, encoded, previous)
+}
+
+func decodeDeltaUint64SSE3(data []uint64, encoded []byte, previous uint64) int
+
+// int64
+
+func decodeInt64(data []int64, encoded []byte) int {
+	if activeKernel() >= KernelSSE3 {
+		return decodeInt64SSE3(data, encoded)
+	}
+	return decodeInt64scalar(data, encoded)
+}
+
+func decodeInt64SSE3(data []int64, encoded []byte) int
+
+func decodeDeltaInt64(data []int64, encoded []byte, previous int64) int {
+	if activeKernel() >= KernelSSE3 {
+		return decodeDeltaInt64SSE3(data, encoded, previous)
+	}
+	return decodeDeltaInt64scalar(data, encoded, previous)
+}
+
+func decodeDeltaInt64SSE3(data []int64, encoded []byte, previous int64) int
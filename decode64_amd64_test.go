@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"testing"
+
+	"golang.org/x/sys/cpu"
+)
+
+func TestRoundTripUint64SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	testUniformAndRandomUint64(t, encodeUint64scalar, decodeUint64SSE3, makeUniformUint64)
+}
+
+func decodeDeltaUint64SSE3Test(data []uint64, encoded []byte) int {
+	return decodeDeltaUint64SSE3(data, encoded, 0)
+}
+
+func TestRoundTripDeltaUint64SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	testUniformAndRandomUint64(t, encodeDeltaUint64scalarTest, decodeDeltaUint64SSE3Test, makeUniformDeltaUint64)
+}
+
+func TestRoundTripInt64SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	testUniformAndRandomInt64(t, encodeInt64scalar, decodeInt64SSE3, makeUniformInt64)
+}
+
+func decodeDeltaInt64SSE3Test(data []int64, encoded []byte) int {
+	return decodeDeltaInt64SSE3(data, encoded, 0)
+}
+
+func TestRoundTripDeltaInt64SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	testUniformAndRandomInt64(t, encodeDeltaInt64scalarTest, decodeDeltaInt64SSE3Test, makeUniformDeltaInt64)
+}
+
+func TestDifferentialDecodeUint64SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	size := 10000
+	data := benchUint64Data[:size]
+	encoded := make([]byte, MaxSize64(size))
+	encoded = encoded[:encodeDeltaUint64scalar(encoded, data, 7)]
+	expected, decoded := make([]uint64, size), make([]uint64, size)
+	expectedSize := decodeDeltaUint64scalar(expected, encoded, 7)
+	if decodedSize := decodeDeltaUint64SSE3(decoded, encoded, 7); decodedSize != expectedSize {
+		t.Errorf("got decodedSize: %d, expected: %d", decodedSize, expectedSize)
+	}
+	for i := range expected {
+		if decoded[i] != expected[i] {
+			t.Fatalf("got decoded[%d]: %d, expected: %d", i, decoded[i], expected[i])
+		}
+	}
+}
+
+func BenchmarkDecodeUint64SSE3(b *testing.B) {
+	if !cpu.X86.HasSSSE3 {
+		b.Skip("CPU does not support SSSE3 instructions")
+	}
+	b.SetBytes(int64(8 * benchSize))
+	benchEncodedSize = encodeUint64scalar(benchEncoded64, benchUint64Data)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeUint64SSE3(benchUint64Data, benchEncoded64)
+	}
+}
+
+func BenchmarkDecodeDeltaUint64SSE3(b *testing.B) {
+	if !cpu.X86.HasSSSE3 {
+		b.Skip("CPU does not support SSSE3 instructions")
+	}
+	b.SetBytes(int64(8 * benchSize))
+	benchEncodedSize = encodeDeltaUint64scalar(benchEncoded64, benchUint64DataSorted, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeDeltaUint64SSE3(benchUint64DataSorted, benchEncoded64, 0)
+	}
+}
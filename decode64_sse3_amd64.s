@@ -0,0 +1,1231 @@
+// Code generated by command: go run gen_decode64_sse3.go -out decode64_sse3_amd64.s. DO NOT EDIT.
+
+#include "textflag.h"
+
+DATA dataByteCount64<>+0(SB)/1, $0x02
+DATA dataByteCount64<>+1(SB)/1, $0x03
+DATA dataByteCount64<>+2(SB)/1, $0x04
+DATA dataByteCount64<>+3(SB)/1, $0x05
+DATA dataByteCount64<>+4(SB)/1, $0x06
+DATA dataByteCount64<>+5(SB)/1, $0x07
+DATA dataByteCount64<>+6(SB)/1, $0x08
+DATA dataByteCount64<>+7(SB)/1, $0x09
+DATA dataByteCount64<>+8(SB)/1, $0x03
+DATA dataByteCount64<>+9(SB)/1, $0x04
+DATA dataByteCount64<>+10(SB)/1, $0x05
+DATA dataByteCount64<>+11(SB)/1, $0x06
+DATA dataByteCount64<>+12(SB)/1, $0x07
+DATA dataByteCount64<>+13(SB)/1, $0x08
+DATA dataByteCount64<>+14(SB)/1, $0x09
+DATA dataByteCount64<>+15(SB)/1, $0x0a
+DATA dataByteCount64<>+16(SB)/1, $0x04
+DATA dataByteCount64<>+17(SB)/1, $0x05
+DATA dataByteCount64<>+18(SB)/1, $0x06
+DATA dataByteCount64<>+19(SB)/1, $0x07
+DATA dataByteCount64<>+20(SB)/1, $0x08
+DATA dataByteCount64<>+21(SB)/1, $0x09
+DATA dataByteCount64<>+22(SB)/1, $0x0a
+DATA dataByteCount64<>+23(SB)/1, $0x0b
+DATA dataByteCount64<>+24(SB)/1, $0x05
+DATA dataByteCount64<>+25(SB)/1, $0x06
+DATA dataByteCount64<>+26(SB)/1, $0x07
+DATA dataByteCount64<>+27(SB)/1, $0x08
+DATA dataByteCount64<>+28(SB)/1, $0x09
+DATA dataByteCount64<>+29(SB)/1, $0x0a
+DATA dataByteCount64<>+30(SB)/1, $0x0b
+DATA dataByteCount64<>+31(SB)/1, $0x0c
+DATA dataByteCount64<>+32(SB)/1, $0x06
+DATA dataByteCount64<>+33(SB)/1, $0x07
+DATA dataByteCount64<>+34(SB)/1, $0x08
+DATA dataByteCount64<>+35(SB)/1, $0x09
+DATA dataByteCount64<>+36(SB)/1, $0x0a
+DATA dataByteCount64<>+37(SB)/1, $0x0b
+DATA dataByteCount64<>+38(SB)/1, $0x0c
+DATA dataByteCount64<>+39(SB)/1, $0x0d
+DATA dataByteCount64<>+40(SB)/1, $0x07
+DATA dataByteCount64<>+41(SB)/1, $0x08
+DATA dataByteCount64<>+42(SB)/1, $0x09
+DATA dataByteCount64<>+43(SB)/1, $0x0a
+DATA dataByteCount64<>+44(SB)/1, $0x0b
+DATA dataByteCount64<>+45(SB)/1, $0x0c
+DATA dataByteCount64<>+46(SB)/1, $0x0d
+DATA dataByteCount64<>+47(SB)/1, $0x0e
+DATA dataByteCount64<>+48(SB)/1, $0x08
+DATA dataByteCount64<>+49(SB)/1, $0x09
+DATA dataByteCount64<>+50(SB)/1, $0x0a
+DATA dataByteCount64<>+51(SB)/1, $0x0b
+DATA dataByteCount64<>+52(SB)/1, $0x0c
+DATA dataByteCount64<>+53(SB)/1, $0x0d
+DATA dataByteCount64<>+54(SB)/1, $0x0e
+DATA dataByteCount64<>+55(SB)/1, $0x0f
+DATA dataByteCount64<>+56(SB)/1, $0x09
+DATA dataByteCount64<>+57(SB)/1, $0x0a
+DATA dataByteCount64<>+58(SB)/1, $0x0b
+DATA dataByteCount64<>+59(SB)/1, $0x0c
+DATA dataByteCount64<>+60(SB)/1, $0x0d
+DATA dataByteCount64<>+61(SB)/1, $0x0e
+DATA dataByteCount64<>+62(SB)/1, $0x0f
+DATA dataByteCount64<>+63(SB)/1, $0x10
+GLOBL dataByteCount64<>(SB), RODATA|NOPTR, $64
+
+DATA dataByteMask64<>+0(SB)/8, $0xffffffffffffff00
+DATA dataByteMask64<>+8(SB)/8, $0xffffffffffffff01
+DATA dataByteMask64<>+16(SB)/8, $0xffffffffffff0100
+DATA dataByteMask64<>+24(SB)/8, $0xffffffffffffff02
+DATA dataByteMask64<>+32(SB)/8, $0xffffffffff020100
+DATA dataByteMask64<>+40(SB)/8, $0xffffffffffffff03
+DATA dataByteMask64<>+48(SB)/8, $0xffffffff03020100
+DATA dataByteMask64<>+56(SB)/8, $0xffffffffffffff04
+DATA dataByteMask64<>+64(SB)/8, $0xffffff0403020100
+DATA dataByteMask64<>+72(SB)/8, $0xffffffffffffff05
+DATA dataByteMask64<>+80(SB)/8, $0xffff050403020100
+DATA dataByteMask64<>+88(SB)/8, $0xffffffffffffff06
+DATA dataByteMask64<>+96(SB)/8, $0xff06050403020100
+DATA dataByteMask64<>+104(SB)/8, $0xffffffffffffff07
+DATA dataByteMask64<>+112(SB)/8, $0x0706050403020100
+DATA dataByteMask64<>+120(SB)/8, $0xffffffffffffff08
+DATA dataByteMask64<>+128(SB)/8, $0xffffffffffffff00
+DATA dataByteMask64<>+136(SB)/8, $0xffffffffffff0201
+DATA dataByteMask64<>+144(SB)/8, $0xffffffffffff0100
+DATA dataByteMask64<>+152(SB)/8, $0xffffffffffff0302
+DATA dataByteMask64<>+160(SB)/8, $0xffffffffff020100
+DATA dataByteMask64<>+168(SB)/8, $0xffffffffffff0403
+DATA dataByteMask64<>+176(SB)/8, $0xffffffff03020100
+DATA dataByteMask64<>+184(SB)/8, $0xffffffffffff0504
+DATA dataByteMask64<>+192(SB)/8, $0xffffff0403020100
+DATA dataByteMask64<>+200(SB)/8, $0xffffffffffff0605
+DATA dataByteMask64<>+208(SB)/8, $0xffff050403020100
+DATA dataByteMask64<>+216(SB)/8, $0xffffffffffff0706
+DATA dataByteMask64<>+224(SB)/8, $0xff06050403020100
+DATA dataByteMask64<>+232(SB)/8, $0xffffffffffff0807
+DATA dataByteMask64<>+240(SB)/8, $0x0706050403020100
+DATA dataByteMask64<>+248(SB)/8, $0xffffffffffff0908
+DATA dataByteMask64<>+256(SB)/8, $0xffffffffffffff00
+DATA dataByteMask64<>+264(SB)/8, $0xffffffffff030201
+DATA dataByteMask64<>+272(SB)/8, $0xffffffffffff0100
+DATA dataByteMask64<>+280(SB)/8, $0xffffffffff040302
+DATA dataByteMask64<>+288(SB)/8, $0xffffffffff020100
+DATA dataByteMask64<>+296(SB)/8, $0xffffffffff050403
+DATA dataByteMask64<>+304(SB)/8, $0xffffffff03020100
+DATA dataByteMask64<>+312(SB)/8, $0xffffffffff060504
+DATA dataByteMask64<>+320(SB)/8, $0xffffff0403020100
+DATA dataByteMask64<>+328(SB)/8, $0xffffffffff070605
+DATA dataByteMask64<>+336(SB)/8, $0xffff050403020100
+DATA dataByteMask64<>+344(SB)/8, $0xffffffffff080706
+DATA dataByteMask64<>+352(SB)/8, $0xff06050403020100
+DATA dataByteMask64<>+360(SB)/8, $0xffffffffff090807
+DATA dataByteMask64<>+368(SB)/8, $0x0706050403020100
+DATA dataByteMask64<>+376(SB)/8, $0xffffffffff0a0908
+DATA dataByteMask64<>+384(SB)/8, $0xffffffffffffff00
+DATA dataByteMask64<>+392(SB)/8, $0xffffffff04030201
+DATA dataByteMask64<>+400(SB)/8, $0xffffffffffff0100
+DATA dataByteMask64<>+408(SB)/8, $0xffffffff05040302
+DATA dataByteMask64<>+416(SB)/8, $0xffffffffff020100
+DATA dataByteMask64<>+424(SB)/8, $0xffffffff06050403
+DATA dataByteMask64<>+432(SB)/8, $0xffffffff03020100
+DATA dataByteMask64<>+440(SB)/8, $0xffffffff07060504
+DATA dataByteMask64<>+448(SB)/8, $0xffffff0403020100
+DATA dataByteMask64<>+456(SB)/8, $0xffffffff08070605
+DATA dataByteMask64<>+464(SB)/8, $0xffff050403020100
+DATA dataByteMask64<>+472(SB)/8, $0xffffffff09080706
+DATA dataByteMask64<>+480(SB)/8, $0xff06050403020100
+DATA dataByteMask64<>+488(SB)/8, $0xffffffff0a090807
+DATA dataByteMask64<>+496(SB)/8, $0x0706050403020100
+DATA dataByteMask64<>+504(SB)/8, $0xffffffff0b0a0908
+DATA dataByteMask64<>+512(SB)/8, $0xffffffffffffff00
+DATA dataByteMask64<>+520(SB)/8, $0xffffff0504030201
+DATA dataByteMask64<>+528(SB)/8, $0xffffffffffff0100
+DATA dataByteMask64<>+536(SB)/8, $0xffffff0605040302
+DATA dataByteMask64<>+544(SB)/8, $0xffffffffff020100
+DATA dataByteMask64<>+552(SB)/8, $0xffffff0706050403
+DATA dataByteMask64<>+560(SB)/8, $0xffffffff03020100
+DATA dataByteMask64<>+568(SB)/8, $0xffffff0807060504
+DATA dataByteMask64<>+576(SB)/8, $0xffffff0403020100
+DATA dataByteMask64<>+584(SB)/8, $0xffffff0908070605
+DATA dataByteMask64<>+592(SB)/8, $0xffff050403020100
+DATA dataByteMask64<>+600(SB)/8, $0xffffff0a09080706
+DATA dataByteMask64<>+608(SB)/8, $0xff06050403020100
+DATA dataByteMask64<>+616(SB)/8, $0xffffff0b0a090807
+DATA dataByteMask64<>+624(SB)/8, $0x0706050403020100
+DATA dataByteMask64<>+632(SB)/8, $0xffffff0c0b0a0908
+DATA dataByteMask64<>+640(SB)/8, $0xffffffffffffff00
+DATA dataByteMask64<>+648(SB)/8, $0xffff060504030201
+DATA dataByteMask64<>+656(SB)/8, $0xffffffffffff0100
+DATA dataByteMask64<>+664(SB)/8, $0xffff070605040302
+DATA dataByteMask64<>+672(SB)/8, $0xffffffffff020100
+DATA dataByteMask64<>+680(SB)/8, $0xffff080706050403
+DATA dataByteMask64<>+688(SB)/8, $0xffffffff03020100
+DATA dataByteMask64<>+696(SB)/8, $0xffff090807060504
+DATA dataByteMask64<>+704(SB)/8, $0xffffff0403020100
+DATA dataByteMask64<>+712(SB)/8, $0xffff0a0908070605
+DATA dataByteMask64<>+720(SB)/8, $0xffff050403020100
+DATA dataByteMask64<>+728(SB)/8, $0xffff0b0a09080706
+DATA dataByteMask64<>+736(SB)/8, $0xff06050403020100
+DATA dataByteMask64<>+744(SB)/8, $0xffff0c0b0a090807
+DATA dataByteMask64<>+752(SB)/8, $0x0706050403020100
+DATA dataByteMask64<>+760(SB)/8, $0xffff0d0c0b0a0908
+DATA dataByteMask64<>+768(SB)/8, $0xffffffffffffff00
+DATA dataByteMask64<>+776(SB)/8, $0xff07060504030201
+DATA dataByteMask64<>+784(SB)/8, $0xffffffffffff0100
+DATA dataByteMask64<>+792(SB)/8, $0xff08070605040302
+DATA dataByteMask64<>+800(SB)/8, $0xffffffffff020100
+DATA dataByteMask64<>+808(SB)/8, $0xff09080706050403
+DATA dataByteMask64<>+816(SB)/8, $0xffffffff03020100
+DATA dataByteMask64<>+824(SB)/8, $0xff0a090807060504
+DATA dataByteMask64<>+832(SB)/8, $0xffffff0403020100
+DATA dataByteMask64<>+840(SB)/8, $0xff0b0a0908070605
+DATA dataByteMask64<>+848(SB)/8, $0xffff050403020100
+DATA dataByteMask64<>+856(SB)/8, $0xff0c0b0a09080706
+DATA dataByteMask64<>+864(SB)/8, $0xff06050403020100
+DATA dataByteMask64<>+872(SB)/8, $0xff0d0c0b0a090807
+DATA dataByteMask64<>+880(SB)/8, $0x0706050403020100
+DATA dataByteMask64<>+888(SB)/8, $0xff0e0d0c0b0a0908
+DATA dataByteMask64<>+896(SB)/8, $0xffffffffffffff00
+DATA dataByteMask64<>+904(SB)/8, $0x0807060504030201
+DATA dataByteMask64<>+912(SB)/8, $0xffffffffffff0100
+DATA dataByteMask64<>+920(SB)/8, $0x0908070605040302
+DATA dataByteMask64<>+928(SB)/8, $0xffffffffff020100
+DATA dataByteMask64<>+936(SB)/8, $0x0a09080706050403
+DATA dataByteMask64<>+944(SB)/8, $0xffffffff03020100
+DATA dataByteMask64<>+952(SB)/8, $0x0b0a090807060504
+DATA dataByteMask64<>+960(SB)/8, $0xffffff0403020100
+DATA dataByteMask64<>+968(SB)/8, $0x0c0b0a0908070605
+DATA dataByteMask64<>+976(SB)/8, $0xffff050403020100
+DATA dataByteMask64<>+984(SB)/8, $0x0d0c0b0a09080706
+DATA dataByteMask64<>+992(SB)/8, $0xff06050403020100
+DATA dataByteMask64<>+1000(SB)/8, $0x0e0d0c0b0a090807
+DATA dataByteMask64<>+1008(SB)/8, $0x0706050403020100
+DATA dataByteMask64<>+1016(SB)/8, $0x0f0e0d0c0b0a0908
+GLOBL dataByteMask64<>(SB), RODATA|NOPTR, $1024
+
+// func decodeUint64SSE3(data []uint64, encoded []byte) int
+// Requires: SSE2, SSSE3
+TEXT ·decodeUint64SSE3(SB), NOSPLIT, $0-56
+	MOVQ encoded_base+24(FP), AX
+	MOVQ encoded_cap+40(FP), CX
+
+	// Revert to scalar processing if we are within 64 bytes of the end.
+	SUBQ $0x40, CX
+	MOVQ data_base+0(FP), DX
+	MOVQ data_len+8(FP), BX
+
+	// Revert to scalar processing if we have less than 8 values to process.
+	MOVQ BX, R8
+	SUBQ $0x08, R8
+
+	// Initialize the control index.
+	XORQ R9, R9
+
+	// Initialize the data index. (3*len(data) + 7) >> 3
+	LEAQ 7(BX)(BX*2), SI
+	SHRQ $0x03, SI
+
+	// Initialize the output index.
+	XORQ DI, DI
+
+	// The byte count lookup table.
+	LEAQ dataByteCount64<>+0(SB), R10
+
+	// The byte mask lookup table.
+	LEAQ dataByteMask64<>+0(SB), R11
+
+simd:
+	// Check if less than 64 encoded bytes remain and jump to scalar.
+	CMPQ SI, CX
+	JGT  scalar
+
+	// Check if less than 8 values remain and jump to scalar.
+	CMPQ DI, R8
+	JGT  scalar
+
+	// Load 3 control bytes holding 8 codes.
+	MOVWLZX (AX)(R9*1), R12
+	MOVBLZX 2(AX)(R9*1), R13
+	SHLL    $0x10, R13
+	ORL     R13, R12
+	ADDQ    $0x03, R9
+
+	// Extract the codes of the next 2 values.
+	MOVL R12, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R12
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X0
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R13*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R13*1), X0
+
+	// Store 2 uint64.
+	MOVOU X0, (DX)(DI*8)
+	ADDQ  R14, SI
+
+	// Extract the codes of the next 2 values.
+	MOVL R12, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R12
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X0
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R13*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R13*1), X0
+
+	// Store 2 uint64.
+	MOVOU X0, 16(DX)(DI*8)
+	ADDQ  R14, SI
+
+	// Extract the codes of the next 2 values.
+	MOVL R12, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R12
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X0
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R13*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R13*1), X0
+
+	// Store 2 uint64.
+	MOVOU X0, 32(DX)(DI*8)
+	ADDQ  R14, SI
+
+	// Extract the codes of the next 2 values.
+	MOVL R12, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R12
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X0
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R13*1), R12
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R13*1), X0
+
+	// Store 2 uint64.
+	MOVOU X0, 48(DX)(DI*8)
+	ADDQ  R12, SI
+
+	// Increment the output index.
+	ADDQ $0x08, DI
+	JMP  simd
+
+scalar:
+	// Process a single value at a time.
+scalarLoop:
+	CMPQ DI, BX
+	JE   done
+
+	// Locate the 3-bit code of value n at bit 3*n of the control bytes.
+	LEAQ    (DI)(DI*2), CX
+	MOVQ    CX, R8
+	SHRQ    $0x03, R8
+	MOVWQZX (AX)(R8*1), R8
+	ANDQ    $0x07, CX
+	SHRQ    CL, R8
+	ANDQ    $0x07, R8
+
+	// Read code+1 bytes from the most significant down.
+	XORQ CX, CX
+	MOVQ R8, R9
+	LEAQ (AX)(SI*1), R10
+
+loadByte:
+	SHLQ    $0x08, CX
+	MOVBQZX (R10)(R9*1), R11
+	ORQ     R11, CX
+	DECQ    R9
+	JGE     loadByte
+
+	// Increment the data index by code+1.
+	LEAQ 1(SI)(R8*1), SI
+	MOVQ CX, (DX)(DI*8)
+	INCQ DI
+	JMP  scalarLoop
+
+done:
+	MOVQ SI, ret+48(FP)
+	RET
+
+// func decodeDeltaUint64SSE3(data []uint64, encoded []byte, previous uint64) int
+// Requires: SSE2, SSSE3
+TEXT ·decodeDeltaUint64SSE3(SB), NOSPLIT, $0-64
+	MOVQ encoded_base+24(FP), AX
+	MOVQ encoded_cap+40(FP), CX
+
+	// Revert to scalar processing if we are within 64 bytes of the end.
+	SUBQ $0x40, CX
+	MOVQ data_base+0(FP), DX
+	MOVQ data_len+8(FP), BX
+
+	// Revert to scalar processing if we have less than 8 values to process.
+	MOVQ BX, R9
+	SUBQ $0x08, R9
+
+	// Initialize the control index.
+	XORQ R10, R10
+
+	// Initialize the data index. (3*len(data) + 7) >> 3
+	LEAQ 7(BX)(BX*2), SI
+	SHRQ $0x03, SI
+
+	// Initialize the output index.
+	XORQ DI, DI
+
+	// The byte count lookup table.
+	LEAQ dataByteCount64<>+0(SB), R11
+
+	// The byte mask lookup table.
+	LEAQ   dataByteMask64<>+0(SB), R12
+	MOVQ   previous+48(FP), R8
+	MOVQ   R8, X0
+	PSHUFD $0x44, X0, X0
+
+simd:
+	// Check if less than 64 encoded bytes remain and jump to scalar.
+	CMPQ SI, CX
+	JGT  scalar
+
+	// Check if less than 8 values remain and jump to scalar.
+	CMPQ DI, R9
+	JGT  scalar
+
+	// Load 3 control bytes holding 8 codes.
+	MOVWLZX (AX)(R10*1), R8
+	MOVBLZX 2(AX)(R10*1), R13
+	SHLL    $0x10, R13
+	ORL     R13, R8
+	ADDQ    $0x03, R10
+
+	// Extract the codes of the next 2 values.
+	MOVL R8, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R8
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X1
+
+	// Lookup count to increment data index.
+	MOVBQZX (R11)(R13*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R12)(R13*1), X1
+
+	// Calculate prefix sum.
+	MOVOU X1, X2
+
+	// (0, delta_0)
+	PSLLDQ $0x08, X2
+
+	// (delta_0, delta_0 + delta_1)
+	PADDQ X2, X1
+
+	// Add the previous last decoded value to all lanes.
+	PADDQ X0, X1
+
+	// Propagate last decoded value to all lanes of previous.
+	PSHUFD $0xee, X1, X0
+
+	// Store 2 uint64.
+	MOVOU X1, (DX)(DI*8)
+	ADDQ  R14, SI
+
+	// Extract the codes of the next 2 values.
+	MOVL R8, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R8
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X1
+
+	// Lookup count to increment data index.
+	MOVBQZX (R11)(R13*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R12)(R13*1), X1
+
+	// Calculate prefix sum.
+	MOVOU X1, X2
+
+	// (0, delta_0)
+	PSLLDQ $0x08, X2
+
+	// (delta_0, delta_0 + delta_1)
+	PADDQ X2, X1
+
+	// Add the previous last decoded value to all lanes.
+	PADDQ X0, X1
+
+	// Propagate last decoded value to all lanes of previous.
+	PSHUFD $0xee, X1, X0
+
+	// Store 2 uint64.
+	MOVOU X1, 16(DX)(DI*8)
+	ADDQ  R14, SI
+
+	// Extract the codes of the next 2 values.
+	MOVL R8, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R8
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X1
+
+	// Lookup count to increment data index.
+	MOVBQZX (R11)(R13*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R12)(R13*1), X1
+
+	// Calculate prefix sum.
+	MOVOU X1, X2
+
+	// (0, delta_0)
+	PSLLDQ $0x08, X2
+
+	// (delta_0, delta_0 + delta_1)
+	PADDQ X2, X1
+
+	// Add the previous last decoded value to all lanes.
+	PADDQ X0, X1
+
+	// Propagate last decoded value to all lanes of previous.
+	PSHUFD $0xee, X1, X0
+
+	// Store 2 uint64.
+	MOVOU X1, 32(DX)(DI*8)
+	ADDQ  R14, SI
+
+	// Extract the codes of the next 2 values.
+	MOVL R8, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R8
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X1
+
+	// Lookup count to increment data index.
+	MOVBQZX (R11)(R13*1), R8
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R12)(R13*1), X1
+
+	// Calculate prefix sum.
+	MOVOU X1, X2
+
+	// (0, delta_0)
+	PSLLDQ $0x08, X2
+
+	// (delta_0, delta_0 + delta_1)
+	PADDQ X2, X1
+
+	// Add the previous last decoded value to all lanes.
+	PADDQ X0, X1
+
+	// Propagate last decoded value to all lanes of previous.
+	PSHUFD $0xee, X1, X0
+
+	// Store 2 uint64.
+	MOVOU X1, 48(DX)(DI*8)
+	ADDQ  R8, SI
+
+	// Increment the output index.
+	ADDQ $0x08, DI
+	JMP  simd
+
+scalar:
+	MOVQ X0, R8
+
+	// Process a single value at a time.
+scalarLoop:
+	CMPQ DI, BX
+	JE   done
+
+	// Locate the 3-bit code of value n at bit 3*n of the control bytes.
+	LEAQ    (DI)(DI*2), CX
+	MOVQ    CX, R9
+	SHRQ    $0x03, R9
+	MOVWQZX (AX)(R9*1), R9
+	ANDQ    $0x07, CX
+	SHRQ    CL, R9
+	ANDQ    $0x07, R9
+
+	// Read code+1 bytes from the most significant down.
+	XORQ CX, CX
+	MOVQ R9, R10
+	LEAQ (AX)(SI*1), R11
+
+loadByte:
+	SHLQ    $0x08, CX
+	MOVBQZX (R11)(R10*1), R12
+	ORQ     R12, CX
+	DECQ    R10
+	JGE     loadByte
+
+	// Increment the data index by code+1.
+	LEAQ 1(SI)(R9*1), SI
+
+	// Add the previous decoded value to the delta.
+	ADDQ CX, R8
+	MOVQ R8, CX
+	MOVQ CX, (DX)(DI*8)
+	INCQ DI
+	JMP  scalarLoop
+
+done:
+	MOVQ SI, ret+56(FP)
+	RET
+
+// func decodeInt64SSE3(data []int64, encoded []byte) int
+// Requires: SSE2, SSSE3
+TEXT ·decodeInt64SSE3(SB), NOSPLIT, $0-56
+	MOVQ encoded_base+24(FP), AX
+	MOVQ encoded_cap+40(FP), CX
+
+	// Revert to scalar processing if we are within 64 bytes of the end.
+	SUBQ $0x40, CX
+	MOVQ data_base+0(FP), DX
+	MOVQ data_len+8(FP), BX
+
+	// Revert to scalar processing if we have less than 8 values to process.
+	MOVQ BX, R8
+	SUBQ $0x08, R8
+
+	// Initialize the control index.
+	XORQ R9, R9
+
+	// Initialize the data index. (3*len(data) + 7) >> 3
+	LEAQ 7(BX)(BX*2), SI
+	SHRQ $0x03, SI
+
+	// Initialize the output index.
+	XORQ DI, DI
+
+	// The byte count lookup table.
+	LEAQ dataByteCount64<>+0(SB), R10
+
+	// The byte mask lookup table.
+	LEAQ dataByteMask64<>+0(SB), R11
+
+simd:
+	// Check if less than 64 encoded bytes remain and jump to scalar.
+	CMPQ SI, CX
+	JGT  scalar
+
+	// Check if less than 8 values remain and jump to scalar.
+	CMPQ DI, R8
+	JGT  scalar
+
+	// Load 3 control bytes holding 8 codes.
+	MOVWLZX (AX)(R9*1), R12
+	MOVBLZX 2(AX)(R9*1), R13
+	SHLL    $0x10, R13
+	ORL     R13, R12
+	ADDQ    $0x03, R9
+
+	// Extract the codes of the next 2 values.
+	MOVL R12, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R12
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X0
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R13*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R13*1), X0
+
+	// Zigzag decode.
+	MOVOU X0, X1
+
+	// (x >> 1)
+	PSRLQ $0x01, X1
+
+	// Set to all ones.
+	PCMPEQL X2, X2
+
+	// Shift to one in each lane.
+	PSRLQ $0x3f, X2
+
+	// (x & 1)
+	PAND X0, X2
+
+	// Set to all zeroes.
+	PXOR X0, X0
+
+	// -(x & 1)
+	PSUBQ X2, X0
+
+	// (x >> 1) ^ - (x & 1)
+	PXOR X1, X0
+
+	// Store 2 uint64.
+	MOVOU X0, (DX)(DI*8)
+	ADDQ  R14, SI
+
+	// Extract the codes of the next 2 values.
+	MOVL R12, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R12
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X0
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R13*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R13*1), X0
+
+	// Zigzag decode.
+	MOVOU X0, X1
+
+	// (x >> 1)
+	PSRLQ $0x01, X1
+
+	// Set to all ones.
+	PCMPEQL X2, X2
+
+	// Shift to one in each lane.
+	PSRLQ $0x3f, X2
+
+	// (x & 1)
+	PAND X0, X2
+
+	// Set to all zeroes.
+	PXOR X0, X0
+
+	// -(x & 1)
+	PSUBQ X2, X0
+
+	// (x >> 1) ^ - (x & 1)
+	PXOR X1, X0
+
+	// Store 2 uint64.
+	MOVOU X0, 16(DX)(DI*8)
+	ADDQ  R14, SI
+
+	// Extract the codes of the next 2 values.
+	MOVL R12, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R12
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X0
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R13*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R13*1), X0
+
+	// Zigzag decode.
+	MOVOU X0, X1
+
+	// (x >> 1)
+	PSRLQ $0x01, X1
+
+	// Set to all ones.
+	PCMPEQL X2, X2
+
+	// Shift to one in each lane.
+	PSRLQ $0x3f, X2
+
+	// (x & 1)
+	PAND X0, X2
+
+	// Set to all zeroes.
+	PXOR X0, X0
+
+	// -(x & 1)
+	PSUBQ X2, X0
+
+	// (x >> 1) ^ - (x & 1)
+	PXOR X1, X0
+
+	// Store 2 uint64.
+	MOVOU X0, 32(DX)(DI*8)
+	ADDQ  R14, SI
+
+	// Extract the codes of the next 2 values.
+	MOVL R12, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R12
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X0
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R13*1), R12
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R13*1), X0
+
+	// Zigzag decode.
+	MOVOU X0, X1
+
+	// (x >> 1)
+	PSRLQ $0x01, X1
+
+	// Set to all ones.
+	PCMPEQL X2, X2
+
+	// Shift to one in each lane.
+	PSRLQ $0x3f, X2
+
+	// (x & 1)
+	PAND X0, X2
+
+	// Set to all zeroes.
+	PXOR X0, X0
+
+	// -(x & 1)
+	PSUBQ X2, X0
+
+	// (x >> 1) ^ - (x & 1)
+	PXOR X1, X0
+
+	// Store 2 uint64.
+	MOVOU X0, 48(DX)(DI*8)
+	ADDQ  R12, SI
+
+	// Increment the output index.
+	ADDQ $0x08, DI
+	JMP  simd
+
+scalar:
+	// Process a single value at a time.
+scalarLoop:
+	CMPQ DI, BX
+	JE   done
+
+	// Locate the 3-bit code of value n at bit 3*n of the control bytes.
+	LEAQ    (DI)(DI*2), CX
+	MOVQ    CX, R8
+	SHRQ    $0x03, R8
+	MOVWQZX (AX)(R8*1), R8
+	ANDQ    $0x07, CX
+	SHRQ    CL, R8
+	ANDQ    $0x07, R8
+
+	// Read code+1 bytes from the most significant down.
+	XORQ CX, CX
+	MOVQ R8, R9
+	LEAQ (AX)(SI*1), R10
+
+loadByte:
+	SHLQ    $0x08, CX
+	MOVBQZX (R10)(R9*1), R11
+	ORQ     R11, CX
+	DECQ    R9
+	JGE     loadByte
+
+	// Increment the data index by code+1.
+	LEAQ 1(SI)(R8*1), SI
+
+	// Zigzag decode.
+	MOVQ CX, R8
+	SHRQ $0x01, R8
+	ANDQ $0x01, CX
+	NEGQ CX
+	XORQ R8, CX
+	MOVQ CX, (DX)(DI*8)
+	INCQ DI
+	JMP  scalarLoop
+
+done:
+	MOVQ SI, ret+48(FP)
+	RET
+
+// func decodeDeltaInt64SSE3(data []int64, encoded []byte, previous int64) int
+// Requires: SSE2, SSSE3
+TEXT ·decodeDeltaInt64SSE3(SB), NOSPLIT, $0-64
+	MOVQ encoded_base+24(FP), AX
+	MOVQ encoded_cap+40(FP), CX
+
+	// Revert to scalar processing if we are within 64 bytes of the end.
+	SUBQ $0x40, CX
+	MOVQ data_base+0(FP), DX
+	MOVQ data_len+8(FP), BX
+
+	// Revert to scalar processing if we have less than 8 values to process.
+	MOVQ BX, R9
+	SUBQ $0x08, R9
+
+	// Initialize the control index.
+	XORQ R10, R10
+
+	// Initialize the data index. (3*len(data) + 7) >> 3
+	LEAQ 7(BX)(BX*2), SI
+	SHRQ $0x03, SI
+
+	// Initialize the output index.
+	XORQ DI, DI
+
+	// The byte count lookup table.
+	LEAQ dataByteCount64<>+0(SB), R11
+
+	// The byte mask lookup table.
+	LEAQ   dataByteMask64<>+0(SB), R12
+	MOVQ   previous+48(FP), R8
+	MOVQ   R8, X0
+	PSHUFD $0x44, X0, X0
+
+simd:
+	// Check if less than 64 encoded bytes remain and jump to scalar.
+	CMPQ SI, CX
+	JGT  scalar
+
+	// Check if less than 8 values remain and jump to scalar.
+	CMPQ DI, R9
+	JGT  scalar
+
+	// Load 3 control bytes holding 8 codes.
+	MOVWLZX (AX)(R10*1), R8
+	MOVBLZX 2(AX)(R10*1), R13
+	SHLL    $0x10, R13
+	ORL     R13, R8
+	ADDQ    $0x03, R10
+
+	// Extract the codes of the next 2 values.
+	MOVL R8, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R8
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X1
+
+	// Lookup count to increment data index.
+	MOVBQZX (R11)(R13*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R12)(R13*1), X1
+
+	// Zigzag decode.
+	MOVOU X1, X2
+
+	// (x >> 1)
+	PSRLQ $0x01, X2
+
+	// Set to all ones.
+	PCMPEQL X3, X3
+
+	// Shift to one in each lane.
+	PSRLQ $0x3f, X3
+
+	// (x & 1)
+	PAND X1, X3
+
+	// Set to all zeroes.
+	PXOR X1, X1
+
+	// -(x & 1)
+	PSUBQ X3, X1
+
+	// (x >> 1) ^ - (x & 1)
+	PXOR X2, X1
+
+	// Calculate prefix sum.
+	MOVOU X1, X2
+
+	// (0, delta_0)
+	PSLLDQ $0x08, X2
+
+	// (delta_0, delta_0 + delta_1)
+	PADDQ X2, X1
+
+	// Add the previous last decoded value to all lanes.
+	PADDQ X0, X1
+
+	// Propagate last decoded value to all lanes of previous.
+	PSHUFD $0xee, X1, X0
+
+	// Store 2 uint64.
+	MOVOU X1, (DX)(DI*8)
+	ADDQ  R14, SI
+
+	// Extract the codes of the next 2 values.
+	MOVL R8, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R8
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X1
+
+	// Lookup count to increment data index.
+	MOVBQZX (R11)(R13*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R12)(R13*1), X1
+
+	// Zigzag decode.
+	MOVOU X1, X2
+
+	// (x >> 1)
+	PSRLQ $0x01, X2
+
+	// Set to all ones.
+	PCMPEQL X3, X3
+
+	// Shift to one in each lane.
+	PSRLQ $0x3f, X3
+
+	// (x & 1)
+	PAND X1, X3
+
+	// Set to all zeroes.
+	PXOR X1, X1
+
+	// -(x & 1)
+	PSUBQ X3, X1
+
+	// (x >> 1) ^ - (x & 1)
+	PXOR X2, X1
+
+	// Calculate prefix sum.
+	MOVOU X1, X2
+
+	// (0, delta_0)
+	PSLLDQ $0x08, X2
+
+	// (delta_0, delta_0 + delta_1)
+	PADDQ X2, X1
+
+	// Add the previous last decoded value to all lanes.
+	PADDQ X0, X1
+
+	// Propagate last decoded value to all lanes of previous.
+	PSHUFD $0xee, X1, X0
+
+	// Store 2 uint64.
+	MOVOU X1, 16(DX)(DI*8)
+	ADDQ  R14, SI
+
+	// Extract the codes of the next 2 values.
+	MOVL R8, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R8
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X1
+
+	// Lookup count to increment data index.
+	MOVBQZX (R11)(R13*1), R14
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R12)(R13*1), X1
+
+	// Zigzag decode.
+	MOVOU X1, X2
+
+	// (x >> 1)
+	PSRLQ $0x01, X2
+
+	// Set to all ones.
+	PCMPEQL X3, X3
+
+	// Shift to one in each lane.
+	PSRLQ $0x3f, X3
+
+	// (x & 1)
+	PAND X1, X3
+
+	// Set to all zeroes.
+	PXOR X1, X1
+
+	// -(x & 1)
+	PSUBQ X3, X1
+
+	// (x >> 1) ^ - (x & 1)
+	PXOR X2, X1
+
+	// Calculate prefix sum.
+	MOVOU X1, X2
+
+	// (0, delta_0)
+	PSLLDQ $0x08, X2
+
+	// (delta_0, delta_0 + delta_1)
+	PADDQ X2, X1
+
+	// Add the previous last decoded value to all lanes.
+	PADDQ X0, X1
+
+	// Propagate last decoded value to all lanes of previous.
+	PSHUFD $0xee, X1, X0
+
+	// Store 2 uint64.
+	MOVOU X1, 32(DX)(DI*8)
+	ADDQ  R14, SI
+
+	// Extract the codes of the next 2 values.
+	MOVL R8, R13
+	ANDL $0x3f, R13
+	SHRL $0x06, R8
+
+	// Load 16 data bytes into XMM.
+	MOVOU (AX)(SI*1), X1
+
+	// Lookup count to increment data index.
+	MOVBQZX (R11)(R13*1), R8
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R13
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R12)(R13*1), X1
+
+	// Zigzag decode.
+	MOVOU X1, X2
+
+	// (x >> 1)
+	PSRLQ $0x01, X2
+
+	// Set to all ones.
+	PCMPEQL X3, X3
+
+	// Shift to one in each lane.
+	PSRLQ $0x3f, X3
+
+	// (x & 1)
+	PAND X1, X3
+
+	// Set to all zeroes.
+	PXOR X1, X1
+
+	// -(x & 1)
+	PSUBQ X3, X1
+
+	// (x >> 1) ^ - (x & 1)
+	PXOR X2, X1
+
+	// Calculate prefix sum.
+	MOVOU X1, X2
+
+	// (0, delta_0)
+	PSLLDQ $0x08, X2
+
+	// (delta_0, delta_0 + delta_1)
+	PADDQ X2, X1
+
+	// Add the previous last decoded value to all lanes.
+	PADDQ X0, X1
+
+	// Propagate last decoded value to all lanes of previous.
+	PSHUFD $0xee, X1, X0
+
+	// Store 2 uint64.
+	MOVOU X1, 48(DX)(DI*8)
+	ADDQ  R8, SI
+
+	// Increment the output index.
+	ADDQ $0x08, DI
+	JMP  simd
+
+scalar:
+	MOVQ X0, R8
+
+	// Process a single value at a time.
+scalarLoop:
+	CMPQ DI, BX
+	JE   done
+
+	// Locate the 3-bit code of value n at bit 3*n of the control bytes.
+	LEAQ    (DI)(DI*2), CX
+	MOVQ    CX, R9
+	SHRQ    $0x03, R9
+	MOVWQZX (AX)(R9*1), R9
+	ANDQ    $0x07, CX
+	SHRQ    CL, R9
+	ANDQ    $0x07, R9
+
+	// Read code+1 bytes from the most significant down.
+	XORQ CX, CX
+	MOVQ R9, R10
+	LEAQ (AX)(SI*1), R11
+
+loadByte:
+	SHLQ    $0x08, CX
+	MOVBQZX (R11)(R10*1), R12
+	ORQ     R12, CX
+	DECQ    R10
+	JGE     loadByte
+
+	// Increment the data index by code+1.
+	LEAQ 1(SI)(R9*1), SI
+
+	// Zigzag decode.
+	MOVQ CX, R9
+	SHRQ $0x01, R9
+	ANDQ $0x01, CX
+	NEGQ CX
+	XORQ R9, CX
+
+	// Add the previous decoded value to the delta.
+	ADDQ CX, R8
+	MOVQ R8, CX
+	MOVQ CX, (DX)(DI*8)
+	INCQ DI
+	JMP  scalarLoop
+
+done:
+	MOVQ SI, ret+56(FP)
+	RET
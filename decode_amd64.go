@@ -1,4 +1,6 @@
 //go:generate go run gen_decode_sse3.go -out decode_sse3_amd64.s
+//go:generate go run gen_decode_avx2.go -out decode_avx2_amd64.s
+//go:generate go run gen_decode_avx512.go -out decode_avx512_amd64.s
 
 /*
 Copyright (c) 2020 Brian M. Kessler
@@ -28,50 +30,96 @@ import (
 	"golang.org/x/sys/cpu"
 )
 
+// hasSSSE3 reports whether the SSE3 decoders can be used, despite the name
+// they rely on the PSHUFB instruction from the SSSE3 extension.
+var hasSSSE3 = cpu.X86.HasSSSE3
+
+// hasAVX512VBMI reports whether the AVX-512 decoders can be used, they
+// rely on the VPERMB instruction from the VBMI extension and on EVEX encoded
+// XMM instructions from the VL extension.
+var hasAVX512VBMI = cpu.X86.HasAVX512F && cpu.X86.HasAVX512BW && cpu.X86.HasAVX512VL &&
+	cpu.X86.HasAVX512VBMI
+
 // uint32
 
-func decodeUint32(data []uint32, encoded []byte) {
-	if cpu.X86.HasSSE3 {
-		decodeUint32SSE3(data, encoded)
-		return
+func decodeUint32(data []uint32, encoded []byte) int {
+	switch activeKernel() {
+	case KernelAVX512:
+		return decodeUint32AVX512(data, encoded)
+	case KernelAVX2:
+		return decodeUint32AVX2(data, encoded)
+	case KernelSSE3:
+		return decodeUint32SSE3(data, encoded)
+	case KernelSWAR:
+		return decodeUint32SWAR(data, encoded)
 	}
-	decodeUint32scalar(data, encoded)
-	return
+	return decodeUint32scalar(data, encoded)
 }
 
-func decodeUint32SSE3(data []uint32, encoded []byte)
+func decodeUint32SSE3(data []uint32, encoded []byte) int
+
+func decodeUint32AVX2(data []uint32, encoded []byte) int
 
-func decodeDeltaUint32(data []uint32, encoded []byte, previous uint32) {
-	if cpu.X86.HasSSE3 {
-		decodeDeltaUint32scalar(data, encoded, previous)
-		return
+func decodeUint32AVX512(data []uint32, encoded []byte) int
+
+func decodeDeltaUint32(data []uint32, encoded []byte, previous uint32) int {
+	switch activeKernel() {
+	case KernelAVX512:
+		return decodeDeltaUint32AVX512(data, encoded, previous)
+	case KernelAVX2:
+		return decodeDeltaUint32AVX2(data, encoded, previous)
+	case KernelSSE3:
+		return decodeDeltaUint32SSE3(data, encoded, previous)
+	case KernelSWAR:
+		return decodeDeltaUint32SWAR(data, encoded, previous)
 	}
-	decodeDeltaUint32scalar(data, encoded, previous)
-	return
+	return decodeDeltaUint32scalar(data, encoded, previous)
 }
 
-func decodeDeltaUint32SSE3(data []uint32, encoded []byte, previous uint32)
+func decodeDeltaUint32SSE3(data []uint32, encoded []byte, previous uint32) int
+
+func decodeDeltaUint32AVX2(data []uint32, encoded []byte, previous uint32) int
+
+func decodeDeltaUint32AVX512(data []uint32, encoded []byte, previous uint32) int
 
 // int32
 
-func decodeInt32(data []int32, encoded []byte) {
-	if cpu.X86.HasSSE3 {
-		decodeInt32SSE3(data, encoded)
-		return
+func decodeInt32(data []int32, encoded []byte) int {
+	switch activeKernel() {
+	case KernelAVX512:
+		return decodeInt32AVX512(data, encoded)
+	case KernelAVX2:
+		return decodeInt32AVX2(data, encoded)
+	case KernelSSE3:
+		return decodeInt32SSE3(data, encoded)
+	case KernelSWAR:
+		return decodeInt32SWAR(data, encoded)
 	}
-	decodeInt32scalar(data, encoded)
-	return
+	return decodeInt32scalar(data, encoded)
 }
 
-func decodeInt32SSE3(data []int32, encoded []byte)
+func decodeInt32SSE3(data []int32, encoded []byte) int
+
+func decodeInt32AVX2(data []int32, encoded []byte) int
+
+func decodeInt32AVX512(data []int32, encoded []byte) int
 
-func decodeDeltaInt32(data []int32, encoded []byte, previous int32) {
-	if cpu.X86.HasSSE3 {
-		decodeDeltaInt32scalar(data, encoded, previous)
-		return
+func decodeDeltaInt32(data []int32, encoded []byte, previous int32) int {
+	switch activeKernel() {
+	case KernelAVX512:
+		return decodeDeltaInt32AVX512(data, encoded, previous)
+	case KernelAVX2:
+		return decodeDeltaInt32AVX2(data, encoded, previous)
+	case KernelSSE3:
+		return decodeDeltaInt32SSE3(data, encoded, previous)
+	case KernelSWAR:
+		return decodeDeltaInt32SWAR(data, encoded, previous)
 	}
-	decodeDeltaInt32scalar(data, encoded, previous)
-	return
+	return decodeDeltaInt32scalar(data, encoded, previous)
 }
 
-func decodeDeltaInt32SSE3(data []int32, encoded []byte, previous int32)
+func decodeDeltaInt32SSE3(data []int32, encoded []byte, previous int32) int
+
+func decodeDeltaInt32AVX2(data []int32, encoded []byte, previous int32) int
+
+func decodeDeltaInt32AVX512(data []int32, encoded []byte, previous int32) int
@@ -30,23 +30,26 @@ import (
 
 // uint32
 func TestRoundTripUint32SSE3(t *testing.T) {
-	if !cpu.X86.HasSSE3 {
-		t.Skip("CPU does not support SSE3 instructions")
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
 	}
 	testUniformAndRandomUint32(t, encodeUint32scalar, decodeUint32SSE3)
 }
 
-func decodeDeltaUint32SSE3Test(data []uint32, encoded []byte) {
-	decodeDeltaUint32SSE3(data, encoded, 0)
+func decodeDeltaUint32SSE3Test(data []uint32, encoded []byte) int {
+	return decodeDeltaUint32SSE3(data, encoded, 0)
 }
 
 func TestRoundTripDeltaUint32SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
 	testUniformDeltaAndRandomUint32(t, encodeDeltaUint32scalarTest, decodeDeltaUint32SSE3Test)
 }
 
 func BenchmarkDecodeUint32SSE3(b *testing.B) {
-	if !cpu.X86.HasSSE3 {
-		b.Skip("CPU does not support SSE3 instructions")
+	if !cpu.X86.HasSSSE3 {
+		b.Skip("CPU does not support SSSE3 instructions")
 	}
 	b.SetBytes(int64(4 * benchSize))
 	benchEncodedSize = encodeUint32scalar(benchEncoded, benchUint32Data)
@@ -57,8 +60,8 @@ func BenchmarkDecodeUint32SSE3(b *testing.B) {
 }
 
 func BenchmarkDecodeDeltaUint32SSE3(b *testing.B) {
-	if !cpu.X86.HasSSE3 {
-		b.Skip("CPU does not support SSE3 instructions")
+	if !cpu.X86.HasSSSE3 {
+		b.Skip("CPU does not support SSSE3 instructions")
 	}
 	b.SetBytes(int64(4 * benchSize))
 	benchEncodedSize = encodeDeltaUint32scalar(benchEncoded, benchUint32DataSorted, 0)
@@ -68,26 +71,113 @@ func BenchmarkDecodeDeltaUint32SSE3(b *testing.B) {
 	}
 }
 
+func TestRoundTripUint32AVX2(t *testing.T) {
+	if !cpu.X86.HasAVX2 {
+		t.Skip("CPU does not support AVX2 instructions")
+	}
+	testUniformAndRandomUint32(t, encodeUint32scalar, decodeUint32AVX2)
+}
+
+func decodeDeltaUint32AVX2Test(data []uint32, encoded []byte) int {
+	return decodeDeltaUint32AVX2(data, encoded, 0)
+}
+
+func TestRoundTripDeltaUint32AVX2(t *testing.T) {
+	if !cpu.X86.HasAVX2 {
+		t.Skip("CPU does not support AVX2 instructions")
+	}
+	testUniformDeltaAndRandomUint32(t, encodeDeltaUint32scalarTest, decodeDeltaUint32AVX2Test)
+}
+
+func BenchmarkDecodeUint32AVX2(b *testing.B) {
+	if !cpu.X86.HasAVX2 {
+		b.Skip("CPU does not support AVX2 instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = encodeUint32scalar(benchEncoded, benchUint32Data)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeUint32AVX2(benchUint32Data, benchEncoded)
+	}
+}
+
+func BenchmarkDecodeDeltaUint32AVX2(b *testing.B) {
+	if !cpu.X86.HasAVX2 {
+		b.Skip("CPU does not support AVX2 instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = encodeDeltaUint32scalar(benchEncoded, benchUint32DataSorted, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeDeltaUint32AVX2(benchUint32DataSorted, benchEncoded, 0)
+	}
+}
+
+func TestRoundTripUint32AVX512(t *testing.T) {
+	if !hasAVX512VBMI {
+		t.Skip("CPU does not support AVX-512 VBMI instructions")
+	}
+	testUniformAndRandomUint32(t, encodeUint32scalar, decodeUint32AVX512)
+}
+
+func decodeDeltaUint32AVX512Test(data []uint32, encoded []byte) int {
+	return decodeDeltaUint32AVX512(data, encoded, 0)
+}
+
+func TestRoundTripDeltaUint32AVX512(t *testing.T) {
+	if !hasAVX512VBMI {
+		t.Skip("CPU does not support AVX-512 VBMI instructions")
+	}
+	testUniformDeltaAndRandomUint32(t, encodeDeltaUint32scalarTest, decodeDeltaUint32AVX512Test)
+}
+
+func BenchmarkDecodeUint32AVX512(b *testing.B) {
+	if !hasAVX512VBMI {
+		b.Skip("CPU does not support AVX-512 VBMI instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = encodeUint32scalar(benchEncoded, benchUint32Data)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeUint32AVX512(benchUint32Data, benchEncoded)
+	}
+}
+
+func BenchmarkDecodeDeltaUint32AVX512(b *testing.B) {
+	if !hasAVX512VBMI {
+		b.Skip("CPU does not support AVX-512 VBMI instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = encodeDeltaUint32scalar(benchEncoded, benchUint32DataSorted, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeDeltaUint32AVX512(benchUint32DataSorted, benchEncoded, 0)
+	}
+}
+
 // int32
 
 func TestRoundTripInt32SSE3(t *testing.T) {
-	if !cpu.X86.HasSSE3 {
-		t.Skip("CPU does not support SSE3 instructions")
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
 	}
 	testUniformAndRandomInt32(t, encodeInt32scalar, decodeInt32SSE3)
 }
 
-func decodeDeltaInt32SSE3Test(data []int32, encoded []byte) {
-	decodeDeltaInt32SSE3(data, encoded, 0)
+func decodeDeltaInt32SSE3Test(data []int32, encoded []byte) int {
+	return decodeDeltaInt32SSE3(data, encoded, 0)
 }
 
 func TestRoundTripDeltaInt32SSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
 	testUniformDeltaAndRandomInt32(t, encodeDeltaInt32scalarTest, decodeDeltaInt32SSE3Test)
 }
 
 func BenchmarkDecodeInt32SSE3(b *testing.B) {
-	if !cpu.X86.HasSSE3 {
-		b.Skip("CPU does not support SSE3 instructions")
+	if !cpu.X86.HasSSSE3 {
+		b.Skip("CPU does not support SSSE3 instructions")
 	}
 	b.SetBytes(int64(4 * benchSize))
 	benchEncodedSize = encodeInt32scalar(benchEncoded, benchInt32Data)
@@ -98,8 +188,8 @@ func BenchmarkDecodeInt32SSE3(b *testing.B) {
 }
 
 func BenchmarkDecodeDeltaInt32SSE3(b *testing.B) {
-	if !cpu.X86.HasSSE3 {
-		b.Skip("CPU does not support SSE3 instructions")
+	if !cpu.X86.HasSSSE3 {
+		b.Skip("CPU does not support SSSE3 instructions")
 	}
 	b.SetBytes(int64(4 * benchSize))
 	benchEncodedSize = encodeDeltaInt32scalar(benchEncoded, benchInt32DataSorted, 0)
@@ -108,3 +198,87 @@ func BenchmarkDecodeDeltaInt32SSE3(b *testing.B) {
 		decodeDeltaInt32SSE3(benchInt32DataSorted, benchEncoded, 0)
 	}
 }
+
+func TestRoundTripInt32AVX2(t *testing.T) {
+	if !cpu.X86.HasAVX2 {
+		t.Skip("CPU does not support AVX2 instructions")
+	}
+	testUniformAndRandomInt32(t, encodeInt32scalar, decodeInt32AVX2)
+}
+
+func decodeDeltaInt32AVX2Test(data []int32, encoded []byte) int {
+	return decodeDeltaInt32AVX2(data, encoded, 0)
+}
+
+func TestRoundTripDeltaInt32AVX2(t *testing.T) {
+	if !cpu.X86.HasAVX2 {
+		t.Skip("CPU does not support AVX2 instructions")
+	}
+	testUniformDeltaAndRandomInt32(t, encodeDeltaInt32scalarTest, decodeDeltaInt32AVX2Test)
+}
+
+func BenchmarkDecodeInt32AVX2(b *testing.B) {
+	if !cpu.X86.HasAVX2 {
+		b.Skip("CPU does not support AVX2 instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = encodeInt32scalar(benchEncoded, benchInt32Data)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeInt32AVX2(benchInt32Data, benchEncoded)
+	}
+}
+
+func BenchmarkDecodeDeltaInt32AVX2(b *testing.B) {
+	if !cpu.X86.HasAVX2 {
+		b.Skip("CPU does not support AVX2 instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = encodeDeltaInt32scalar(benchEncoded, benchInt32DataSorted, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeDeltaInt32AVX2(benchInt32DataSorted, benchEncoded, 0)
+	}
+}
+
+func TestRoundTripInt32AVX512(t *testing.T) {
+	if !hasAVX512VBMI {
+		t.Skip("CPU does not support AVX-512 VBMI instructions")
+	}
+	testUniformAndRandomInt32(t, encodeInt32scalar, decodeInt32AVX512)
+}
+
+func decodeDeltaInt32AVX512Test(data []int32, encoded []byte) int {
+	return decodeDeltaInt32AVX512(data, encoded, 0)
+}
+
+func TestRoundTripDeltaInt32AVX512(t *testing.T) {
+	if !hasAVX512VBMI {
+		t.Skip("CPU does not support AVX-512 VBMI instructions")
+	}
+	testUniformDeltaAndRandomInt32(t, encodeDeltaInt32scalarTest, decodeDeltaInt32AVX512Test)
+}
+
+func BenchmarkDecodeInt32AVX512(b *testing.B) {
+	if !hasAVX512VBMI {
+		b.Skip("CPU does not support AVX-512 VBMI instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = encodeInt32scalar(benchEncoded, benchInt32Data)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeInt32AVX512(benchInt32Data, benchEncoded)
+	}
+}
+
+func BenchmarkDecodeDeltaInt32AVX512(b *testing.B) {
+	if !hasAVX512VBMI {
+		b.Skip("CPU does not support AVX-512 VBMI instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = encodeDeltaInt32scalar(benchEncoded, benchInt32DataSorted, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeDeltaInt32AVX512(benchInt32DataSorted, benchEncoded, 0)
+	}
+}
@@ -774,9 +774,9 @@ DATA dataByteMask<>+4080(SB)/8, $0x0706050403020100
 DATA dataByteMask<>+4088(SB)/8, $0x0f0e0d0c0b0a0908
 GLOBL dataByteMask<>(SB), RODATA|NOPTR, $4096
 
-// func decodeUint32SSE3(data []uint32, encoded []byte)
+// func decodeUint32SSE3(data []uint32, encoded []byte) int
 // Requires: SSE2, SSSE3
-TEXT ·decodeUint32SSE3(SB), NOSPLIT, $0-48
+TEXT ·decodeUint32SSE3(SB), NOSPLIT, $0-56
 	MOVQ encoded_base+24(FP), AX
 	MOVQ encoded_cap+40(FP), CX
 
@@ -786,115 +786,116 @@ TEXT ·decodeUint32SSE3(SB), NOSPLIT, $0-48
 	MOVQ data_len+8(FP), BX
 
 	// Revert to scalar processing if we have less than 4 values to process.
-	MOVQ BX, BP
-	SUBQ $0x04, BP
+	MOVQ BX, SI
+	SUBQ $0x04, SI
 
 	// Initialize the control index.
-	XORQ SI, SI
+	XORQ DI, DI
 
 	// Initialize the data index. (len(data) + 3) >> 2
-	MOVQ BX, DI
-	ADDQ $0x03, DI
-	SHRQ $0x02, DI
+	MOVQ BX, R8
+	ADDQ $0x03, R8
+	SHRQ $0x02, R8
 
 	// Initialize the output index.
-	XORQ R8, R8
+	XORQ R9, R9
 
 	// The byte count lookup table.
-	LEAQ dataByteCount<>+0(SB), R9
+	LEAQ dataByteCount<>+0(SB), R10
 
 	// The byte mask lookup table.
-	LEAQ dataByteMask<>+0(SB), R10
+	LEAQ dataByteMask<>+0(SB), R11
 
 simd:
 	// Check if less than 16 encoded bytes remain and jump to scalar.
-	CMPQ DI, CX
+	CMPQ R8, CX
 	JGT  scalar
 
 	// Check if less than 4 values remain and jump to scalar.
-	CMPQ R8, BP
+	CMPQ R9, SI
 	JGT  scalar
 
 	// Load control byte.
-	MOVBQZX (AX)(SI*1), R11
-	INCQ    SI
+	MOVBQZX (AX)(DI*1), R12
+	INCQ    DI
 
 	// Load 16 data bytes into XMM.
-	MOVOU (AX)(DI*1), X0
+	MOVOU (AX)(R8*1), X0
 
 	// Lookup count to increment data index.
-	MOVBQZX (R9)(R11*1), R12
+	MOVBQZX (R10)(R12*1), R13
 
 	// Lookup the PSHUFB mask.
-	SHLQ $0x04, R11
+	SHLQ $0x04, R12
 
 	// Use mask to shuffle the relevant bytes into place.
-	PSHUFB (R10)(R11*1), X0
+	PSHUFB (R11)(R12*1), X0
 
 	// Store 4 uint32.
-	MOVOU X0, (DX)(R8*4)
+	MOVOU X0, (DX)(R9*4)
 
 	// Increment the indices.
-	ADDQ $0x04, R8
-	ADDQ R12, DI
+	ADDQ $0x04, R9
+	ADDQ R13, R8
 	JMP  simd
 
 scalar:
 	// Process a single value at a time.
-	CMPQ R8, BX
+	CMPQ R9, BX
 	JE   done
 
 	// Determine if we need to load a new control byte.
-	TESTQ $0x00000003, R8
+	TESTQ $0x00000003, R9
 	JNE   loadBytes
 
 	// Load control byte.
-	MOVBQZX (AX)(SI*1), R13
-	INCQ    SI
+	MOVBQZX (AX)(DI*1), R14
+	INCQ    DI
 
 loadBytes:
 	// Switch on the low two bits of the control byte.
-	MOVQ R13, CX
+	MOVQ R14, CX
 	ANDQ $0x03, CX
 	JE   oneByte
 	CMPQ CX, $0x01
 	JE   twoByte
 	CMPQ CX, $0x02
 	JE   threeByte
-	MOVL (AX)(DI*1), CX
-	ADDQ $0x04, DI
+	MOVL (AX)(R8*1), CX
+	ADDQ $0x04, R8
 	JMP  shiftControl
 
 threeByte:
-	MOVWLZX (AX)(DI*1), CX
-	MOVBLZX 2(AX)(DI*1), BP
-	SHLL    $0x10, BP
-	ORL     BP, CX
-	ADDQ    $0x03, DI
+	MOVWLZX (AX)(R8*1), CX
+	MOVBLZX 2(AX)(R8*1), SI
+	SHLL    $0x10, SI
+	ORL     SI, CX
+	ADDQ    $0x03, R8
 	JMP     shiftControl
 
 twoByte:
-	MOVWLZX (AX)(DI*1), CX
-	ADDQ    $0x02, DI
+	MOVWLZX (AX)(R8*1), CX
+	ADDQ    $0x02, R8
 	JMP     shiftControl
 
 oneByte:
-	MOVBLZX (AX)(DI*1), CX
-	INCQ    DI
+	MOVBLZX (AX)(R8*1), CX
+	INCQ    R8
 
 shiftControl:
 	// Shift control byte to get next value.
-	SHRQ $0x02, R13
-	MOVL CX, (DX)(R8*4)
-	INCQ R8
+	SHRQ $0x02, R14
+	MOVL CX, (DX)(R9*4)
+	INCQ R9
 	JMP  scalar
 
 done:
+	MOVQ R8, ret+48(FP)
 	RET
 
-// func decodeDeltaUint32SSE3(data []uint32, encoded []byte, previous uint32)
+// func decodeDeltaUint32SSE3(data []uint32, encoded []byte, previous uint32) int
 // Requires: SSE2, SSSE3
-TEXT ·decodeDeltaUint32SSE3(SB), NOSPLIT, $0-56
+TEXT ·decodeDeltaUint32SSE3(SB), NOSPLIT, $0-64
 	MOVQ encoded_base+24(FP), AX
 	MOVQ encoded_cap+40(FP), CX
 
@@ -904,53 +905,53 @@ TEXT ·decodeDeltaUint32SSE3(SB), NOSPLIT, $0-56
 	MOVQ data_len+8(FP), BX
 
 	// Revert to scalar processing if we have less than 4 values to process.
-	MOVQ BX, BP
-	SUBQ $0x04, BP
+	MOVQ BX, SI
+	SUBQ $0x04, SI
 
 	// Initialize the control index.
-	XORQ SI, SI
+	XORQ DI, DI
 
 	// Initialize the data index. (len(data) + 3) >> 2
-	MOVQ BX, DI
-	ADDQ $0x03, DI
-	SHRQ $0x02, DI
+	MOVQ BX, R8
+	ADDQ $0x03, R8
+	SHRQ $0x02, R8
 
 	// Initialize the output index.
-	XORQ R8, R8
+	XORQ R9, R9
 
 	// The byte count lookup table.
-	LEAQ dataByteCount<>+0(SB), R9
+	LEAQ dataByteCount<>+0(SB), R10
 
 	// The byte mask lookup table.
-	LEAQ   dataByteMask<>+0(SB), R10
-	MOVL   previous+48(FP), R11
-	MOVD   R11, X0
+	LEAQ   dataByteMask<>+0(SB), R11
+	MOVL   previous+48(FP), R12
+	MOVD   R12, X0
 	PSHUFD $0x00, X0, X0
 
 simd:
 	// Check if less than 16 encoded bytes remain and jump to scalar.
-	CMPQ DI, CX
+	CMPQ R8, CX
 	JGT  scalar
 
 	// Check if less than 4 values remain and jump to scalar.
-	CMPQ R8, BP
+	CMPQ R9, SI
 	JGT  scalar
 
 	// Load control byte.
-	MOVBQZX (AX)(SI*1), R11
-	INCQ    SI
+	MOVBQZX (AX)(DI*1), R12
+	INCQ    DI
 
 	// Load 16 data bytes into XMM.
-	MOVOU (AX)(DI*1), X1
+	MOVOU (AX)(R8*1), X1
 
 	// Lookup count to increment data index.
-	MOVBQZX (R9)(R11*1), R12
+	MOVBQZX (R10)(R12*1), R13
 
 	// Lookup the PSHUFB mask.
-	SHLQ $0x04, R11
+	SHLQ $0x04, R12
 
 	// Use mask to shuffle the relevant bytes into place.
-	PSHUFB (R10)(R11*1), X1
+	PSHUFB (R11)(R12*1), X1
 
 	// Calculate prefix sum.
 	MOVOU X1, X2
@@ -973,75 +974,76 @@ simd:
 
 	// Propagate last decoded value to all lanes of previous.
 	PSHUFD $0xff, X1, X0
-	MOVD   X0, R11
+	MOVD   X0, R12
 
 	// Store 4 uint32.
-	MOVOU X1, (DX)(R8*4)
+	MOVOU X1, (DX)(R9*4)
 
 	// Increment the indices.
-	ADDQ $0x04, R8
-	ADDQ R12, DI
+	ADDQ $0x04, R9
+	ADDQ R13, R8
 	JMP  simd
 
 scalar:
 	// Process a single value at a time.
-	CMPQ R8, BX
+	CMPQ R9, BX
 	JE   done
 
 	// Determine if we need to load a new control byte.
-	TESTQ $0x00000003, R8
+	TESTQ $0x00000003, R9
 	JNE   loadBytes
 
 	// Load control byte.
-	MOVBQZX (AX)(SI*1), R13
-	INCQ    SI
+	MOVBQZX (AX)(DI*1), R14
+	INCQ    DI
 
 loadBytes:
 	// Switch on the low two bits of the control byte.
-	MOVQ R13, CX
+	MOVQ R14, CX
 	ANDQ $0x03, CX
 	JE   oneByte
 	CMPQ CX, $0x01
 	JE   twoByte
 	CMPQ CX, $0x02
 	JE   threeByte
-	MOVL (AX)(DI*1), CX
-	ADDQ $0x04, DI
+	MOVL (AX)(R8*1), CX
+	ADDQ $0x04, R8
 	JMP  shiftControl
 
 threeByte:
-	MOVWLZX (AX)(DI*1), CX
-	MOVBLZX 2(AX)(DI*1), BP
-	SHLL    $0x10, BP
-	ORL     BP, CX
-	ADDQ    $0x03, DI
+	MOVWLZX (AX)(R8*1), CX
+	MOVBLZX 2(AX)(R8*1), SI
+	SHLL    $0x10, SI
+	ORL     SI, CX
+	ADDQ    $0x03, R8
 	JMP     shiftControl
 
 twoByte:
-	MOVWLZX (AX)(DI*1), CX
-	ADDQ    $0x02, DI
+	MOVWLZX (AX)(R8*1), CX
+	ADDQ    $0x02, R8
 	JMP     shiftControl
 
 oneByte:
-	MOVBLZX (AX)(DI*1), CX
-	INCQ    DI
+	MOVBLZX (AX)(R8*1), CX
+	INCQ    R8
 
 shiftControl:
 	// Shift control byte to get next value.
-	SHRQ $0x02, R13
+	SHRQ $0x02, R14
 
 	// Add the previous decoded value to the delta.
-	ADDL CX, R11
-	MOVL R11, (DX)(R8*4)
-	INCQ R8
+	ADDL CX, R12
+	MOVL R12, (DX)(R9*4)
+	INCQ R9
 	JMP  scalar
 
 done:
+	MOVQ R8, ret+56(FP)
 	RET
 
-// func decodeInt32SSE3(data []int32, encoded []byte)
+// func decodeInt32SSE3(data []int32, encoded []byte) int
 // Requires: SSE2, SSSE3
-TEXT ·decodeInt32SSE3(SB), NOSPLIT, $0-48
+TEXT ·decodeInt32SSE3(SB), NOSPLIT, $0-56
 	MOVQ encoded_base+24(FP), AX
 	MOVQ encoded_cap+40(FP), CX
 
@@ -1051,50 +1053,50 @@ TEXT ·decodeInt32SSE3(SB), NOSPLIT, $0-48
 	MOVQ data_len+8(FP), BX
 
 	// Revert to scalar processing if we have less than 4 values to process.
-	MOVQ BX, BP
-	SUBQ $0x04, BP
+	MOVQ BX, SI
+	SUBQ $0x04, SI
 
 	// Initialize the control index.
-	XORQ SI, SI
+	XORQ DI, DI
 
 	// Initialize the data index. (len(data) + 3) >> 2
-	MOVQ BX, DI
-	ADDQ $0x03, DI
-	SHRQ $0x02, DI
+	MOVQ BX, R8
+	ADDQ $0x03, R8
+	SHRQ $0x02, R8
 
 	// Initialize the output index.
-	XORQ R8, R8
+	XORQ R9, R9
 
 	// The byte count lookup table.
-	LEAQ dataByteCount<>+0(SB), R9
+	LEAQ dataByteCount<>+0(SB), R10
 
 	// The byte mask lookup table.
-	LEAQ dataByteMask<>+0(SB), R10
+	LEAQ dataByteMask<>+0(SB), R11
 
 simd:
 	// Check if less than 16 encoded bytes remain and jump to scalar.
-	CMPQ DI, CX
+	CMPQ R8, CX
 	JGT  scalar
 
 	// Check if less than 4 values remain and jump to scalar.
-	CMPQ R8, BP
+	CMPQ R9, SI
 	JGT  scalar
 
 	// Load control byte.
-	MOVBQZX (AX)(SI*1), R11
-	INCQ    SI
+	MOVBQZX (AX)(DI*1), R12
+	INCQ    DI
 
 	// Load 16 data bytes into XMM.
-	MOVOU (AX)(DI*1), X0
+	MOVOU (AX)(R8*1), X0
 
 	// Lookup count to increment data index.
-	MOVBQZX (R9)(R11*1), R12
+	MOVBQZX (R10)(R12*1), R13
 
 	// Lookup the PSHUFB mask.
-	SHLQ $0x04, R11
+	SHLQ $0x04, R12
 
 	// Use mask to shuffle the relevant bytes into place.
-	PSHUFB (R10)(R11*1), X0
+	PSHUFB (R11)(R12*1), X0
 
 	// Zigzag decode.
 	MOVOU X0, X1
@@ -1121,76 +1123,77 @@ simd:
 	PXOR X1, X0
 
 	// Store 4 uint32.
-	MOVOU X0, (DX)(R8*4)
+	MOVOU X0, (DX)(R9*4)
 
 	// Increment the indices.
-	ADDQ $0x04, R8
-	ADDQ R12, DI
+	ADDQ $0x04, R9
+	ADDQ R13, R8
 	JMP  simd
 
 scalar:
 	// Process a single value at a time.
-	CMPQ R8, BX
+	CMPQ R9, BX
 	JE   done
 
 	// Determine if we need to load a new control byte.
-	TESTQ $0x00000003, R8
+	TESTQ $0x00000003, R9
 	JNE   loadBytes
 
 	// Load control byte.
-	MOVBQZX (AX)(SI*1), R13
-	INCQ    SI
+	MOVBQZX (AX)(DI*1), R14
+	INCQ    DI
 
 loadBytes:
 	// Switch on the low two bits of the control byte.
-	MOVQ R13, CX
+	MOVQ R14, CX
 	ANDQ $0x03, CX
 	JE   oneByte
 	CMPQ CX, $0x01
 	JE   twoByte
 	CMPQ CX, $0x02
 	JE   threeByte
-	MOVL (AX)(DI*1), CX
-	ADDQ $0x04, DI
+	MOVL (AX)(R8*1), CX
+	ADDQ $0x04, R8
 	JMP  shiftControl
 
 threeByte:
-	MOVWLZX (AX)(DI*1), CX
-	MOVBLZX 2(AX)(DI*1), BP
-	SHLL    $0x10, BP
-	ORL     BP, CX
-	ADDQ    $0x03, DI
+	MOVWLZX (AX)(R8*1), CX
+	MOVBLZX 2(AX)(R8*1), SI
+	SHLL    $0x10, SI
+	ORL     SI, CX
+	ADDQ    $0x03, R8
 	JMP     shiftControl
 
 twoByte:
-	MOVWLZX (AX)(DI*1), CX
-	ADDQ    $0x02, DI
+	MOVWLZX (AX)(R8*1), CX
+	ADDQ    $0x02, R8
 	JMP     shiftControl
 
 oneByte:
-	MOVBLZX (AX)(DI*1), CX
-	INCQ    DI
+	MOVBLZX (AX)(R8*1), CX
+	INCQ    R8
 
 shiftControl:
 	// Shift control byte to get next value.
-	SHRQ $0x02, R13
+	SHRQ $0x02, R14
 
 	// Zigzag decode.
-	MOVL CX, BP
-	SHRL $0x01, BP
+	MOVL CX, SI
+	SHRL $0x01, SI
 	ANDL $0x01, CX
 	NEGL CX
-	XORL BP, CX
-	MOVL CX, (DX)(R8*4)
-	INCQ R8
+	XORL SI, CX
+	MOVL CX, (DX)(R9*4)
+	INCQ R9
 	JMP  scalar
 
 done:
+	MOVQ R8, ret+48(FP)
 	RET
 
-// func decodeDeltaInt32SSE3(data []int32, encoded []byte, previous int32)
+// func decodeDeltaInt32SSE3(data []int32, encoded []byte, previous int32) int
 // Requires: SSE2, SSSE3
-TEXT ·decodeDeltaInt32SSE3(SB), NOSPLIT, $0-56
+TEXT ·decodeDeltaInt32SSE3(SB), NOSPLIT, $0-64
 	MOVQ encoded_base+24(FP), AX
 	MOVQ encoded_cap+40(FP), CX
 
@@ -1200,53 +1203,53 @@ TEXT ·decodeDeltaInt32SSE3(SB), NOSPLIT, $0-56
 	MOVQ data_len+8(FP), BX
 
 	// Revert to scalar processing if we have less than 4 values to process.
-	MOVQ BX, BP
-	SUBQ $0x04, BP
+	MOVQ BX, SI
+	SUBQ $0x04, SI
 
 	// Initialize the control index.
-	XORQ SI, SI
+	XORQ DI, DI
 
 	// Initialize the data index. (len(data) + 3) >> 2
-	MOVQ BX, DI
-	ADDQ $0x03, DI
-	SHRQ $0x02, DI
+	MOVQ BX, R8
+	ADDQ $0x03, R8
+	SHRQ $0x02, R8
 
 	// Initialize the output index.
-	XORQ R8, R8
+	XORQ R9, R9
 
 	// The byte count lookup table.
-	LEAQ dataByteCount<>+0(SB), R9
+	LEAQ dataByteCount<>+0(SB), R10
 
 	// The byte mask lookup table.
-	LEAQ   dataByteMask<>+0(SB), R10
-	MOVL   previous+48(FP), R11
-	MOVD   R11, X0
+	LEAQ   dataByteMask<>+0(SB), R11
+	MOVL   previous+48(FP), R12
+	MOVD   R12, X0
 	PSHUFD $0x00, X0, X0
 
 simd:
 	// Check if less than 16 encoded bytes remain and jump to scalar.
-	CMPQ DI, CX
+	CMPQ R8, CX
 	JGT  scalar
 
 	// Check if less than 4 values remain and jump to scalar.
-	CMPQ R8, BP
+	CMPQ R9, SI
 	JGT  scalar
 
 	// Load control byte.
-	MOVBQZX (AX)(SI*1), R11
-	INCQ    SI
+	MOVBQZX (AX)(DI*1), R12
+	INCQ    DI
 
 	// Load 16 data bytes into XMM.
-	MOVOU (AX)(DI*1), X1
+	MOVOU (AX)(R8*1), X1
 
 	// Lookup count to increment data index.
-	MOVBQZX (R9)(R11*1), R12
+	MOVBQZX (R10)(R12*1), R13
 
 	// Lookup the PSHUFB mask.
-	SHLQ $0x04, R11
+	SHLQ $0x04, R12
 
 	// Use mask to shuffle the relevant bytes into place.
-	PSHUFB (R10)(R11*1), X1
+	PSHUFB (R11)(R12*1), X1
 
 	// Zigzag decode.
 	MOVOU X1, X2
@@ -1293,75 +1296,76 @@ simd:
 
 	// Propagate last decoded value to all lanes of previous.
 	PSHUFD $0xff, X1, X0
-	MOVD   X0, R11
+	MOVD   X0, R12
 
 	// Store 4 uint32.
-	MOVOU X1, (DX)(R8*4)
+	MOVOU X1, (DX)(R9*4)
 
 	// Increment the indices.
-	ADDQ $0x04, R8
-	ADDQ R12, DI
+	ADDQ $0x04, R9
+	ADDQ R13, R8
 	JMP  simd
 
 scalar:
 	// Process a single value at a time.
-	CMPQ R8, BX
+	CMPQ R9, BX
 	JE   done
 
 	// Determine if we need to load a new control byte.
-	TESTQ $0x00000003, R8
+	TESTQ $0x00000003, R9
 	JNE   loadBytes
 
 	// Load control byte.
-	MOVBQZX (AX)(SI*1), R13
-	INCQ    SI
+	MOVBQZX (AX)(DI*1), R14
+	INCQ    DI
 
 loadBytes:
 	// Switch on the low two bits of the control byte.
-	MOVQ R13, CX
+	MOVQ R14, CX
 	ANDQ $0x03, CX
 	JE   oneByte
 	CMPQ CX, $0x01
 	JE   twoByte
 	CMPQ CX, $0x02
 	JE   threeByte
-	MOVL (AX)(DI*1), CX
-	ADDQ $0x04, DI
+	MOVL (AX)(R8*1), CX
+	ADDQ $0x04, R8
 	JMP  shiftControl
 
 threeByte:
-	MOVWLZX (AX)(DI*1), CX
-	MOVBLZX 2(AX)(DI*1), BP
-	SHLL    $0x10, BP
-	ORL     BP, CX
-	ADDQ    $0x03, DI
+	MOVWLZX (AX)(R8*1), CX
+	MOVBLZX 2(AX)(R8*1), SI
+	SHLL    $0x10, SI
+	ORL     SI, CX
+	ADDQ    $0x03, R8
 	JMP     shiftControl
 
 twoByte:
-	MOVWLZX (AX)(DI*1), CX
-	ADDQ    $0x02, DI
+	MOVWLZX (AX)(R8*1), CX
+	ADDQ    $0x02, R8
 	JMP     shiftControl
 
 oneByte:
-	MOVBLZX (AX)(DI*1), CX
-	INCQ    DI
+	MOVBLZX (AX)(R8*1), CX
+	INCQ    R8
 
 shiftControl:
 	// Shift control byte to get next value.
-	SHRQ $0x02, R13
+	SHRQ $0x02, R14
 
 	// Zigzag decode.
-	MOVL CX, BP
-	SHRL $0x01, BP
+	MOVL CX, SI
+	SHRL $0x01, SI
 	ANDL $0x01, CX
 	NEGL CX
-	XORL BP, CX
+	XORL SI, CX
 
 	// Add the previous decoded value to the delta.
-	ADDL CX, R11
-	MOVL R11, (DX)(R8*4)
-	INCQ R8
+	ADDL CX, R12
+	MOVL R12, (DX)(R9*4)
+	INCQ R9
 	JMP  scalar
 
 done:
+	MOVQ R8, ret+56(FP)
 	RET
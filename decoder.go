@@ -0,0 +1,228 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"encoding/binary"
+)
+
+// decoderBlockSize is the number of values NextBatch decodes with the bulk
+// decoders, and Skip sums with the bulk sums, at a time.  It must be a multiple of 4.
+const decoderBlockSize = 1024
+
+// Decoder decodes Stream VByte encoded uint32 one at a time, or a batch at a
+// time, so that decoding can stop early or be interleaved with processing.
+type Decoder struct {
+	encoded []byte
+	count   int
+	i       int // number of values decoded
+	di      int // offset of the next data byte
+
+	delta    bool
+	zigzag   bool
+	previous uint32
+
+	// scratch holds the gathered control and data bytes of NextBatch and Skip.
+	scratch []byte
+}
+
+// NewDecoder returns a Decoder of count uint32 encoded by EncodeUint32.
+// encoded must contain at least count encoded uint32.
+func NewDecoder(encoded []byte, count int) *Decoder {
+	return &Decoder{encoded: encoded, count: count, di: (count + 3) >> 2}
+}
+
+// NewDeltaDecoder returns a Decoder of count uint32 encoded by
+// EncodeDeltaUint32 with the initial value previous.  encoded must contain at
+// least count encoded uint32.
+func NewDeltaDecoder(encoded []byte, count int, previous uint32) *Decoder {
+	d := NewDecoder(encoded, count)
+	d.delta, d.previous = true, previous
+	return d
+}
+
+// NewZigzagDecoder returns a Decoder of count int32 encoded by EncodeInt32.
+// The values decoded are the bits of int32, i.e. int32(v) recovers them.
+// encoded must contain at least count encoded int32.
+func NewZigzagDecoder(encoded []byte, count int) *Decoder {
+	d := NewDecoder(encoded, count)
+	d.zigzag = true
+	return d
+}
+
+// NewDeltaZigzagDecoder returns a Decoder of count int32 encoded by
+// EncodeDeltaInt32 with the initial value previous.  The values decoded are
+// the bits of int32, i.e. int32(v) recovers them.  encoded must contain at
+// least count encoded int32.
+func NewDeltaZigzagDecoder(encoded []byte, count int, previous int32) *Decoder {
+	d := NewDecoder(encoded, count)
+	d.delta, d.zigzag, d.previous = true, true, uint32(previous)
+	return d
+}
+
+// Remaining returns the number of values not yet decoded or skipped.
+func (d *Decoder) Remaining() int {
+	return d.count - d.i
+}
+
+// Next returns the next value, or false once all values have been decoded.
+func (d *Decoder) Next() (uint32, bool) {
+	if d.i == d.count {
+		return 0, false
+	}
+	code := (d.encoded[d.i>>2] >> (2 * uint(d.i&3))) & 3
+	var v uint32
+	switch code {
+	case 0:
+		v = uint32(d.encoded[d.di])
+	case 1:
+		v = uint32(binary.LittleEndian.Uint16(d.encoded[d.di:]))
+	case 2:
+		v = uint32(d.encoded[d.di+2])<<16 | uint32(binary.LittleEndian.Uint16(d.encoded[d.di:]))
+	default:
+		v = binary.LittleEndian.Uint32(d.encoded[d.di:])
+	}
+	d.di += int(code) + 1
+	d.i++
+	if d.zigzag {
+		v = (v >> 1) ^ -(v & 1)
+	}
+	if d.delta {
+		d.previous += v
+		v = d.previous
+	}
+	return v, true
+}
+
+// NextBatch decodes the next values into dst and returns the number decoded,
+// which is less than len(dst) only at the end of the values.  Values filling
+// whole control bytes are decoded with the bulk decoders, e.g. SSE3 on amd64.
+func (d *Decoder) NextBatch(dst []uint32) int {
+	n := d.count - d.i
+	if n > len(dst) {
+		n = len(dst)
+	}
+	k := 0
+	for ; k < n && d.i&3 != 0; k++ {
+		dst[k], _ = d.Next()
+	}
+	for full := (n - k) &^ 3; full > 0; {
+		m := full
+		if m > decoderBlockSize {
+			m = decoderBlockSize
+		}
+		d.decodeBlock(dst[k : k+m])
+		k += m
+		full -= m
+	}
+	for ; k < n; k++ {
+		dst[k], _ = d.Next()
+	}
+	return n
+}
+
+// decodeBlock decodes len(dst), a multiple of 4, values starting at a control
+// byte boundary with the bulk decoders.
+func (d *Decoder) decodeBlock(dst []uint32) {
+	block := d.gather(len(dst) >> 2)
+	switch {
+	case d.delta && d.zigzag:
+		decodeDeltaInt32(as[int32](dst), block, int32(d.previous))
+		d.previous = dst[len(dst)-1]
+	case d.zigzag:
+		decodeInt32(as[int32](dst), block)
+	case d.delta:
+		decodeDeltaUint32(dst, block, d.previous)
+		d.previous = dst[len(dst)-1]
+	default:
+		decodeUint32(dst, block)
+	}
+	d.i += len(dst)
+	d.di += len(block) - len(dst)>>2
+}
+
+// skipBlock skips count, a multiple of 4, delta encoded values starting at a
+// control byte boundary, advancing the running value by the sum of their
+// deltas without writing the values out.
+func (d *Decoder) skipBlock(count int) {
+	block := d.gather(count >> 2)
+	if d.zigzag {
+		d.previous += uint32(sumInt32(block, count))
+	} else {
+		d.previous += uint32(sumUint32(block, count))
+	}
+	d.i += count
+	d.di += len(block) - count>>2
+}
+
+// gather returns the next numControlBytes control bytes followed by their
+// data bytes, copied into scratch since the bulk decoders and sums expect the
+// control bytes to precede the data bytes.
+func (d *Decoder) gather(numControlBytes int) []byte {
+	ci := d.i >> 2
+	control := d.encoded[ci : ci+numControlBytes]
+	size := numControlBytes + dataLen(control)
+	if len(d.scratch) < size {
+		d.scratch = make([]byte, MaxSize32(4*numControlBytes))
+	}
+	copy(d.scratch, control)
+	copy(d.scratch[numControlBytes:], d.encoded[d.di:d.di+size-numControlBytes])
+	return d.scratch[:size]
+}
+
+// Skip advances past the next n values, or the remaining values if fewer,
+// and returns the number skipped.  Whole control bytes are skipped using
+// their lengths, with delta encoding the running value is advanced by the sum
+// of the skipped deltas, so the values are never written out.  A negative n
+// skips nothing.
+func (d *Decoder) Skip(n int) int {
+	if n < 0 {
+		return 0
+	}
+	if rem := d.count - d.i; n > rem {
+		n = rem
+	}
+	k := 0
+	for ; k < n && d.i&3 != 0; k++ {
+		d.Next()
+	}
+	full := (n - k) &^ 3
+	if d.delta {
+		for m := full; m > 0; {
+			b := m
+			if b > decoderBlockSize {
+				b = decoderBlockSize
+			}
+			d.skipBlock(b)
+			m -= b
+		}
+	} else {
+		ci := d.i >> 2
+		d.di += dataLen(d.encoded[ci : ci+full>>2])
+		d.i += full
+	}
+	for k += full; k < n; k++ {
+		d.Next()
+	}
+	return n
+}
@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestDecoder(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range append(testSizes, 3*decoderBlockSize+5) {
+		data := makeRandomUint32(r, size)
+		int32Data := make([]int32, size)
+		for i, v := range data {
+			int32Data[i] = int32(v)
+		}
+		encoded := make([]byte, MaxSize32(size))
+		for _, tc := range []struct {
+			name       string
+			encode     func() int
+			newDecoder func() *Decoder
+		}{
+			{"NewDecoder", func() int { return EncodeUint32(encoded, data) }, func() *Decoder { return NewDecoder(encoded, size) }},
+			{"NewDeltaDecoder", func() int { return EncodeDeltaUint32(encoded, data, 7) }, func() *Decoder { return NewDeltaDecoder(encoded, size, 7) }},
+			{"NewZigzagDecoder", func() int { return EncodeInt32(encoded, int32Data) }, func() *Decoder { return NewZigzagDecoder(encoded, size) }},
+			{"NewDeltaZigzagDecoder", func() int { return EncodeDeltaInt32(encoded, int32Data, -7) }, func() *Decoder { return NewDeltaZigzagDecoder(encoded, size, -7) }},
+		} {
+			tc.encode()
+
+			d := tc.newDecoder()
+			for i, expected := range data {
+				if v, ok := d.Next(); !ok || v != expected {
+					t.Fatalf("size %d: %s Next got %d, %t at %d, expected: %d", size, tc.name, v, ok, i, expected)
+				}
+			}
+			if _, ok := d.Next(); ok {
+				t.Errorf("size %d: %s Next past the end reported a value", size, tc.name)
+			}
+
+			d = tc.newDecoder()
+			if got := d.Skip(-1); got != 0 || d.Remaining() != size {
+				t.Fatalf("size %d: %s Skip(-1) got %d with %d remaining, expected: 0 with %d", size, tc.name, got, d.Remaining(), size)
+			}
+			if v, ok := d.Next(); size > 0 && v != data[0] {
+				t.Fatalf("size %d: %s Next after Skip(-1) got %d, %t, expected: %d", size, tc.name, v, ok, data[0])
+			}
+
+			// Mix single values, batches and skips of uneven lengths.
+			d = tc.newDecoder()
+			dst := make([]uint32, size)
+			for i := 0; i < size; {
+				n := r.Intn(size-i) + 1
+				switch r.Intn(3) {
+				case 0:
+					v, _ := d.Next()
+					if v != data[i] {
+						t.Fatalf("size %d: %s Next got %d at %d, expected: %d", size, tc.name, v, i, data[i])
+					}
+					i++
+				case 1:
+					if got := d.NextBatch(dst[:n]); got != n {
+						t.Fatalf("size %d: %s NextBatch got %d values, expected: %d", size, tc.name, got, n)
+					}
+					for j, v := range dst[:n] {
+						if v != data[i+j] {
+							t.Fatalf("size %d: %s NextBatch got %d at %d, expected: %d", size, tc.name, v, i+j, data[i+j])
+						}
+					}
+					i += n
+				default:
+					if got := d.Skip(n); got != n {
+						t.Fatalf("size %d: %s Skip got %d, expected: %d", size, tc.name, got, n)
+					}
+					i += n
+				}
+				if d.Remaining() != size-i {
+					t.Fatalf("size %d: %s got Remaining %d, expected: %d", size, tc.name, d.Remaining(), size-i)
+				}
+			}
+			if got := d.NextBatch(dst); got != 0 {
+				t.Errorf("size %d: %s NextBatch past the end got %d values", size, tc.name, got)
+			}
+			if got := d.Skip(1); got != 0 {
+				t.Errorf("size %d: %s Skip past the end got %d", size, tc.name, got)
+			}
+		}
+	}
+}
+
+func BenchmarkDecoderNext(b *testing.B) {
+	benchEncodedSize = EncodeUint32(benchEncoded, benchUint32Data)
+	b.SetBytes(int64(4 * benchSize))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d := NewDecoder(benchEncoded, benchSize)
+		for _, ok := d.Next(); ok; _, ok = d.Next() {
+		}
+	}
+}
+
+func BenchmarkDecoderNextBatch(b *testing.B) {
+	benchEncodedSize = EncodeUint32(benchEncoded, benchUint32Data)
+	dst := make([]uint32, 4096)
+	b.SetBytes(int64(4 * benchSize))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d := NewDecoder(benchEncoded, benchSize)
+		for d.NextBatch(dst) > 0 {
+		}
+	}
+}
@@ -0,0 +1,328 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"golang.org/x/sys/cpu"
+)
+
+const (
+	differentialSeed    = 42
+	differentialCorpora = 200
+	differentialMaxSize = 4100
+)
+
+var differentialPrevious = []uint32{0, 1, 0xDEADBEEF}
+
+// decodeKernel holds the decoders for a single instruction set which are
+// checked against the scalar reference implementation.
+type decodeKernel struct {
+	name        string
+	supported   bool
+	uint32      func(data []uint32, encoded []byte) int
+	deltaUint32 func(data []uint32, encoded []byte, previous uint32) int
+	int32       func(data []int32, encoded []byte) int
+	deltaInt32  func(data []int32, encoded []byte, previous int32) int
+}
+
+var decodeKernels = []decodeKernel{
+	{"SSE3", cpu.X86.HasSSSE3, decodeUint32SSE3, decodeDeltaUint32SSE3, decodeInt32SSE3, decodeDeltaInt32SSE3},
+	{"AVX2", cpu.X86.HasAVX2, decodeUint32AVX2, decodeDeltaUint32AVX2, decodeInt32AVX2, decodeDeltaInt32AVX2},
+	{"AVX512", hasAVX512VBMI, decodeUint32AVX512, decodeDeltaUint32AVX512, decodeInt32AVX512, decodeDeltaInt32AVX512},
+}
+
+// encodeKernel holds the encoders for a single instruction set which are
+// checked against the scalar reference implementation.
+type encodeKernel struct {
+	name        string
+	supported   bool
+	uint32      func(encoded []byte, data []uint32) int
+	deltaUint32 func(encoded []byte, data []uint32, previous uint32) int
+	int32       func(encoded []byte, data []int32) int
+	deltaInt32  func(encoded []byte, data []int32, previous int32) int
+}
+
+var encodeKernels = []encodeKernel{
+	{"SSE41", hasSSE41, encodeUint32SSE41, encodeDeltaUint32SSE41, encodeInt32SSE41, encodeDeltaInt32SSE41},
+	{"AVX512", hasAVX512VBMI2, encodeUint32AVX512, encodeDeltaUint32AVX512, encodeInt32AVX512, encodeDeltaInt32AVX512},
+}
+
+// differentialUint32Corpora returns every test size of the uniform and
+// benchmark data along with random corpora of random sizes.
+func differentialUint32Corpora() [][]uint32 {
+	var corpora [][]uint32
+	for _, size := range testSizes {
+		corpora = append(corpora,
+			oneByteUint32Data[0:size:size],
+			twoByteUint32Data[0:size:size],
+			threeByteUint32Data[0:size:size],
+			fourByteUint32Data[0:size:size],
+			oneByteDeltaUint32Data[0:size:size],
+			fourByteDeltaUint32Data[0:size:size],
+			benchUint32Data[0:size:size],
+			benchUint32DataSorted[0:size:size],
+		)
+	}
+	r := rand.New(rand.NewSource(differentialSeed))
+	for i := 0; i < differentialCorpora; i++ {
+		corpora = append(corpora,
+			makeRandomUint32(r, r.Intn(differentialMaxSize)),
+			makeRandomDeltaUint32(r, r.Intn(differentialMaxSize), r.Uint32()),
+		)
+	}
+	return corpora
+}
+
+// differentialInt32Corpora returns every test size of the uniform and
+// benchmark data along with random corpora of random sizes.
+func differentialInt32Corpora() [][]int32 {
+	var corpora [][]int32
+	for _, size := range testSizes {
+		corpora = append(corpora,
+			oneByteInt32Data[0:size:size],
+			twoByteInt32Data[0:size:size],
+			threeByteInt32Data[0:size:size],
+			fourByteInt32Data[0:size:size],
+			oneByteDeltaInt32Data[0:size:size],
+			fourByteDeltaInt32Data[0:size:size],
+			benchInt32Data[0:size:size],
+			benchInt32DataSorted[0:size:size],
+		)
+	}
+	r := rand.New(rand.NewSource(differentialSeed))
+	for i := 0; i < differentialCorpora; i++ {
+		corpora = append(corpora,
+			makeRandomInt32(r, r.Intn(differentialMaxSize)),
+			makeRandomDeltaInt32(r, r.Intn(differentialMaxSize), int32(r.Uint32())),
+		)
+	}
+	return corpora
+}
+
+// encodeExact encodes data with encoder into a slice of exactly the encoded size.
+func encodeExact(data []uint32, encoder func([]byte, []uint32) int) []byte {
+	encodedRaw := make([]byte, MaxSize32(len(data)))
+	encodedSize := encoder(encodedRaw, data)
+	encoded := make([]byte, encodedSize, encodedSize)
+	copy(encoded, encodedRaw)
+	return encoded
+}
+
+// encodeExactInt32 encodes data with encoder into a slice of exactly the encoded size.
+func encodeExactInt32(data []int32, encoder func([]byte, []int32) int) []byte {
+	encodedRaw := make([]byte, MaxSize32(len(data)))
+	encodedSize := encoder(encodedRaw, data)
+	encoded := make([]byte, encodedSize, encodedSize)
+	copy(encoded, encodedRaw)
+	return encoded
+}
+
+func checkDecodedSize(t *testing.T, got, want int) {
+	t.Helper()
+	if got != want {
+		t.Fatalf("got decoded size %d, expected %d", got, want)
+	}
+}
+
+func checkDecodedUint32(t *testing.T, got, want []uint32) {
+	t.Helper()
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("len %d: got data[%d]: %d, expected: %d", len(want), i, got[i], want[i])
+		}
+	}
+}
+
+func checkDecodedInt32(t *testing.T, got, want []int32) {
+	t.Helper()
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("len %d: got data[%d]: %d, expected: %d", len(want), i, got[i], want[i])
+		}
+	}
+}
+
+func checkEncodedEqual(t *testing.T, got, want []byte) {
+	t.Helper()
+	if !bytes.Equal(got, want) {
+		t.Fatalf("got encoded size %d, expected %d or mismatched bytes", len(got), len(want))
+	}
+}
+
+func TestDifferentialDecodeUint32(t *testing.T) {
+	corpora := differentialUint32Corpora()
+	for _, kernel := range decodeKernels {
+		t.Run(kernel.name, func(t *testing.T) {
+			if !kernel.supported {
+				t.Skipf("CPU does not support %s instructions", kernel.name)
+			}
+			for _, data := range corpora {
+				want := make([]uint32, len(data))
+				got := make([]uint32, len(data))
+				encoded := encodeExact(data, encodeUint32scalar)
+				decodeUint32scalar(want, encoded)
+				checkDecodedSize(t, kernel.uint32(got, encoded), len(encoded))
+				checkDecodedUint32(t, got, want)
+				for _, previous := range differentialPrevious {
+					encoded := encodeExact(data, func(encoded []byte, data []uint32) int {
+						return encodeDeltaUint32scalar(encoded, data, previous)
+					})
+					decodeDeltaUint32scalar(want, encoded, previous)
+					checkDecodedSize(t, kernel.deltaUint32(got, encoded, previous), len(encoded))
+					checkDecodedUint32(t, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDifferentialDecodeInt32(t *testing.T) {
+	corpora := differentialInt32Corpora()
+	for _, kernel := range decodeKernels {
+		t.Run(kernel.name, func(t *testing.T) {
+			if !kernel.supported {
+				t.Skipf("CPU does not support %s instructions", kernel.name)
+			}
+			for _, data := range corpora {
+				want := make([]int32, len(data))
+				got := make([]int32, len(data))
+				encoded := encodeExactInt32(data, encodeInt32scalar)
+				decodeInt32scalar(want, encoded)
+				checkDecodedSize(t, kernel.int32(got, encoded), len(encoded))
+				checkDecodedInt32(t, got, want)
+				for _, p := range differentialPrevious {
+					previous := int32(p)
+					encoded := encodeExactInt32(data, func(encoded []byte, data []int32) int {
+						return encodeDeltaInt32scalar(encoded, data, previous)
+					})
+					decodeDeltaInt32scalar(want, encoded, previous)
+					checkDecodedSize(t, kernel.deltaInt32(got, encoded, previous), len(encoded))
+					checkDecodedInt32(t, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDifferentialEncodeUint32(t *testing.T) {
+	corpora := differentialUint32Corpora()
+	for _, kernel := range encodeKernels {
+		t.Run(kernel.name, func(t *testing.T) {
+			if !kernel.supported {
+				t.Skipf("CPU does not support %s instructions", kernel.name)
+			}
+			for _, data := range corpora {
+				checkEncodedEqual(t, encodeExact(data, kernel.uint32), encodeExact(data, encodeUint32scalar))
+				for _, previous := range differentialPrevious {
+					got := encodeExact(data, func(encoded []byte, data []uint32) int {
+						return kernel.deltaUint32(encoded, data, previous)
+					})
+					want := encodeExact(data, func(encoded []byte, data []uint32) int {
+						return encodeDeltaUint32scalar(encoded, data, previous)
+					})
+					checkEncodedEqual(t, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDifferentialEncodeInt32(t *testing.T) {
+	corpora := differentialInt32Corpora()
+	for _, kernel := range encodeKernels {
+		t.Run(kernel.name, func(t *testing.T) {
+			if !kernel.supported {
+				t.Skipf("CPU does not support %s instructions", kernel.name)
+			}
+			for _, data := range corpora {
+				checkEncodedEqual(t, encodeExactInt32(data, kernel.int32), encodeExactInt32(data, encodeInt32scalar))
+				for _, p := range differentialPrevious {
+					previous := int32(p)
+					got := encodeExactInt32(data, func(encoded []byte, data []int32) int {
+						return kernel.deltaInt32(encoded, data, previous)
+					})
+					want := encodeExactInt32(data, func(encoded []byte, data []int32) int {
+						return encodeDeltaInt32scalar(encoded, data, previous)
+					})
+					checkEncodedEqual(t, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestEncodeWithinLen checks that the encoders write only within
+// len(encoded), leaving any spare capacity after it untouched.
+func TestEncodeWithinLen(t *testing.T) {
+	const sentinel, spare = 0xA5, 64
+	r := rand.New(rand.NewSource(differentialSeed))
+	for _, k := range encodeKernels {
+		if !k.supported {
+			t.Logf("skipping %s: not supported by the CPU", k.name)
+			continue
+		}
+		for size := 0; size <= 200; size++ {
+			data := makeRandomUint32(r, size)
+			int32Data := makeRandomInt32(r, size)
+			previous := r.Uint32()
+			for _, tc := range []struct {
+				name      string
+				encode    func([]byte) int
+				reference func([]byte) int
+			}{
+				{"uint32",
+					func(b []byte) int { return k.uint32(b, data) },
+					func(b []byte) int { return encodeUint32scalar(b, data) }},
+				{"delta uint32",
+					func(b []byte) int { return k.deltaUint32(b, data, previous) },
+					func(b []byte) int { return encodeDeltaUint32scalar(b, data, previous) }},
+				{"int32",
+					func(b []byte) int { return k.int32(b, int32Data) },
+					func(b []byte) int { return encodeInt32scalar(b, int32Data) }},
+				{"delta int32",
+					func(b []byte) int { return k.deltaInt32(b, int32Data, int32(previous)) },
+					func(b []byte) int { return encodeDeltaInt32scalar(b, int32Data, int32(previous)) }},
+			} {
+				expected := make([]byte, MaxSize32(size))
+				expected = expected[:tc.reference(expected)]
+				m := len(expected)
+				buf := make([]byte, m+spare)
+				for i := m; i < len(buf); i++ {
+					buf[i] = sentinel
+				}
+				if n := tc.encode(buf[:m]); n != m || !bytes.Equal(buf[:m], expected) {
+					t.Errorf("%s %s size %d: got encoded size %d, expected: %d", k.name, tc.name, size, n, m)
+				}
+				for i := m; i < len(buf); i++ {
+					if buf[i] != sentinel {
+						t.Fatalf("%s %s size %d: overwrote spare capacity at len+%d", k.name, tc.name, size, i-m)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+//go:generate go run gen_encode_sse41.go -out encode_sse41_amd64.s
+//go:generate go run gen_encode_avx512.go -out encode_avx512_amd64.s
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"golang.org/x/sys/cpu"
+)
+
+// hasSSE41 reports whether the SSE4.1 encoders can be used, they
+// also rely on the SSSE3 PSHUFB and PALIGNR instructions.
+var hasSSE41 = cpu.X86.HasSSE41 && cpu.X86.HasSSSE3
+
+// hasAVX512VBMI2 reports whether the AVX-512 encoders can be used, they
+// rely on the VPCOMPRESSB instruction from the VBMI2 extension.
+var hasAVX512VBMI2 = cpu.X86.HasAVX512F && cpu.X86.HasAVX512BW && cpu.X86.HasAVX512VL &&
+	cpu.X86.HasAVX512VBMI2 && cpu.X86.HasPOPCNT
+
+// uint32
+
+func encodeUint32(encoded []byte, data []uint32) int {
+	k := activeKernel()
+	if k >= KernelAVX512 && hasAVX512VBMI2 {
+		return encodeUint32AVX512(encoded, data)
+	}
+	if k >= KernelSSE3 && hasSSE41 {
+		return encodeUint32SSE41(encoded, data)
+	}
+	return encodeUint32scalar(encoded, data)
+}
+
+func encodeUint32SSE41(encoded []byte, data []uint32) int
+
+func encodeUint32AVX512(encoded []byte, data []uint32) int
+
+func encodeDeltaUint32(encoded []byte, data []uint32, previous uint32) int {
+	k := activeKernel()
+	if k >= KernelAVX512 && hasAVX512VBMI2 {
+		return encodeDeltaUint32AVX512(encoded, data, previous)
+	}
+	if k >= KernelSSE3 && hasSSE41 {
+		return encodeDeltaUint32SSE41(encoded, data, previous)
+	}
+	return encodeDeltaUint32scalar(encoded, data, previous)
+}
+
+func encodeDeltaUint32SSE41(encoded []byte, data []uint32, previous uint32) int
+
+func encodeDeltaUint32AVX512(encoded []byte, data []uint32, previous uint32) int
+
+// int32
+
+func encodeInt32(encoded []byte, data []int32) int {
+	k := activeKernel()
+	if k >= KernelAVX512 && hasAVX512VBMI2 {
+		return encodeInt32AVX512(encoded, data)
+	}
+	if k >= KernelSSE3 && hasSSE41 {
+		return encodeInt32SSE41(encoded, data)
+	}
+	return encodeInt32scalar(encoded, data)
+}
+
+func encodeInt32SSE41(encoded []byte, data []int32) int
+
+func encodeInt32AVX512(encoded []byte, data []int32) int
+
+func encodeDeltaInt32(encoded []byte, data []int32, previous int32) int {
+	k := activeKernel()
+	if k >= KernelAVX512 && hasAVX512VBMI2 {
+		return encodeDeltaInt32AVX512(encoded, data, previous)
+	}
+	if k >= KernelSSE3 && hasSSE41 {
+		return encodeDeltaInt32SSE41(encoded, data, previous)
+	}
+	return encodeDeltaInt32scalar(encoded, data, previous)
+}
+
+func encodeDeltaInt32SSE41(encoded []byte, data []int32, previous int32) int
+
+func encodeDeltaInt32AVX512(encoded []byte, data []int32, previous int32) int
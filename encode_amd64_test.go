@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"testing"
+)
+
+// uint32
+
+func TestRoundTripUint32SSE41(t *testing.T) {
+	if !hasSSE41 {
+		t.Skip("CPU does not support SSE4.1 instructions")
+	}
+	testUniformAndRandomUint32(t, encodeUint32SSE41, decodeUint32scalar)
+}
+
+func encodeDeltaUint32SSE41Test(encoded []byte, data []uint32) int {
+	return encodeDeltaUint32SSE41(encoded, data, 0)
+}
+
+func TestRoundTripDeltaUint32SSE41(t *testing.T) {
+	if !hasSSE41 {
+		t.Skip("CPU does not support SSE4.1 instructions")
+	}
+	testUniformDeltaAndRandomUint32(t, encodeDeltaUint32SSE41Test, decodeDeltaUint32scalarTest)
+}
+
+func BenchmarkEncodeUint32SSE41(b *testing.B) {
+	if !hasSSE41 {
+		b.Skip("CPU does not support SSE4.1 instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	for i := 0; i < b.N; i++ {
+		benchEncodedSize = encodeUint32SSE41(benchEncoded, benchUint32Data)
+	}
+}
+
+func BenchmarkEncodeDeltaUint32SSE41(b *testing.B) {
+	if !hasSSE41 {
+		b.Skip("CPU does not support SSE4.1 instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	for i := 0; i < b.N; i++ {
+		benchEncodedSize = encodeDeltaUint32SSE41(benchEncoded, benchUint32DataSorted, 0)
+	}
+}
+
+func TestRoundTripEncodeUint32AVX512(t *testing.T) {
+	if !hasAVX512VBMI2 {
+		t.Skip("CPU does not support AVX-512 VBMI2 instructions")
+	}
+	testUniformAndRandomUint32(t, encodeUint32AVX512, decodeUint32scalar)
+}
+
+func encodeDeltaUint32AVX512Test(encoded []byte, data []uint32) int {
+	return encodeDeltaUint32AVX512(encoded, data, 0)
+}
+
+func TestRoundTripEncodeDeltaUint32AVX512(t *testing.T) {
+	if !hasAVX512VBMI2 {
+		t.Skip("CPU does not support AVX-512 VBMI2 instructions")
+	}
+	testUniformDeltaAndRandomUint32(t, encodeDeltaUint32AVX512Test, decodeDeltaUint32scalarTest)
+}
+
+func BenchmarkEncodeUint32AVX512(b *testing.B) {
+	if !hasAVX512VBMI2 {
+		b.Skip("CPU does not support AVX-512 VBMI2 instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	for i := 0; i < b.N; i++ {
+		benchEncodedSize = encodeUint32AVX512(benchEncoded, benchUint32Data)
+	}
+}
+
+func BenchmarkEncodeDeltaUint32AVX512(b *testing.B) {
+	if !hasAVX512VBMI2 {
+		b.Skip("CPU does not support AVX-512 VBMI2 instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	for i := 0; i < b.N; i++ {
+		benchEncodedSize = encodeDeltaUint32AVX512(benchEncoded, benchUint32DataSorted, 0)
+	}
+}
+
+// int32
+
+func TestRoundTripInt32SSE41(t *testing.T) {
+	if !hasSSE41 {
+		t.Skip("CPU does not support SSE4.1 instructions")
+	}
+	testUniformAndRandomInt32(t, encodeInt32SSE41, decodeInt32scalar)
+}
+
+func encodeDeltaInt32SSE41Test(encoded []byte, data []int32) int {
+	return encodeDeltaInt32SSE41(encoded, data, 0)
+}
+
+func TestRoundTripDeltaInt32SSE41(t *testing.T) {
+	if !hasSSE41 {
+		t.Skip("CPU does not support SSE4.1 instructions")
+	}
+	testUniformDeltaAndRandomInt32(t, encodeDeltaInt32SSE41Test, decodeDeltaInt32scalarTest)
+}
+
+func BenchmarkEncodeInt32SSE41(b *testing.B) {
+	if !hasSSE41 {
+		b.Skip("CPU does not support SSE4.1 instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	for i := 0; i < b.N; i++ {
+		benchEncodedSize = encodeInt32SSE41(benchEncoded, benchInt32Data)
+	}
+}
+
+func BenchmarkEncodeDeltaInt32SSE41(b *testing.B) {
+	if !hasSSE41 {
+		b.Skip("CPU does not support SSE4.1 instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	for i := 0; i < b.N; i++ {
+		benchEncodedSize = encodeDeltaInt32SSE41(benchEncoded, benchInt32DataSorted, 0)
+	}
+}
+
+func TestRoundTripEncodeInt32AVX512(t *testing.T) {
+	if !hasAVX512VBMI2 {
+		t.Skip("CPU does not support AVX-512 VBMI2 instructions")
+	}
+	testUniformAndRandomInt32(t, encodeInt32AVX512, decodeInt32scalar)
+}
+
+func encodeDeltaInt32AVX512Test(encoded []byte, data []int32) int {
+	return encodeDeltaInt32AVX512(encoded, data, 0)
+}
+
+func TestRoundTripEncodeDeltaInt32AVX512(t *testing.T) {
+	if !hasAVX512VBMI2 {
+		t.Skip("CPU does not support AVX-512 VBMI2 instructions")
+	}
+	testUniformDeltaAndRandomInt32(t, encodeDeltaInt32AVX512Test, decodeDeltaInt32scalarTest)
+}
+
+func BenchmarkEncodeInt32AVX512(b *testing.B) {
+	if !hasAVX512VBMI2 {
+		b.Skip("CPU does not support AVX-512 VBMI2 instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	for i := 0; i < b.N; i++ {
+		benchEncodedSize = encodeInt32AVX512(benchEncoded, benchInt32Data)
+	}
+}
+
+func BenchmarkEncodeDeltaInt32AVX512(b *testing.B) {
+	if !hasAVX512VBMI2 {
+		b.Skip("CPU does not support AVX-512 VBMI2 instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	for i := 0; i < b.N; i++ {
+		benchEncodedSize = encodeDeltaInt32AVX512(benchEncoded, benchInt32DataSorted, 0)
+	}
+}
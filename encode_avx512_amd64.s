@@ -0,0 +1,687 @@
+// Code generated by command: go run gen_encode_avx512.go -out encode_avx512_amd64.s. DO NOT EDIT.
+
+#include "textflag.h"
+
+// func encodeUint32AVX512(encoded []byte, data []uint32) int
+// Requires: AVX, AVX512BW, AVX512F, AVX512VBMI2, AVX512VL, POPCNT
+TEXT ·encodeUint32AVX512(SB), NOSPLIT, $0-56
+	MOVQ encoded_base+0(FP), AX
+	MOVQ encoded_len+8(FP), CX
+	MOVQ data_base+24(FP), DX
+	MOVQ data_len+32(FP), BX
+
+	// Initialize the control index.
+	XORQ SI, SI
+
+	// Initialize the data index. (len(data) + 3) >> 2
+	MOVQ BX, DI
+	ADDQ $0x03, DI
+	SHRQ $0x02, DI
+
+	// Initialize the input index.
+	XORQ R8, R8
+
+	// The constants used to compute the byte length codes.
+	MOVL         $0x00000001, R9
+	VPBROADCASTD R9, Z0
+	MOVL         $0x000000ff, R9
+	VPBROADCASTD R9, Z1
+	MOVL         $0x0000ffff, R9
+	VPBROADCASTD R9, Z2
+	MOVL         $0x00ffffff, R9
+	VPBROADCASTD R9, Z3
+	MOVL         $0x03020100, R9
+	VPBROADCASTD R9, Z4
+	MOVL         $0x01010101, R9
+	VPBROADCASTD R9, Z5
+	MOVL         $0x01041040, R9
+	VPBROADCASTD R9, Z6
+
+avx512:
+	// Check if less than 64 encoded bytes remain and jump to scalar.
+	LEAQ 64(DI), R9
+	CMPQ R9, CX
+	JGT  scalarStart
+
+	// Check if less than 16 values remain and jump to scalar.
+	LEAQ 16(R8), R9
+	CMPQ R9, BX
+	JGT  scalarStart
+
+	// Load 16 values.
+	VMOVDQU32 (DX)(R8*4), Z7
+
+	// Increment the byte length code for each byte width the value exceeds.
+	VPXORD  Z8, Z8, Z8
+	VPCMPUD $0x06, Z1, Z7, K1
+	VPADDD  Z0, Z8, K1, Z8
+	VPCMPUD $0x06, Z2, Z7, K1
+	VPADDD  Z0, Z8, K1, Z8
+	VPCMPUD $0x06, Z3, Z7, K1
+	VPADDD  Z0, Z8, K1, Z8
+
+	// Select the bytes whose index within the value is at most the code.
+	VPMULLD Z5, Z8, Z9
+	VPCMPUB $0x02, Z9, Z4, K1
+	KMOVQ   K1, R9
+	POPCNTQ R9, R9
+
+	// Pack the selected bytes and store 64 data bytes.
+	VPCOMPRESSB.Z Z7, K1, Z7
+	VMOVDQU64     Z7, (AX)(DI*1)
+
+	// Pack the codes of each group of 4 values into a control byte.
+	VPMOVDB Z8, X7
+	VPMULLD X6, X7, X7
+	VPSRLD  $0x18, X7, X7
+	VPMOVDB X7, X7
+
+	// Store 4 control bytes.
+	VMOVD X7, (AX)(SI*1)
+	ADDQ  $0x04, SI
+
+	// Increment the indices.
+	ADDQ $0x10, R8
+	ADDQ R9, DI
+	JMP  avx512
+
+scalarStart:
+	XORL CX, CX
+
+scalar:
+	// Process a single value at a time.
+	CMPQ R8, BX
+	JE   finish
+	MOVL (DX)(R8*4), R9
+
+	// Shift control byte to make room for the next code.
+	SHRL $0x02, CX
+
+	// Switch on the number of bytes needed to hold the value.
+	CMPL R9, $0x000000ff
+	JBE  oneByte
+	CMPL R9, $0x0000ffff
+	JBE  twoByte
+	CMPL R9, $0x00ffffff
+	JBE  threeByte
+	MOVL R9, (AX)(DI*1)
+	ADDQ $0x04, DI
+	ORL  $0x000000c0, CX
+	JMP  nextValue
+
+threeByte:
+	MOVW R9, (AX)(DI*1)
+	SHRL $0x10, R9
+	MOVB R9, 2(AX)(DI*1)
+	ADDQ $0x03, DI
+	ORL  $0x00000080, CX
+	JMP  nextValue
+
+twoByte:
+	MOVW R9, (AX)(DI*1)
+	ADDQ $0x02, DI
+	ORL  $0x00000040, CX
+	JMP  nextValue
+
+oneByte:
+	MOVB R9, (AX)(DI*1)
+	INCQ DI
+
+nextValue:
+	INCQ R8
+
+	// Store the control byte if the block of 4 values is complete.
+	TESTQ $0x00000003, R8
+	JNE   scalar
+	MOVB  CL, (AX)(SI*1)
+	INCQ  SI
+	XORL  CX, CX
+	JMP   scalar
+
+finish:
+	// Check if the last block was complete or the control byte needs to be shifted and written.
+	TESTQ $0x00000003, R8
+	JE    done
+
+pad:
+	SHRL  $0x02, CX
+	INCQ  R8
+	TESTQ $0x00000003, R8
+	JNE   pad
+	MOVB  CL, (AX)(SI*1)
+
+done:
+	VZEROUPPER
+	MOVQ DI, ret+48(FP)
+	RET
+
+// func encodeDeltaUint32AVX512(encoded []byte, data []uint32, previous uint32) int
+// Requires: AVX, AVX512BW, AVX512F, AVX512VBMI2, AVX512VL, POPCNT
+TEXT ·encodeDeltaUint32AVX512(SB), NOSPLIT, $0-64
+	MOVQ encoded_base+0(FP), AX
+	MOVQ encoded_len+8(FP), CX
+	MOVQ data_base+24(FP), DX
+	MOVQ data_len+32(FP), BX
+
+	// Initialize the control index.
+	XORQ SI, SI
+
+	// Initialize the data index. (len(data) + 3) >> 2
+	MOVQ BX, DI
+	ADDQ $0x03, DI
+	SHRQ $0x02, DI
+
+	// Initialize the input index.
+	XORQ R8, R8
+
+	// The constants used to compute the byte length codes.
+	MOVL         $0x00000001, R9
+	VPBROADCASTD R9, Z0
+	MOVL         $0x000000ff, R9
+	VPBROADCASTD R9, Z1
+	MOVL         $0x0000ffff, R9
+	VPBROADCASTD R9, Z2
+	MOVL         $0x00ffffff, R9
+	VPBROADCASTD R9, Z3
+	MOVL         $0x03020100, R9
+	VPBROADCASTD R9, Z4
+	MOVL         $0x01010101, R9
+	VPBROADCASTD R9, Z5
+	MOVL         $0x01041040, R9
+	VPBROADCASTD R9, Z6
+	MOVL         previous+48(FP), R9
+	VPBROADCASTD R9, Z7
+
+avx512:
+	// Check if less than 64 encoded bytes remain and jump to scalar.
+	LEAQ 64(DI), R9
+	CMPQ R9, CX
+	JGT  scalarStart
+
+	// Check if less than 16 values remain and jump to scalar.
+	LEAQ 16(R8), R9
+	CMPQ R9, BX
+	JGT  scalarStart
+
+	// Load 16 values.
+	VMOVDQU32 (DX)(R8*4), Z8
+
+	// Calculate deltas.
+	// (previous_15, data_0, ..., data_14)
+	VALIGND $0x0f, Z7, Z8, Z9
+
+	// Save the current values as previous for the next block.
+	VMOVDQU64 Z8, Z7
+
+	// (data_0 - previous_15, data_1 - data_0, ..., data_15 - data_14)
+	VPSUBD Z9, Z8, Z8
+
+	// Increment the byte length code for each byte width the value exceeds.
+	VPXORD  Z10, Z10, Z10
+	VPCMPUD $0x06, Z1, Z8, K1
+	VPADDD  Z0, Z10, K1, Z10
+	VPCMPUD $0x06, Z2, Z8, K1
+	VPADDD  Z0, Z10, K1, Z10
+	VPCMPUD $0x06, Z3, Z8, K1
+	VPADDD  Z0, Z10, K1, Z10
+
+	// Select the bytes whose index within the value is at most the code.
+	VPMULLD Z5, Z10, Z9
+	VPCMPUB $0x02, Z9, Z4, K1
+	KMOVQ   K1, R9
+	POPCNTQ R9, R9
+
+	// Pack the selected bytes and store 64 data bytes.
+	VPCOMPRESSB.Z Z8, K1, Z8
+	VMOVDQU64     Z8, (AX)(DI*1)
+
+	// Pack the codes of each group of 4 values into a control byte.
+	VPMOVDB Z10, X8
+	VPMULLD X6, X8, X8
+	VPSRLD  $0x18, X8, X8
+	VPMOVDB X8, X8
+
+	// Store 4 control bytes.
+	VMOVD X8, (AX)(SI*1)
+	ADDQ  $0x04, SI
+
+	// Increment the indices.
+	ADDQ $0x10, R8
+	ADDQ R9, DI
+	JMP  avx512
+
+scalarStart:
+	// Extract the last value of the SIMD loop as previous.
+	VALIGND $0x0f, Z7, Z7, Z7
+	VMOVD   X7, CX
+	XORL    R9, R9
+
+scalar:
+	// Process a single value at a time.
+	CMPQ R8, BX
+	JE   finish
+	MOVL (DX)(R8*4), R10
+
+	// Calculate delta.
+	MOVL R10, R11
+	SUBL CX, R10
+	MOVL R11, CX
+
+	// Shift control byte to make room for the next code.
+	SHRL $0x02, R9
+
+	// Switch on the number of bytes needed to hold the value.
+	CMPL R10, $0x000000ff
+	JBE  oneByte
+	CMPL R10, $0x0000ffff
+	JBE  twoByte
+	CMPL R10, $0x00ffffff
+	JBE  threeByte
+	MOVL R10, (AX)(DI*1)
+	ADDQ $0x04, DI
+	ORL  $0x000000c0, R9
+	JMP  nextValue
+
+threeByte:
+	MOVW R10, (AX)(DI*1)
+	SHRL $0x10, R10
+	MOVB R10, 2(AX)(DI*1)
+	ADDQ $0x03, DI
+	ORL  $0x00000080, R9
+	JMP  nextValue
+
+twoByte:
+	MOVW R10, (AX)(DI*1)
+	ADDQ $0x02, DI
+	ORL  $0x00000040, R9
+	JMP  nextValue
+
+oneByte:
+	MOVB R10, (AX)(DI*1)
+	INCQ DI
+
+nextValue:
+	INCQ R8
+
+	// Store the control byte if the block of 4 values is complete.
+	TESTQ $0x00000003, R8
+	JNE   scalar
+	MOVB  R9, (AX)(SI*1)
+	INCQ  SI
+	XORL  R9, R9
+	JMP   scalar
+
+finish:
+	// Check if the last block was complete or the control byte needs to be shifted and written.
+	TESTQ $0x00000003, R8
+	JE    done
+
+pad:
+	SHRL  $0x02, R9
+	INCQ  R8
+	TESTQ $0x00000003, R8
+	JNE   pad
+	MOVB  R9, (AX)(SI*1)
+
+done:
+	VZEROUPPER
+	MOVQ DI, ret+56(FP)
+	RET
+
+// func encodeInt32AVX512(encoded []byte, data []int32) int
+// Requires: AVX, AVX512BW, AVX512F, AVX512VBMI2, AVX512VL, POPCNT
+TEXT ·encodeInt32AVX512(SB), NOSPLIT, $0-56
+	MOVQ encoded_base+0(FP), AX
+	MOVQ encoded_len+8(FP), CX
+	MOVQ data_base+24(FP), DX
+	MOVQ data_len+32(FP), BX
+
+	// Initialize the control index.
+	XORQ SI, SI
+
+	// Initialize the data index. (len(data) + 3) >> 2
+	MOVQ BX, DI
+	ADDQ $0x03, DI
+	SHRQ $0x02, DI
+
+	// Initialize the input index.
+	XORQ R8, R8
+
+	// The constants used to compute the byte length codes.
+	MOVL         $0x00000001, R9
+	VPBROADCASTD R9, Z0
+	MOVL         $0x000000ff, R9
+	VPBROADCASTD R9, Z1
+	MOVL         $0x0000ffff, R9
+	VPBROADCASTD R9, Z2
+	MOVL         $0x00ffffff, R9
+	VPBROADCASTD R9, Z3
+	MOVL         $0x03020100, R9
+	VPBROADCASTD R9, Z4
+	MOVL         $0x01010101, R9
+	VPBROADCASTD R9, Z5
+	MOVL         $0x01041040, R9
+	VPBROADCASTD R9, Z6
+
+avx512:
+	// Check if less than 64 encoded bytes remain and jump to scalar.
+	LEAQ 64(DI), R9
+	CMPQ R9, CX
+	JGT  scalarStart
+
+	// Check if less than 16 values remain and jump to scalar.
+	LEAQ 16(R8), R9
+	CMPQ R9, BX
+	JGT  scalarStart
+
+	// Load 16 values.
+	VMOVDQU32 (DX)(R8*4), Z7
+
+	// Zigzag encode.
+	// (x >> 31)
+	VPSRAD $0x1f, Z7, Z8
+
+	// (x << 1)
+	VPSLLD $0x01, Z7, Z7
+
+	// (x << 1) ^ (x >> 31)
+	VPXORD Z8, Z7, Z7
+
+	// Increment the byte length code for each byte width the value exceeds.
+	VPXORD  Z9, Z9, Z9
+	VPCMPUD $0x06, Z1, Z7, K1
+	VPADDD  Z0, Z9, K1, Z9
+	VPCMPUD $0x06, Z2, Z7, K1
+	VPADDD  Z0, Z9, K1, Z9
+	VPCMPUD $0x06, Z3, Z7, K1
+	VPADDD  Z0, Z9, K1, Z9
+
+	// Select the bytes whose index within the value is at most the code.
+	VPMULLD Z5, Z9, Z8
+	VPCMPUB $0x02, Z8, Z4, K1
+	KMOVQ   K1, R9
+	POPCNTQ R9, R9
+
+	// Pack the selected bytes and store 64 data bytes.
+	VPCOMPRESSB.Z Z7, K1, Z7
+	VMOVDQU64     Z7, (AX)(DI*1)
+
+	// Pack the codes of each group of 4 values into a control byte.
+	VPMOVDB Z9, X7
+	VPMULLD X6, X7, X7
+	VPSRLD  $0x18, X7, X7
+	VPMOVDB X7, X7
+
+	// Store 4 control bytes.
+	VMOVD X7, (AX)(SI*1)
+	ADDQ  $0x04, SI
+
+	// Increment the indices.
+	ADDQ $0x10, R8
+	ADDQ R9, DI
+	JMP  avx512
+
+scalarStart:
+	XORL CX, CX
+
+scalar:
+	// Process a single value at a time.
+	CMPQ R8, BX
+	JE   finish
+	MOVL (DX)(R8*4), R9
+
+	// Zigzag encode.
+	MOVL R9, R10
+	SARL $0x1f, R10
+	SHLL $0x01, R9
+	XORL R10, R9
+
+	// Shift control byte to make room for the next code.
+	SHRL $0x02, CX
+
+	// Switch on the number of bytes needed to hold the value.
+	CMPL R9, $0x000000ff
+	JBE  oneByte
+	CMPL R9, $0x0000ffff
+	JBE  twoByte
+	CMPL R9, $0x00ffffff
+	JBE  threeByte
+	MOVL R9, (AX)(DI*1)
+	ADDQ $0x04, DI
+	ORL  $0x000000c0, CX
+	JMP  nextValue
+
+threeByte:
+	MOVW R9, (AX)(DI*1)
+	SHRL $0x10, R9
+	MOVB R9, 2(AX)(DI*1)
+	ADDQ $0x03, DI
+	ORL  $0x00000080, CX
+	JMP  nextValue
+
+twoByte:
+	MOVW R9, (AX)(DI*1)
+	ADDQ $0x02, DI
+	ORL  $0x00000040, CX
+	JMP  nextValue
+
+oneByte:
+	MOVB R9, (AX)(DI*1)
+	INCQ DI
+
+nextValue:
+	INCQ R8
+
+	// Store the control byte if the block of 4 values is complete.
+	TESTQ $0x00000003, R8
+	JNE   scalar
+	MOVB  CL, (AX)(SI*1)
+	INCQ  SI
+	XORL  CX, CX
+	JMP   scalar
+
+finish:
+	// Check if the last block was complete or the control byte needs to be shifted and written.
+	TESTQ $0x00000003, R8
+	JE    done
+
+pad:
+	SHRL  $0x02, CX
+	INCQ  R8
+	TESTQ $0x00000003, R8
+	JNE   pad
+	MOVB  CL, (AX)(SI*1)
+
+done:
+	VZEROUPPER
+	MOVQ DI, ret+48(FP)
+	RET
+
+// func encodeDeltaInt32AVX512(encoded []byte, data []int32, previous int32) int
+// Requires: AVX, AVX512BW, AVX512F, AVX512VBMI2, AVX512VL, POPCNT
+TEXT ·encodeDeltaInt32AVX512(SB), NOSPLIT, $0-64
+	MOVQ encoded_base+0(FP), AX
+	MOVQ encoded_len+8(FP), CX
+	MOVQ data_base+24(FP), DX
+	MOVQ data_len+32(FP), BX
+
+	// Initialize the control index.
+	XORQ SI, SI
+
+	// Initialize the data index. (len(data) + 3) >> 2
+	MOVQ BX, DI
+	ADDQ $0x03, DI
+	SHRQ $0x02, DI
+
+	// Initialize the input index.
+	XORQ R8, R8
+
+	// The constants used to compute the byte length codes.
+	MOVL         $0x00000001, R9
+	VPBROADCASTD R9, Z0
+	MOVL         $0x000000ff, R9
+	VPBROADCASTD R9, Z1
+	MOVL         $0x0000ffff, R9
+	VPBROADCASTD R9, Z2
+	MOVL         $0x00ffffff, R9
+	VPBROADCASTD R9, Z3
+	MOVL         $0x03020100, R9
+	VPBROADCASTD R9, Z4
+	MOVL         $0x01010101, R9
+	VPBROADCASTD R9, Z5
+	MOVL         $0x01041040, R9
+	VPBROADCASTD R9, Z6
+	MOVL         previous+48(FP), R9
+	VPBROADCASTD R9, Z7
+
+avx512:
+	// Check if less than 64 encoded bytes remain and jump to scalar.
+	LEAQ 64(DI), R9
+	CMPQ R9, CX
+	JGT  scalarStart
+
+	// Check if less than 16 values remain and jump to scalar.
+	LEAQ 16(R8), R9
+	CMPQ R9, BX
+	JGT  scalarStart
+
+	// Load 16 values.
+	VMOVDQU32 (DX)(R8*4), Z8
+
+	// Calculate deltas.
+	// (previous_15, data_0, ..., data_14)
+	VALIGND $0x0f, Z7, Z8, Z9
+
+	// Save the current values as previous for the next block.
+	VMOVDQU64 Z8, Z7
+
+	// (data_0 - previous_15, data_1 - data_0, ..., data_15 - data_14)
+	VPSUBD Z9, Z8, Z8
+
+	// Zigzag encode.
+	// (x >> 31)
+	VPSRAD $0x1f, Z8, Z9
+
+	// (x << 1)
+	VPSLLD $0x01, Z8, Z8
+
+	// (x << 1) ^ (x >> 31)
+	VPXORD Z9, Z8, Z8
+
+	// Increment the byte length code for each byte width the value exceeds.
+	VPXORD  Z10, Z10, Z10
+	VPCMPUD $0x06, Z1, Z8, K1
+	VPADDD  Z0, Z10, K1, Z10
+	VPCMPUD $0x06, Z2, Z8, K1
+	VPADDD  Z0, Z10, K1, Z10
+	VPCMPUD $0x06, Z3, Z8, K1
+	VPADDD  Z0, Z10, K1, Z10
+
+	// Select the bytes whose index within the value is at most the code.
+	VPMULLD Z5, Z10, Z9
+	VPCMPUB $0x02, Z9, Z4, K1
+	KMOVQ   K1, R9
+	POPCNTQ R9, R9
+
+	// Pack the selected bytes and store 64 data bytes.
+	VPCOMPRESSB.Z Z8, K1, Z8
+	VMOVDQU64     Z8, (AX)(DI*1)
+
+	// Pack the codes of each group of 4 values into a control byte.
+	VPMOVDB Z10, X8
+	VPMULLD X6, X8, X8
+	VPSRLD  $0x18, X8, X8
+	VPMOVDB X8, X8
+
+	// Store 4 control bytes.
+	VMOVD X8, (AX)(SI*1)
+	ADDQ  $0x04, SI
+
+	// Increment the indices.
+	ADDQ $0x10, R8
+	ADDQ R9, DI
+	JMP  avx512
+
+scalarStart:
+	// Extract the last value of the SIMD loop as previous.
+	VALIGND $0x0f, Z7, Z7, Z7
+	VMOVD   X7, CX
+	XORL    R9, R9
+
+scalar:
+	// Process a single value at a time.
+	CMPQ R8, BX
+	JE   finish
+	MOVL (DX)(R8*4), R10
+
+	// Calculate delta.
+	MOVL R10, R11
+	SUBL CX, R10
+	MOVL R11, CX
+
+	// Zigzag encode.
+	MOVL R10, R11
+	SARL $0x1f, R11
+	SHLL $0x01, R10
+	XORL R11, R10
+
+	// Shift control byte to make room for the next code.
+	SHRL $0x02, R9
+
+	// Switch on the number of bytes needed to hold the value.
+	CMPL R10, $0x000000ff
+	JBE  oneByte
+	CMPL R10, $0x0000ffff
+	JBE  twoByte
+	CMPL R10, $0x00ffffff
+	JBE  threeByte
+	MOVL R10, (AX)(DI*1)
+	ADDQ $0x04, DI
+	ORL  $0x000000c0, R9
+	JMP  nextValue
+
+threeByte:
+	MOVW R10, (AX)(DI*1)
+	SHRL $0x10, R10
+	MOVB R10, 2(AX)(DI*1)
+	ADDQ $0x03, DI
+	ORL  $0x00000080, R9
+	JMP  nextValue
+
+twoByte:
+	MOVW R10, (AX)(DI*1)
+	ADDQ $0x02, DI
+	ORL  $0x00000040, R9
+	JMP  nextValue
+
+oneByte:
+	MOVB R10, (AX)(DI*1)
+	INCQ DI
+
+nextValue:
+	INCQ R8
+
+	// Store the control byte if the block of 4 values is complete.
+	TESTQ $0x00000003, R8
+	JNE   scalar
+	MOVB  R9, (AX)(SI*1)
+	INCQ  SI
+	XORL  R9, R9
+	JMP   scalar
+
+finish:
+	// Check if the last block was complete or the control byte needs to be shifted and written.
+	TESTQ $0x00000003, R8
+	JE    done
+
+pad:
+	SHRL  $0x02, R9
+	INCQ  R8
+	TESTQ $0x00000003, R8
+	JNE   pad
+	MOVB  R9, (AX)(SI*1)
+
+done:
+	VZEROUPPER
+	MOVQ DI, ret+56(FP)
+	RET
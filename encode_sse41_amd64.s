@@ -0,0 +1,1507 @@
+// Code generated by command: go run gen_encode_sse41.go -out encode_sse41_amd64.s. DO NOT EDIT.
+
+#include "textflag.h"
+
+DATA dataByteCount<>+0(SB)/1, $0x04
+DATA dataByteCount<>+1(SB)/1, $0x05
+DATA dataByteCount<>+2(SB)/1, $0x06
+DATA dataByteCount<>+3(SB)/1, $0x07
+DATA dataByteCount<>+4(SB)/1, $0x05
+DATA dataByteCount<>+5(SB)/1, $0x06
+DATA dataByteCount<>+6(SB)/1, $0x07
+DATA dataByteCount<>+7(SB)/1, $0x08
+DATA dataByteCount<>+8(SB)/1, $0x06
+DATA dataByteCount<>+9(SB)/1, $0x07
+DATA dataByteCount<>+10(SB)/1, $0x08
+DATA dataByteCount<>+11(SB)/1, $0x09
+DATA dataByteCount<>+12(SB)/1, $0x07
+DATA dataByteCount<>+13(SB)/1, $0x08
+DATA dataByteCount<>+14(SB)/1, $0x09
+DATA dataByteCount<>+15(SB)/1, $0x0a
+DATA dataByteCount<>+16(SB)/1, $0x05
+DATA dataByteCount<>+17(SB)/1, $0x06
+DATA dataByteCount<>+18(SB)/1, $0x07
+DATA dataByteCount<>+19(SB)/1, $0x08
+DATA dataByteCount<>+20(SB)/1, $0x06
+DATA dataByteCount<>+21(SB)/1, $0x07
+DATA dataByteCount<>+22(SB)/1, $0x08
+DATA dataByteCount<>+23(SB)/1, $0x09
+DATA dataByteCount<>+24(SB)/1, $0x07
+DATA dataByteCount<>+25(SB)/1, $0x08
+DATA dataByteCount<>+26(SB)/1, $0x09
+DATA dataByteCount<>+27(SB)/1, $0x0a
+DATA dataByteCount<>+28(SB)/1, $0x08
+DATA dataByteCount<>+29(SB)/1, $0x09
+DATA dataByteCount<>+30(SB)/1, $0x0a
+DATA dataByteCount<>+31(SB)/1, $0x0b
+DATA dataByteCount<>+32(SB)/1, $0x06
+DATA dataByteCount<>+33(SB)/1, $0x07
+DATA dataByteCount<>+34(SB)/1, $0x08
+DATA dataByteCount<>+35(SB)/1, $0x09
+DATA dataByteCount<>+36(SB)/1, $0x07
+DATA dataByteCount<>+37(SB)/1, $0x08
+DATA dataByteCount<>+38(SB)/1, $0x09
+DATA dataByteCount<>+39(SB)/1, $0x0a
+DATA dataByteCount<>+40(SB)/1, $0x08
+DATA dataByteCount<>+41(SB)/1, $0x09
+DATA dataByteCount<>+42(SB)/1, $0x0a
+DATA dataByteCount<>+43(SB)/1, $0x0b
+DATA dataByteCount<>+44(SB)/1, $0x09
+DATA dataByteCount<>+45(SB)/1, $0x0a
+DATA dataByteCount<>+46(SB)/1, $0x0b
+DATA dataByteCount<>+47(SB)/1, $0x0c
+DATA dataByteCount<>+48(SB)/1, $0x07
+DATA dataByteCount<>+49(SB)/1, $0x08
+DATA dataByteCount<>+50(SB)/1, $0x09
+DATA dataByteCount<>+51(SB)/1, $0x0a
+DATA dataByteCount<>+52(SB)/1, $0x08
+DATA dataByteCount<>+53(SB)/1, $0x09
+DATA dataByteCount<>+54(SB)/1, $0x0a
+DATA dataByteCount<>+55(SB)/1, $0x0b
+DATA dataByteCount<>+56(SB)/1, $0x09
+DATA dataByteCount<>+57(SB)/1, $0x0a
+DATA dataByteCount<>+58(SB)/1, $0x0b
+DATA dataByteCount<>+59(SB)/1, $0x0c
+DATA dataByteCount<>+60(SB)/1, $0x0a
+DATA dataByteCount<>+61(SB)/1, $0x0b
+DATA dataByteCount<>+62(SB)/1, $0x0c
+DATA dataByteCount<>+63(SB)/1, $0x0d
+DATA dataByteCount<>+64(SB)/1, $0x05
+DATA dataByteCount<>+65(SB)/1, $0x06
+DATA dataByteCount<>+66(SB)/1, $0x07
+DATA dataByteCount<>+67(SB)/1, $0x08
+DATA dataByteCount<>+68(SB)/1, $0x06
+DATA dataByteCount<>+69(SB)/1, $0x07
+DATA dataByteCount<>+70(SB)/1, $0x08
+DATA dataByteCount<>+71(SB)/1, $0x09
+DATA dataByteCount<>+72(SB)/1, $0x07
+DATA dataByteCount<>+73(SB)/1, $0x08
+DATA dataByteCount<>+74(SB)/1, $0x09
+DATA dataByteCount<>+75(SB)/1, $0x0a
+DATA dataByteCount<>+76(SB)/1, $0x08
+DATA dataByteCount<>+77(SB)/1, $0x09
+DATA dataByteCount<>+78(SB)/1, $0x0a
+DATA dataByteCount<>+79(SB)/1, $0x0b
+DATA dataByteCount<>+80(SB)/1, $0x06
+DATA dataByteCount<>+81(SB)/1, $0x07
+DATA dataByteCount<>+82(SB)/1, $0x08
+DATA dataByteCount<>+83(SB)/1, $0x09
+DATA dataByteCount<>+84(SB)/1, $0x07
+DATA dataByteCount<>+85(SB)/1, $0x08
+DATA dataByteCount<>+86(SB)/1, $0x09
+DATA dataByteCount<>+87(SB)/1, $0x0a
+DATA dataByteCount<>+88(SB)/1, $0x08
+DATA dataByteCount<>+89(SB)/1, $0x09
+DATA dataByteCount<>+90(SB)/1, $0x0a
+DATA dataByteCount<>+91(SB)/1, $0x0b
+DATA dataByteCount<>+92(SB)/1, $0x09
+DATA dataByteCount<>+93(SB)/1, $0x0a
+DATA dataByteCount<>+94(SB)/1, $0x0b
+DATA dataByteCount<>+95(SB)/1, $0x0c
+DATA dataByteCount<>+96(SB)/1, $0x07
+DATA dataByteCount<>+97(SB)/1, $0x08
+DATA dataByteCount<>+98(SB)/1, $0x09
+DATA dataByteCount<>+99(SB)/1, $0x0a
+DATA dataByteCount<>+100(SB)/1, $0x08
+DATA dataByteCount<>+101(SB)/1, $0x09
+DATA dataByteCount<>+102(SB)/1, $0x0a
+DATA dataByteCount<>+103(SB)/1, $0x0b
+DATA dataByteCount<>+104(SB)/1, $0x09
+DATA dataByteCount<>+105(SB)/1, $0x0a
+DATA dataByteCount<>+106(SB)/1, $0x0b
+DATA dataByteCount<>+107(SB)/1, $0x0c
+DATA dataByteCount<>+108(SB)/1, $0x0a
+DATA dataByteCount<>+109(SB)/1, $0x0b
+DATA dataByteCount<>+110(SB)/1, $0x0c
+DATA dataByteCount<>+111(SB)/1, $0x0d
+DATA dataByteCount<>+112(SB)/1, $0x08
+DATA dataByteCount<>+113(SB)/1, $0x09
+DATA dataByteCount<>+114(SB)/1, $0x0a
+DATA dataByteCount<>+115(SB)/1, $0x0b
+DATA dataByteCount<>+116(SB)/1, $0x09
+DATA dataByteCount<>+117(SB)/1, $0x0a
+DATA dataByteCount<>+118(SB)/1, $0x0b
+DATA dataByteCount<>+119(SB)/1, $0x0c
+DATA dataByteCount<>+120(SB)/1, $0x0a
+DATA dataByteCount<>+121(SB)/1, $0x0b
+DATA dataByteCount<>+122(SB)/1, $0x0c
+DATA dataByteCount<>+123(SB)/1, $0x0d
+DATA dataByteCount<>+124(SB)/1, $0x0b
+DATA dataByteCount<>+125(SB)/1, $0x0c
+DATA dataByteCount<>+126(SB)/1, $0x0d
+DATA dataByteCount<>+127(SB)/1, $0x0e
+DATA dataByteCount<>+128(SB)/1, $0x06
+DATA dataByteCount<>+129(SB)/1, $0x07
+DATA dataByteCount<>+130(SB)/1, $0x08
+DATA dataByteCount<>+131(SB)/1, $0x09
+DATA dataByteCount<>+132(SB)/1, $0x07
+DATA dataByteCount<>+133(SB)/1, $0x08
+DATA dataByteCount<>+134(SB)/1, $0x09
+DATA dataByteCount<>+135(SB)/1, $0x0a
+DATA dataByteCount<>+136(SB)/1, $0x08
+DATA dataByteCount<>+137(SB)/1, $0x09
+DATA dataByteCount<>+138(SB)/1, $0x0a
+DATA dataByteCount<>+139(SB)/1, $0x0b
+DATA dataByteCount<>+140(SB)/1, $0x09
+DATA dataByteCount<>+141(SB)/1, $0x0a
+DATA dataByteCount<>+142(SB)/1, $0x0b
+DATA dataByteCount<>+143(SB)/1, $0x0c
+DATA dataByteCount<>+144(SB)/1, $0x07
+DATA dataByteCount<>+145(SB)/1, $0x08
+DATA dataByteCount<>+146(SB)/1, $0x09
+DATA dataByteCount<>+147(SB)/1, $0x0a
+DATA dataByteCount<>+148(SB)/1, $0x08
+DATA dataByteCount<>+149(SB)/1, $0x09
+DATA dataByteCount<>+150(SB)/1, $0x0a
+DATA dataByteCount<>+151(SB)/1, $0x0b
+DATA dataByteCount<>+152(SB)/1, $0x09
+DATA dataByteCount<>+153(SB)/1, $0x0a
+DATA dataByteCount<>+154(SB)/1, $0x0b
+DATA dataByteCount<>+155(SB)/1, $0x0c
+DATA dataByteCount<>+156(SB)/1, $0x0a
+DATA dataByteCount<>+157(SB)/1, $0x0b
+DATA dataByteCount<>+158(SB)/1, $0x0c
+DATA dataByteCount<>+159(SB)/1, $0x0d
+DATA dataByteCount<>+160(SB)/1, $0x08
+DATA dataByteCount<>+161(SB)/1, $0x09
+DATA dataByteCount<>+162(SB)/1, $0x0a
+DATA dataByteCount<>+163(SB)/1, $0x0b
+DATA dataByteCount<>+164(SB)/1, $0x09
+DATA dataByteCount<>+165(SB)/1, $0x0a
+DATA dataByteCount<>+166(SB)/1, $0x0b
+DATA dataByteCount<>+167(SB)/1, $0x0c
+DATA dataByteCount<>+168(SB)/1, $0x0a
+DATA dataByteCount<>+169(SB)/1, $0x0b
+DATA dataByteCount<>+170(SB)/1, $0x0c
+DATA dataByteCount<>+171(SB)/1, $0x0d
+DATA dataByteCount<>+172(SB)/1, $0x0b
+DATA dataByteCount<>+173(SB)/1, $0x0c
+DATA dataByteCount<>+174(SB)/1, $0x0d
+DATA dataByteCount<>+175(SB)/1, $0x0e
+DATA dataByteCount<>+176(SB)/1, $0x09
+DATA dataByteCount<>+177(SB)/1, $0x0a
+DATA dataByteCount<>+178(SB)/1, $0x0b
+DATA dataByteCount<>+179(SB)/1, $0x0c
+DATA dataByteCount<>+180(SB)/1, $0x0a
+DATA dataByteCount<>+181(SB)/1, $0x0b
+DATA dataByteCount<>+182(SB)/1, $0x0c
+DATA dataByteCount<>+183(SB)/1, $0x0d
+DATA dataByteCount<>+184(SB)/1, $0x0b
+DATA dataByteCount<>+185(SB)/1, $0x0c
+DATA dataByteCount<>+186(SB)/1, $0x0d
+DATA dataByteCount<>+187(SB)/1, $0x0e
+DATA dataByteCount<>+188(SB)/1, $0x0c
+DATA dataByteCount<>+189(SB)/1, $0x0d
+DATA dataByteCount<>+190(SB)/1, $0x0e
+DATA dataByteCount<>+191(SB)/1, $0x0f
+DATA dataByteCount<>+192(SB)/1, $0x07
+DATA dataByteCount<>+193(SB)/1, $0x08
+DATA dataByteCount<>+194(SB)/1, $0x09
+DATA dataByteCount<>+195(SB)/1, $0x0a
+DATA dataByteCount<>+196(SB)/1, $0x08
+DATA dataByteCount<>+197(SB)/1, $0x09
+DATA dataByteCount<>+198(SB)/1, $0x0a
+DATA dataByteCount<>+199(SB)/1, $0x0b
+DATA dataByteCount<>+200(SB)/1, $0x09
+DATA dataByteCount<>+201(SB)/1, $0x0a
+DATA dataByteCount<>+202(SB)/1, $0x0b
+DATA dataByteCount<>+203(SB)/1, $0x0c
+DATA dataByteCount<>+204(SB)/1, $0x0a
+DATA dataByteCount<>+205(SB)/1, $0x0b
+DATA dataByteCount<>+206(SB)/1, $0x0c
+DATA dataByteCount<>+207(SB)/1, $0x0d
+DATA dataByteCount<>+208(SB)/1, $0x08
+DATA dataByteCount<>+209(SB)/1, $0x09
+DATA dataByteCount<>+210(SB)/1, $0x0a
+DATA dataByteCount<>+211(SB)/1, $0x0b
+DATA dataByteCount<>+212(SB)/1, $0x09
+DATA dataByteCount<>+213(SB)/1, $0x0a
+DATA dataByteCount<>+214(SB)/1, $0x0b
+DATA dataByteCount<>+215(SB)/1, $0x0c
+DATA dataByteCount<>+216(SB)/1, $0x0a
+DATA dataByteCount<>+217(SB)/1, $0x0b
+DATA dataByteCount<>+218(SB)/1, $0x0c
+DATA dataByteCount<>+219(SB)/1, $0x0d
+DATA dataByteCount<>+220(SB)/1, $0x0b
+DATA dataByteCount<>+221(SB)/1, $0x0c
+DATA dataByteCount<>+222(SB)/1, $0x0d
+DATA dataByteCount<>+223(SB)/1, $0x0e
+DATA dataByteCount<>+224(SB)/1, $0x09
+DATA dataByteCount<>+225(SB)/1, $0x0a
+DATA dataByteCount<>+226(SB)/1, $0x0b
+DATA dataByteCount<>+227(SB)/1, $0x0c
+DATA dataByteCount<>+228(SB)/1, $0x0a
+DATA dataByteCount<>+229(SB)/1, $0x0b
+DATA dataByteCount<>+230(SB)/1, $0x0c
+DATA dataByteCount<>+231(SB)/1, $0x0d
+DATA dataByteCount<>+232(SB)/1, $0x0b
+DATA dataByteCount<>+233(SB)/1, $0x0c
+DATA dataByteCount<>+234(SB)/1, $0x0d
+DATA dataByteCount<>+235(SB)/1, $0x0e
+DATA dataByteCount<>+236(SB)/1, $0x0c
+DATA dataByteCount<>+237(SB)/1, $0x0d
+DATA dataByteCount<>+238(SB)/1, $0x0e
+DATA dataByteCount<>+239(SB)/1, $0x0f
+DATA dataByteCount<>+240(SB)/1, $0x0a
+DATA dataByteCount<>+241(SB)/1, $0x0b
+DATA dataByteCount<>+242(SB)/1, $0x0c
+DATA dataByteCount<>+243(SB)/1, $0x0d
+DATA dataByteCount<>+244(SB)/1, $0x0b
+DATA dataByteCount<>+245(SB)/1, $0x0c
+DATA dataByteCount<>+246(SB)/1, $0x0d
+DATA dataByteCount<>+247(SB)/1, $0x0e
+DATA dataByteCount<>+248(SB)/1, $0x0c
+DATA dataByteCount<>+249(SB)/1, $0x0d
+DATA dataByteCount<>+250(SB)/1, $0x0e
+DATA dataByteCount<>+251(SB)/1, $0x0f
+DATA dataByteCount<>+252(SB)/1, $0x0d
+DATA dataByteCount<>+253(SB)/1, $0x0e
+DATA dataByteCount<>+254(SB)/1, $0x0f
+DATA dataByteCount<>+255(SB)/1, $0x10
+GLOBL dataByteCount<>(SB), RODATA|NOPTR, $256
+
+DATA encodeByteMask<>+0(SB)/8, $0xffffffff0c080400
+DATA encodeByteMask<>+8(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+16(SB)/8, $0xffffff0c08040100
+DATA encodeByteMask<>+24(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+32(SB)/8, $0xffff0c0804020100
+DATA encodeByteMask<>+40(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+48(SB)/8, $0xff0c080403020100
+DATA encodeByteMask<>+56(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+64(SB)/8, $0xffffff0c08050400
+DATA encodeByteMask<>+72(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+80(SB)/8, $0xffff0c0805040100
+DATA encodeByteMask<>+88(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+96(SB)/8, $0xff0c080504020100
+DATA encodeByteMask<>+104(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+112(SB)/8, $0x0c08050403020100
+DATA encodeByteMask<>+120(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+128(SB)/8, $0xffff0c0806050400
+DATA encodeByteMask<>+136(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+144(SB)/8, $0xff0c080605040100
+DATA encodeByteMask<>+152(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+160(SB)/8, $0x0c08060504020100
+DATA encodeByteMask<>+168(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+176(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+184(SB)/8, $0xffffffffffffff0c
+DATA encodeByteMask<>+192(SB)/8, $0xff0c080706050400
+DATA encodeByteMask<>+200(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+208(SB)/8, $0x0c08070605040100
+DATA encodeByteMask<>+216(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+224(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+232(SB)/8, $0xffffffffffffff0c
+DATA encodeByteMask<>+240(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+248(SB)/8, $0xffffffffffff0c08
+DATA encodeByteMask<>+256(SB)/8, $0xffffff0c09080400
+DATA encodeByteMask<>+264(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+272(SB)/8, $0xffff0c0908040100
+DATA encodeByteMask<>+280(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+288(SB)/8, $0xff0c090804020100
+DATA encodeByteMask<>+296(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+304(SB)/8, $0x0c09080403020100
+DATA encodeByteMask<>+312(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+320(SB)/8, $0xffff0c0908050400
+DATA encodeByteMask<>+328(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+336(SB)/8, $0xff0c090805040100
+DATA encodeByteMask<>+344(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+352(SB)/8, $0x0c09080504020100
+DATA encodeByteMask<>+360(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+368(SB)/8, $0x0908050403020100
+DATA encodeByteMask<>+376(SB)/8, $0xffffffffffffff0c
+DATA encodeByteMask<>+384(SB)/8, $0xff0c090806050400
+DATA encodeByteMask<>+392(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+400(SB)/8, $0x0c09080605040100
+DATA encodeByteMask<>+408(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+416(SB)/8, $0x0908060504020100
+DATA encodeByteMask<>+424(SB)/8, $0xffffffffffffff0c
+DATA encodeByteMask<>+432(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+440(SB)/8, $0xffffffffffff0c09
+DATA encodeByteMask<>+448(SB)/8, $0x0c09080706050400
+DATA encodeByteMask<>+456(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+464(SB)/8, $0x0908070605040100
+DATA encodeByteMask<>+472(SB)/8, $0xffffffffffffff0c
+DATA encodeByteMask<>+480(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+488(SB)/8, $0xffffffffffff0c09
+DATA encodeByteMask<>+496(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+504(SB)/8, $0xffffffffff0c0908
+DATA encodeByteMask<>+512(SB)/8, $0xffff0c0a09080400
+DATA encodeByteMask<>+520(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+528(SB)/8, $0xff0c0a0908040100
+DATA encodeByteMask<>+536(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+544(SB)/8, $0x0c0a090804020100
+DATA encodeByteMask<>+552(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+560(SB)/8, $0x0a09080403020100
+DATA encodeByteMask<>+568(SB)/8, $0xffffffffffffff0c
+DATA encodeByteMask<>+576(SB)/8, $0xff0c0a0908050400
+DATA encodeByteMask<>+584(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+592(SB)/8, $0x0c0a090805040100
+DATA encodeByteMask<>+600(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+608(SB)/8, $0x0a09080504020100
+DATA encodeByteMask<>+616(SB)/8, $0xffffffffffffff0c
+DATA encodeByteMask<>+624(SB)/8, $0x0908050403020100
+DATA encodeByteMask<>+632(SB)/8, $0xffffffffffff0c0a
+DATA encodeByteMask<>+640(SB)/8, $0x0c0a090806050400
+DATA encodeByteMask<>+648(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+656(SB)/8, $0x0a09080605040100
+DATA encodeByteMask<>+664(SB)/8, $0xffffffffffffff0c
+DATA encodeByteMask<>+672(SB)/8, $0x0908060504020100
+DATA encodeByteMask<>+680(SB)/8, $0xffffffffffff0c0a
+DATA encodeByteMask<>+688(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+696(SB)/8, $0xffffffffff0c0a09
+DATA encodeByteMask<>+704(SB)/8, $0x0a09080706050400
+DATA encodeByteMask<>+712(SB)/8, $0xffffffffffffff0c
+DATA encodeByteMask<>+720(SB)/8, $0x0908070605040100
+DATA encodeByteMask<>+728(SB)/8, $0xffffffffffff0c0a
+DATA encodeByteMask<>+736(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+744(SB)/8, $0xffffffffff0c0a09
+DATA encodeByteMask<>+752(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+760(SB)/8, $0xffffffff0c0a0908
+DATA encodeByteMask<>+768(SB)/8, $0xff0c0b0a09080400
+DATA encodeByteMask<>+776(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+784(SB)/8, $0x0c0b0a0908040100
+DATA encodeByteMask<>+792(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+800(SB)/8, $0x0b0a090804020100
+DATA encodeByteMask<>+808(SB)/8, $0xffffffffffffff0c
+DATA encodeByteMask<>+816(SB)/8, $0x0a09080403020100
+DATA encodeByteMask<>+824(SB)/8, $0xffffffffffff0c0b
+DATA encodeByteMask<>+832(SB)/8, $0x0c0b0a0908050400
+DATA encodeByteMask<>+840(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+848(SB)/8, $0x0b0a090805040100
+DATA encodeByteMask<>+856(SB)/8, $0xffffffffffffff0c
+DATA encodeByteMask<>+864(SB)/8, $0x0a09080504020100
+DATA encodeByteMask<>+872(SB)/8, $0xffffffffffff0c0b
+DATA encodeByteMask<>+880(SB)/8, $0x0908050403020100
+DATA encodeByteMask<>+888(SB)/8, $0xffffffffff0c0b0a
+DATA encodeByteMask<>+896(SB)/8, $0x0b0a090806050400
+DATA encodeByteMask<>+904(SB)/8, $0xffffffffffffff0c
+DATA encodeByteMask<>+912(SB)/8, $0x0a09080605040100
+DATA encodeByteMask<>+920(SB)/8, $0xffffffffffff0c0b
+DATA encodeByteMask<>+928(SB)/8, $0x0908060504020100
+DATA encodeByteMask<>+936(SB)/8, $0xffffffffff0c0b0a
+DATA encodeByteMask<>+944(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+952(SB)/8, $0xffffffff0c0b0a09
+DATA encodeByteMask<>+960(SB)/8, $0x0a09080706050400
+DATA encodeByteMask<>+968(SB)/8, $0xffffffffffff0c0b
+DATA encodeByteMask<>+976(SB)/8, $0x0908070605040100
+DATA encodeByteMask<>+984(SB)/8, $0xffffffffff0c0b0a
+DATA encodeByteMask<>+992(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+1000(SB)/8, $0xffffffff0c0b0a09
+DATA encodeByteMask<>+1008(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+1016(SB)/8, $0xffffff0c0b0a0908
+DATA encodeByteMask<>+1024(SB)/8, $0xffffff0d0c080400
+DATA encodeByteMask<>+1032(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1040(SB)/8, $0xffff0d0c08040100
+DATA encodeByteMask<>+1048(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1056(SB)/8, $0xff0d0c0804020100
+DATA encodeByteMask<>+1064(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1072(SB)/8, $0x0d0c080403020100
+DATA encodeByteMask<>+1080(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1088(SB)/8, $0xffff0d0c08050400
+DATA encodeByteMask<>+1096(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1104(SB)/8, $0xff0d0c0805040100
+DATA encodeByteMask<>+1112(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1120(SB)/8, $0x0d0c080504020100
+DATA encodeByteMask<>+1128(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1136(SB)/8, $0x0c08050403020100
+DATA encodeByteMask<>+1144(SB)/8, $0xffffffffffffff0d
+DATA encodeByteMask<>+1152(SB)/8, $0xff0d0c0806050400
+DATA encodeByteMask<>+1160(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1168(SB)/8, $0x0d0c080605040100
+DATA encodeByteMask<>+1176(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1184(SB)/8, $0x0c08060504020100
+DATA encodeByteMask<>+1192(SB)/8, $0xffffffffffffff0d
+DATA encodeByteMask<>+1200(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+1208(SB)/8, $0xffffffffffff0d0c
+DATA encodeByteMask<>+1216(SB)/8, $0x0d0c080706050400
+DATA encodeByteMask<>+1224(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1232(SB)/8, $0x0c08070605040100
+DATA encodeByteMask<>+1240(SB)/8, $0xffffffffffffff0d
+DATA encodeByteMask<>+1248(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+1256(SB)/8, $0xffffffffffff0d0c
+DATA encodeByteMask<>+1264(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+1272(SB)/8, $0xffffffffff0d0c08
+DATA encodeByteMask<>+1280(SB)/8, $0xffff0d0c09080400
+DATA encodeByteMask<>+1288(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1296(SB)/8, $0xff0d0c0908040100
+DATA encodeByteMask<>+1304(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1312(SB)/8, $0x0d0c090804020100
+DATA encodeByteMask<>+1320(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1328(SB)/8, $0x0c09080403020100
+DATA encodeByteMask<>+1336(SB)/8, $0xffffffffffffff0d
+DATA encodeByteMask<>+1344(SB)/8, $0xff0d0c0908050400
+DATA encodeByteMask<>+1352(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1360(SB)/8, $0x0d0c090805040100
+DATA encodeByteMask<>+1368(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1376(SB)/8, $0x0c09080504020100
+DATA encodeByteMask<>+1384(SB)/8, $0xffffffffffffff0d
+DATA encodeByteMask<>+1392(SB)/8, $0x0908050403020100
+DATA encodeByteMask<>+1400(SB)/8, $0xffffffffffff0d0c
+DATA encodeByteMask<>+1408(SB)/8, $0x0d0c090806050400
+DATA encodeByteMask<>+1416(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1424(SB)/8, $0x0c09080605040100
+DATA encodeByteMask<>+1432(SB)/8, $0xffffffffffffff0d
+DATA encodeByteMask<>+1440(SB)/8, $0x0908060504020100
+DATA encodeByteMask<>+1448(SB)/8, $0xffffffffffff0d0c
+DATA encodeByteMask<>+1456(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+1464(SB)/8, $0xffffffffff0d0c09
+DATA encodeByteMask<>+1472(SB)/8, $0x0c09080706050400
+DATA encodeByteMask<>+1480(SB)/8, $0xffffffffffffff0d
+DATA encodeByteMask<>+1488(SB)/8, $0x0908070605040100
+DATA encodeByteMask<>+1496(SB)/8, $0xffffffffffff0d0c
+DATA encodeByteMask<>+1504(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+1512(SB)/8, $0xffffffffff0d0c09
+DATA encodeByteMask<>+1520(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+1528(SB)/8, $0xffffffff0d0c0908
+DATA encodeByteMask<>+1536(SB)/8, $0xff0d0c0a09080400
+DATA encodeByteMask<>+1544(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1552(SB)/8, $0x0d0c0a0908040100
+DATA encodeByteMask<>+1560(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1568(SB)/8, $0x0c0a090804020100
+DATA encodeByteMask<>+1576(SB)/8, $0xffffffffffffff0d
+DATA encodeByteMask<>+1584(SB)/8, $0x0a09080403020100
+DATA encodeByteMask<>+1592(SB)/8, $0xffffffffffff0d0c
+DATA encodeByteMask<>+1600(SB)/8, $0x0d0c0a0908050400
+DATA encodeByteMask<>+1608(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1616(SB)/8, $0x0c0a090805040100
+DATA encodeByteMask<>+1624(SB)/8, $0xffffffffffffff0d
+DATA encodeByteMask<>+1632(SB)/8, $0x0a09080504020100
+DATA encodeByteMask<>+1640(SB)/8, $0xffffffffffff0d0c
+DATA encodeByteMask<>+1648(SB)/8, $0x0908050403020100
+DATA encodeByteMask<>+1656(SB)/8, $0xffffffffff0d0c0a
+DATA encodeByteMask<>+1664(SB)/8, $0x0c0a090806050400
+DATA encodeByteMask<>+1672(SB)/8, $0xffffffffffffff0d
+DATA encodeByteMask<>+1680(SB)/8, $0x0a09080605040100
+DATA encodeByteMask<>+1688(SB)/8, $0xffffffffffff0d0c
+DATA encodeByteMask<>+1696(SB)/8, $0x0908060504020100
+DATA encodeByteMask<>+1704(SB)/8, $0xffffffffff0d0c0a
+DATA encodeByteMask<>+1712(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+1720(SB)/8, $0xffffffff0d0c0a09
+DATA encodeByteMask<>+1728(SB)/8, $0x0a09080706050400
+DATA encodeByteMask<>+1736(SB)/8, $0xffffffffffff0d0c
+DATA encodeByteMask<>+1744(SB)/8, $0x0908070605040100
+DATA encodeByteMask<>+1752(SB)/8, $0xffffffffff0d0c0a
+DATA encodeByteMask<>+1760(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+1768(SB)/8, $0xffffffff0d0c0a09
+DATA encodeByteMask<>+1776(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+1784(SB)/8, $0xffffff0d0c0a0908
+DATA encodeByteMask<>+1792(SB)/8, $0x0d0c0b0a09080400
+DATA encodeByteMask<>+1800(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+1808(SB)/8, $0x0c0b0a0908040100
+DATA encodeByteMask<>+1816(SB)/8, $0xffffffffffffff0d
+DATA encodeByteMask<>+1824(SB)/8, $0x0b0a090804020100
+DATA encodeByteMask<>+1832(SB)/8, $0xffffffffffff0d0c
+DATA encodeByteMask<>+1840(SB)/8, $0x0a09080403020100
+DATA encodeByteMask<>+1848(SB)/8, $0xffffffffff0d0c0b
+DATA encodeByteMask<>+1856(SB)/8, $0x0c0b0a0908050400
+DATA encodeByteMask<>+1864(SB)/8, $0xffffffffffffff0d
+DATA encodeByteMask<>+1872(SB)/8, $0x0b0a090805040100
+DATA encodeByteMask<>+1880(SB)/8, $0xffffffffffff0d0c
+DATA encodeByteMask<>+1888(SB)/8, $0x0a09080504020100
+DATA encodeByteMask<>+1896(SB)/8, $0xffffffffff0d0c0b
+DATA encodeByteMask<>+1904(SB)/8, $0x0908050403020100
+DATA encodeByteMask<>+1912(SB)/8, $0xffffffff0d0c0b0a
+DATA encodeByteMask<>+1920(SB)/8, $0x0b0a090806050400
+DATA encodeByteMask<>+1928(SB)/8, $0xffffffffffff0d0c
+DATA encodeByteMask<>+1936(SB)/8, $0x0a09080605040100
+DATA encodeByteMask<>+1944(SB)/8, $0xffffffffff0d0c0b
+DATA encodeByteMask<>+1952(SB)/8, $0x0908060504020100
+DATA encodeByteMask<>+1960(SB)/8, $0xffffffff0d0c0b0a
+DATA encodeByteMask<>+1968(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+1976(SB)/8, $0xffffff0d0c0b0a09
+DATA encodeByteMask<>+1984(SB)/8, $0x0a09080706050400
+DATA encodeByteMask<>+1992(SB)/8, $0xffffffffff0d0c0b
+DATA encodeByteMask<>+2000(SB)/8, $0x0908070605040100
+DATA encodeByteMask<>+2008(SB)/8, $0xffffffff0d0c0b0a
+DATA encodeByteMask<>+2016(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+2024(SB)/8, $0xffffff0d0c0b0a09
+DATA encodeByteMask<>+2032(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+2040(SB)/8, $0xffff0d0c0b0a0908
+DATA encodeByteMask<>+2048(SB)/8, $0xffff0e0d0c080400
+DATA encodeByteMask<>+2056(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+2064(SB)/8, $0xff0e0d0c08040100
+DATA encodeByteMask<>+2072(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+2080(SB)/8, $0x0e0d0c0804020100
+DATA encodeByteMask<>+2088(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+2096(SB)/8, $0x0d0c080403020100
+DATA encodeByteMask<>+2104(SB)/8, $0xffffffffffffff0e
+DATA encodeByteMask<>+2112(SB)/8, $0xff0e0d0c08050400
+DATA encodeByteMask<>+2120(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+2128(SB)/8, $0x0e0d0c0805040100
+DATA encodeByteMask<>+2136(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+2144(SB)/8, $0x0d0c080504020100
+DATA encodeByteMask<>+2152(SB)/8, $0xffffffffffffff0e
+DATA encodeByteMask<>+2160(SB)/8, $0x0c08050403020100
+DATA encodeByteMask<>+2168(SB)/8, $0xffffffffffff0e0d
+DATA encodeByteMask<>+2176(SB)/8, $0x0e0d0c0806050400
+DATA encodeByteMask<>+2184(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+2192(SB)/8, $0x0d0c080605040100
+DATA encodeByteMask<>+2200(SB)/8, $0xffffffffffffff0e
+DATA encodeByteMask<>+2208(SB)/8, $0x0c08060504020100
+DATA encodeByteMask<>+2216(SB)/8, $0xffffffffffff0e0d
+DATA encodeByteMask<>+2224(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+2232(SB)/8, $0xffffffffff0e0d0c
+DATA encodeByteMask<>+2240(SB)/8, $0x0d0c080706050400
+DATA encodeByteMask<>+2248(SB)/8, $0xffffffffffffff0e
+DATA encodeByteMask<>+2256(SB)/8, $0x0c08070605040100
+DATA encodeByteMask<>+2264(SB)/8, $0xffffffffffff0e0d
+DATA encodeByteMask<>+2272(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+2280(SB)/8, $0xffffffffff0e0d0c
+DATA encodeByteMask<>+2288(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+2296(SB)/8, $0xffffffff0e0d0c08
+DATA encodeByteMask<>+2304(SB)/8, $0xff0e0d0c09080400
+DATA encodeByteMask<>+2312(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+2320(SB)/8, $0x0e0d0c0908040100
+DATA encodeByteMask<>+2328(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+2336(SB)/8, $0x0d0c090804020100
+DATA encodeByteMask<>+2344(SB)/8, $0xffffffffffffff0e
+DATA encodeByteMask<>+2352(SB)/8, $0x0c09080403020100
+DATA encodeByteMask<>+2360(SB)/8, $0xffffffffffff0e0d
+DATA encodeByteMask<>+2368(SB)/8, $0x0e0d0c0908050400
+DATA encodeByteMask<>+2376(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+2384(SB)/8, $0x0d0c090805040100
+DATA encodeByteMask<>+2392(SB)/8, $0xffffffffffffff0e
+DATA encodeByteMask<>+2400(SB)/8, $0x0c09080504020100
+DATA encodeByteMask<>+2408(SB)/8, $0xffffffffffff0e0d
+DATA encodeByteMask<>+2416(SB)/8, $0x0908050403020100
+DATA encodeByteMask<>+2424(SB)/8, $0xffffffffff0e0d0c
+DATA encodeByteMask<>+2432(SB)/8, $0x0d0c090806050400
+DATA encodeByteMask<>+2440(SB)/8, $0xffffffffffffff0e
+DATA encodeByteMask<>+2448(SB)/8, $0x0c09080605040100
+DATA encodeByteMask<>+2456(SB)/8, $0xffffffffffff0e0d
+DATA encodeByteMask<>+2464(SB)/8, $0x0908060504020100
+DATA encodeByteMask<>+2472(SB)/8, $0xffffffffff0e0d0c
+DATA encodeByteMask<>+2480(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+2488(SB)/8, $0xffffffff0e0d0c09
+DATA encodeByteMask<>+2496(SB)/8, $0x0c09080706050400
+DATA encodeByteMask<>+2504(SB)/8, $0xffffffffffff0e0d
+DATA encodeByteMask<>+2512(SB)/8, $0x0908070605040100
+DATA encodeByteMask<>+2520(SB)/8, $0xffffffffff0e0d0c
+DATA encodeByteMask<>+2528(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+2536(SB)/8, $0xffffffff0e0d0c09
+DATA encodeByteMask<>+2544(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+2552(SB)/8, $0xffffff0e0d0c0908
+DATA encodeByteMask<>+2560(SB)/8, $0x0e0d0c0a09080400
+DATA encodeByteMask<>+2568(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+2576(SB)/8, $0x0d0c0a0908040100
+DATA encodeByteMask<>+2584(SB)/8, $0xffffffffffffff0e
+DATA encodeByteMask<>+2592(SB)/8, $0x0c0a090804020100
+DATA encodeByteMask<>+2600(SB)/8, $0xffffffffffff0e0d
+DATA encodeByteMask<>+2608(SB)/8, $0x0a09080403020100
+DATA encodeByteMask<>+2616(SB)/8, $0xffffffffff0e0d0c
+DATA encodeByteMask<>+2624(SB)/8, $0x0d0c0a0908050400
+DATA encodeByteMask<>+2632(SB)/8, $0xffffffffffffff0e
+DATA encodeByteMask<>+2640(SB)/8, $0x0c0a090805040100
+DATA encodeByteMask<>+2648(SB)/8, $0xffffffffffff0e0d
+DATA encodeByteMask<>+2656(SB)/8, $0x0a09080504020100
+DATA encodeByteMask<>+2664(SB)/8, $0xffffffffff0e0d0c
+DATA encodeByteMask<>+2672(SB)/8, $0x0908050403020100
+DATA encodeByteMask<>+2680(SB)/8, $0xffffffff0e0d0c0a
+DATA encodeByteMask<>+2688(SB)/8, $0x0c0a090806050400
+DATA encodeByteMask<>+2696(SB)/8, $0xffffffffffff0e0d
+DATA encodeByteMask<>+2704(SB)/8, $0x0a09080605040100
+DATA encodeByteMask<>+2712(SB)/8, $0xffffffffff0e0d0c
+DATA encodeByteMask<>+2720(SB)/8, $0x0908060504020100
+DATA encodeByteMask<>+2728(SB)/8, $0xffffffff0e0d0c0a
+DATA encodeByteMask<>+2736(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+2744(SB)/8, $0xffffff0e0d0c0a09
+DATA encodeByteMask<>+2752(SB)/8, $0x0a09080706050400
+DATA encodeByteMask<>+2760(SB)/8, $0xffffffffff0e0d0c
+DATA encodeByteMask<>+2768(SB)/8, $0x0908070605040100
+DATA encodeByteMask<>+2776(SB)/8, $0xffffffff0e0d0c0a
+DATA encodeByteMask<>+2784(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+2792(SB)/8, $0xffffff0e0d0c0a09
+DATA encodeByteMask<>+2800(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+2808(SB)/8, $0xffff0e0d0c0a0908
+DATA encodeByteMask<>+2816(SB)/8, $0x0d0c0b0a09080400
+DATA encodeByteMask<>+2824(SB)/8, $0xffffffffffffff0e
+DATA encodeByteMask<>+2832(SB)/8, $0x0c0b0a0908040100
+DATA encodeByteMask<>+2840(SB)/8, $0xffffffffffff0e0d
+DATA encodeByteMask<>+2848(SB)/8, $0x0b0a090804020100
+DATA encodeByteMask<>+2856(SB)/8, $0xffffffffff0e0d0c
+DATA encodeByteMask<>+2864(SB)/8, $0x0a09080403020100
+DATA encodeByteMask<>+2872(SB)/8, $0xffffffff0e0d0c0b
+DATA encodeByteMask<>+2880(SB)/8, $0x0c0b0a0908050400
+DATA encodeByteMask<>+2888(SB)/8, $0xffffffffffff0e0d
+DATA encodeByteMask<>+2896(SB)/8, $0x0b0a090805040100
+DATA encodeByteMask<>+2904(SB)/8, $0xffffffffff0e0d0c
+DATA encodeByteMask<>+2912(SB)/8, $0x0a09080504020100
+DATA encodeByteMask<>+2920(SB)/8, $0xffffffff0e0d0c0b
+DATA encodeByteMask<>+2928(SB)/8, $0x0908050403020100
+DATA encodeByteMask<>+2936(SB)/8, $0xffffff0e0d0c0b0a
+DATA encodeByteMask<>+2944(SB)/8, $0x0b0a090806050400
+DATA encodeByteMask<>+2952(SB)/8, $0xffffffffff0e0d0c
+DATA encodeByteMask<>+2960(SB)/8, $0x0a09080605040100
+DATA encodeByteMask<>+2968(SB)/8, $0xffffffff0e0d0c0b
+DATA encodeByteMask<>+2976(SB)/8, $0x0908060504020100
+DATA encodeByteMask<>+2984(SB)/8, $0xffffff0e0d0c0b0a
+DATA encodeByteMask<>+2992(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+3000(SB)/8, $0xffff0e0d0c0b0a09
+DATA encodeByteMask<>+3008(SB)/8, $0x0a09080706050400
+DATA encodeByteMask<>+3016(SB)/8, $0xffffffff0e0d0c0b
+DATA encodeByteMask<>+3024(SB)/8, $0x0908070605040100
+DATA encodeByteMask<>+3032(SB)/8, $0xffffff0e0d0c0b0a
+DATA encodeByteMask<>+3040(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+3048(SB)/8, $0xffff0e0d0c0b0a09
+DATA encodeByteMask<>+3056(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+3064(SB)/8, $0xff0e0d0c0b0a0908
+DATA encodeByteMask<>+3072(SB)/8, $0xff0f0e0d0c080400
+DATA encodeByteMask<>+3080(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+3088(SB)/8, $0x0f0e0d0c08040100
+DATA encodeByteMask<>+3096(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+3104(SB)/8, $0x0e0d0c0804020100
+DATA encodeByteMask<>+3112(SB)/8, $0xffffffffffffff0f
+DATA encodeByteMask<>+3120(SB)/8, $0x0d0c080403020100
+DATA encodeByteMask<>+3128(SB)/8, $0xffffffffffff0f0e
+DATA encodeByteMask<>+3136(SB)/8, $0x0f0e0d0c08050400
+DATA encodeByteMask<>+3144(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+3152(SB)/8, $0x0e0d0c0805040100
+DATA encodeByteMask<>+3160(SB)/8, $0xffffffffffffff0f
+DATA encodeByteMask<>+3168(SB)/8, $0x0d0c080504020100
+DATA encodeByteMask<>+3176(SB)/8, $0xffffffffffff0f0e
+DATA encodeByteMask<>+3184(SB)/8, $0x0c08050403020100
+DATA encodeByteMask<>+3192(SB)/8, $0xffffffffff0f0e0d
+DATA encodeByteMask<>+3200(SB)/8, $0x0e0d0c0806050400
+DATA encodeByteMask<>+3208(SB)/8, $0xffffffffffffff0f
+DATA encodeByteMask<>+3216(SB)/8, $0x0d0c080605040100
+DATA encodeByteMask<>+3224(SB)/8, $0xffffffffffff0f0e
+DATA encodeByteMask<>+3232(SB)/8, $0x0c08060504020100
+DATA encodeByteMask<>+3240(SB)/8, $0xffffffffff0f0e0d
+DATA encodeByteMask<>+3248(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+3256(SB)/8, $0xffffffff0f0e0d0c
+DATA encodeByteMask<>+3264(SB)/8, $0x0d0c080706050400
+DATA encodeByteMask<>+3272(SB)/8, $0xffffffffffff0f0e
+DATA encodeByteMask<>+3280(SB)/8, $0x0c08070605040100
+DATA encodeByteMask<>+3288(SB)/8, $0xffffffffff0f0e0d
+DATA encodeByteMask<>+3296(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+3304(SB)/8, $0xffffffff0f0e0d0c
+DATA encodeByteMask<>+3312(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+3320(SB)/8, $0xffffff0f0e0d0c08
+DATA encodeByteMask<>+3328(SB)/8, $0x0f0e0d0c09080400
+DATA encodeByteMask<>+3336(SB)/8, $0xffffffffffffffff
+DATA encodeByteMask<>+3344(SB)/8, $0x0e0d0c0908040100
+DATA encodeByteMask<>+3352(SB)/8, $0xffffffffffffff0f
+DATA encodeByteMask<>+3360(SB)/8, $0x0d0c090804020100
+DATA encodeByteMask<>+3368(SB)/8, $0xffffffffffff0f0e
+DATA encodeByteMask<>+3376(SB)/8, $0x0c09080403020100
+DATA encodeByteMask<>+3384(SB)/8, $0xffffffffff0f0e0d
+DATA encodeByteMask<>+3392(SB)/8, $0x0e0d0c0908050400
+DATA encodeByteMask<>+3400(SB)/8, $0xffffffffffffff0f
+DATA encodeByteMask<>+3408(SB)/8, $0x0d0c090805040100
+DATA encodeByteMask<>+3416(SB)/8, $0xffffffffffff0f0e
+DATA encodeByteMask<>+3424(SB)/8, $0x0c09080504020100
+DATA encodeByteMask<>+3432(SB)/8, $0xffffffffff0f0e0d
+DATA encodeByteMask<>+3440(SB)/8, $0x0908050403020100
+DATA encodeByteMask<>+3448(SB)/8, $0xffffffff0f0e0d0c
+DATA encodeByteMask<>+3456(SB)/8, $0x0d0c090806050400
+DATA encodeByteMask<>+3464(SB)/8, $0xffffffffffff0f0e
+DATA encodeByteMask<>+3472(SB)/8, $0x0c09080605040100
+DATA encodeByteMask<>+3480(SB)/8, $0xffffffffff0f0e0d
+DATA encodeByteMask<>+3488(SB)/8, $0x0908060504020100
+DATA encodeByteMask<>+3496(SB)/8, $0xffffffff0f0e0d0c
+DATA encodeByteMask<>+3504(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+3512(SB)/8, $0xffffff0f0e0d0c09
+DATA encodeByteMask<>+3520(SB)/8, $0x0c09080706050400
+DATA encodeByteMask<>+3528(SB)/8, $0xffffffffff0f0e0d
+DATA encodeByteMask<>+3536(SB)/8, $0x0908070605040100
+DATA encodeByteMask<>+3544(SB)/8, $0xffffffff0f0e0d0c
+DATA encodeByteMask<>+3552(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+3560(SB)/8, $0xffffff0f0e0d0c09
+DATA encodeByteMask<>+3568(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+3576(SB)/8, $0xffff0f0e0d0c0908
+DATA encodeByteMask<>+3584(SB)/8, $0x0e0d0c0a09080400
+DATA encodeByteMask<>+3592(SB)/8, $0xffffffffffffff0f
+DATA encodeByteMask<>+3600(SB)/8, $0x0d0c0a0908040100
+DATA encodeByteMask<>+3608(SB)/8, $0xffffffffffff0f0e
+DATA encodeByteMask<>+3616(SB)/8, $0x0c0a090804020100
+DATA encodeByteMask<>+3624(SB)/8, $0xffffffffff0f0e0d
+DATA encodeByteMask<>+3632(SB)/8, $0x0a09080403020100
+DATA encodeByteMask<>+3640(SB)/8, $0xffffffff0f0e0d0c
+DATA encodeByteMask<>+3648(SB)/8, $0x0d0c0a0908050400
+DATA encodeByteMask<>+3656(SB)/8, $0xffffffffffff0f0e
+DATA encodeByteMask<>+3664(SB)/8, $0x0c0a090805040100
+DATA encodeByteMask<>+3672(SB)/8, $0xffffffffff0f0e0d
+DATA encodeByteMask<>+3680(SB)/8, $0x0a09080504020100
+DATA encodeByteMask<>+3688(SB)/8, $0xffffffff0f0e0d0c
+DATA encodeByteMask<>+3696(SB)/8, $0x0908050403020100
+DATA encodeByteMask<>+3704(SB)/8, $0xffffff0f0e0d0c0a
+DATA encodeByteMask<>+3712(SB)/8, $0x0c0a090806050400
+DATA encodeByteMask<>+3720(SB)/8, $0xffffffffff0f0e0d
+DATA encodeByteMask<>+3728(SB)/8, $0x0a09080605040100
+DATA encodeByteMask<>+3736(SB)/8, $0xffffffff0f0e0d0c
+DATA encodeByteMask<>+3744(SB)/8, $0x0908060504020100
+DATA encodeByteMask<>+3752(SB)/8, $0xffffff0f0e0d0c0a
+DATA encodeByteMask<>+3760(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+3768(SB)/8, $0xffff0f0e0d0c0a09
+DATA encodeByteMask<>+3776(SB)/8, $0x0a09080706050400
+DATA encodeByteMask<>+3784(SB)/8, $0xffffffff0f0e0d0c
+DATA encodeByteMask<>+3792(SB)/8, $0x0908070605040100
+DATA encodeByteMask<>+3800(SB)/8, $0xffffff0f0e0d0c0a
+DATA encodeByteMask<>+3808(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+3816(SB)/8, $0xffff0f0e0d0c0a09
+DATA encodeByteMask<>+3824(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+3832(SB)/8, $0xff0f0e0d0c0a0908
+DATA encodeByteMask<>+3840(SB)/8, $0x0d0c0b0a09080400
+DATA encodeByteMask<>+3848(SB)/8, $0xffffffffffff0f0e
+DATA encodeByteMask<>+3856(SB)/8, $0x0c0b0a0908040100
+DATA encodeByteMask<>+3864(SB)/8, $0xffffffffff0f0e0d
+DATA encodeByteMask<>+3872(SB)/8, $0x0b0a090804020100
+DATA encodeByteMask<>+3880(SB)/8, $0xffffffff0f0e0d0c
+DATA encodeByteMask<>+3888(SB)/8, $0x0a09080403020100
+DATA encodeByteMask<>+3896(SB)/8, $0xffffff0f0e0d0c0b
+DATA encodeByteMask<>+3904(SB)/8, $0x0c0b0a0908050400
+DATA encodeByteMask<>+3912(SB)/8, $0xffffffffff0f0e0d
+DATA encodeByteMask<>+3920(SB)/8, $0x0b0a090805040100
+DATA encodeByteMask<>+3928(SB)/8, $0xffffffff0f0e0d0c
+DATA encodeByteMask<>+3936(SB)/8, $0x0a09080504020100
+DATA encodeByteMask<>+3944(SB)/8, $0xffffff0f0e0d0c0b
+DATA encodeByteMask<>+3952(SB)/8, $0x0908050403020100
+DATA encodeByteMask<>+3960(SB)/8, $0xffff0f0e0d0c0b0a
+DATA encodeByteMask<>+3968(SB)/8, $0x0b0a090806050400
+DATA encodeByteMask<>+3976(SB)/8, $0xffffffff0f0e0d0c
+DATA encodeByteMask<>+3984(SB)/8, $0x0a09080605040100
+DATA encodeByteMask<>+3992(SB)/8, $0xffffff0f0e0d0c0b
+DATA encodeByteMask<>+4000(SB)/8, $0x0908060504020100
+DATA encodeByteMask<>+4008(SB)/8, $0xffff0f0e0d0c0b0a
+DATA encodeByteMask<>+4016(SB)/8, $0x0806050403020100
+DATA encodeByteMask<>+4024(SB)/8, $0xff0f0e0d0c0b0a09
+DATA encodeByteMask<>+4032(SB)/8, $0x0a09080706050400
+DATA encodeByteMask<>+4040(SB)/8, $0xffffff0f0e0d0c0b
+DATA encodeByteMask<>+4048(SB)/8, $0x0908070605040100
+DATA encodeByteMask<>+4056(SB)/8, $0xffff0f0e0d0c0b0a
+DATA encodeByteMask<>+4064(SB)/8, $0x0807060504020100
+DATA encodeByteMask<>+4072(SB)/8, $0xff0f0e0d0c0b0a09
+DATA encodeByteMask<>+4080(SB)/8, $0x0706050403020100
+DATA encodeByteMask<>+4088(SB)/8, $0x0f0e0d0c0b0a0908
+GLOBL encodeByteMask<>(SB), RODATA|NOPTR, $4096
+
+DATA byteMax<>+0(SB)/4, $0x000000ff
+DATA byteMax<>+4(SB)/4, $0x000000ff
+DATA byteMax<>+8(SB)/4, $0x000000ff
+DATA byteMax<>+12(SB)/4, $0x000000ff
+DATA byteMax<>+16(SB)/4, $0x0000ffff
+DATA byteMax<>+20(SB)/4, $0x0000ffff
+DATA byteMax<>+24(SB)/4, $0x0000ffff
+DATA byteMax<>+28(SB)/4, $0x0000ffff
+DATA byteMax<>+32(SB)/4, $0x00ffffff
+DATA byteMax<>+36(SB)/4, $0x00ffffff
+DATA byteMax<>+40(SB)/4, $0x00ffffff
+DATA byteMax<>+44(SB)/4, $0x00ffffff
+GLOBL byteMax<>(SB), RODATA|NOPTR, $48
+
+DATA packCodes<>+0(SB)/8, $0xffffffff0c080400
+DATA packCodes<>+8(SB)/8, $0xffffffffffffffff
+GLOBL packCodes<>(SB), RODATA|NOPTR, $16
+
+// func encodeUint32SSE41(encoded []byte, data []uint32) int
+// Requires: SSE2, SSE4.1, SSSE3
+TEXT ·encodeUint32SSE41(SB), NOSPLIT, $0-56
+	MOVQ encoded_base+0(FP), AX
+	MOVQ encoded_len+8(FP), CX
+
+	// Revert to scalar processing if we are within 16 bytes of the end.
+	SUBQ $0x10, CX
+	MOVQ data_base+24(FP), DX
+	MOVQ data_len+32(FP), BX
+
+	// Revert to scalar processing if we have less than 4 values to process.
+	MOVQ BX, SI
+	SUBQ $0x04, SI
+
+	// Initialize the control index.
+	XORQ DI, DI
+
+	// Initialize the data index. (len(data) + 3) >> 2
+	MOVQ BX, R8
+	ADDQ $0x03, R8
+	SHRQ $0x02, R8
+
+	// Initialize the input index.
+	XORQ R9, R9
+
+	// The byte count lookup table.
+	LEAQ dataByteCount<>+0(SB), R10
+
+	// The byte mask lookup table.
+	LEAQ encodeByteMask<>+0(SB), R11
+
+simd:
+	// Check if less than 16 encoded bytes remain and jump to scalar.
+	CMPQ R8, CX
+	JGT  scalarStart
+
+	// Check if less than 4 values remain and jump to scalar.
+	CMPQ R9, SI
+	JGT  scalarStart
+
+	// Load 4 values.
+	MOVOU (DX)(R9*4), X0
+
+	// Start with a byte length code of 3 in each lane.
+	PCMPEQL X1, X1
+	PSRLL   $0x1e, X1
+
+	// Decrement the code for each byte width the value fits in.
+	MOVOU   X0, X2
+	PMINUD  byteMax<>+0(SB), X2
+	PCMPEQL X0, X2
+	PADDL   X2, X1
+	MOVOU   X0, X2
+	PMINUD  byteMax<>+16(SB), X2
+	PCMPEQL X0, X2
+	PADDL   X2, X1
+	MOVOU   X0, X2
+	PMINUD  byteMax<>+32(SB), X2
+	PCMPEQL X0, X2
+	PADDL   X2, X1
+
+	// Gather the low byte of each code and pack into the control byte.
+	PSHUFB packCodes<>+0(SB), X1
+	MOVQ   X1, R12
+	IMUL3L $0x01041040, R12, R12
+	SHRL   $0x18, R12
+
+	// Store control byte.
+	MOVB R12, (AX)(DI*1)
+	INCQ DI
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R12*1), R13
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R12
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R12*1), X0
+
+	// Store 16 data bytes.
+	MOVOU X0, (AX)(R8*1)
+
+	// Increment the indices.
+	ADDQ $0x04, R9
+	ADDQ R13, R8
+	JMP  simd
+
+scalarStart:
+	XORL CX, CX
+
+scalar:
+	// Process a single value at a time.
+	CMPQ R9, BX
+	JE   finish
+	MOVL (DX)(R9*4), SI
+
+	// Shift control byte to make room for the next code.
+	SHRL $0x02, CX
+
+	// Switch on the number of bytes needed to hold the value.
+	CMPL SI, $0x000000ff
+	JBE  oneByte
+	CMPL SI, $0x0000ffff
+	JBE  twoByte
+	CMPL SI, $0x00ffffff
+	JBE  threeByte
+	MOVL SI, (AX)(R8*1)
+	ADDQ $0x04, R8
+	ORL  $0x000000c0, CX
+	JMP  nextValue
+
+threeByte:
+	MOVW SI, (AX)(R8*1)
+	SHRL $0x10, SI
+	MOVB SI, 2(AX)(R8*1)
+	ADDQ $0x03, R8
+	ORL  $0x00000080, CX
+	JMP  nextValue
+
+twoByte:
+	MOVW SI, (AX)(R8*1)
+	ADDQ $0x02, R8
+	ORL  $0x00000040, CX
+	JMP  nextValue
+
+oneByte:
+	MOVB SI, (AX)(R8*1)
+	INCQ R8
+
+nextValue:
+	INCQ R9
+
+	// Store the control byte if the block of 4 values is complete.
+	TESTQ $0x00000003, R9
+	JNE   scalar
+	MOVB  CL, (AX)(DI*1)
+	INCQ  DI
+	XORL  CX, CX
+	JMP   scalar
+
+finish:
+	// Check if the last block was complete or the control byte needs to be shifted and written.
+	TESTQ $0x00000003, R9
+	JE    done
+
+pad:
+	SHRL  $0x02, CX
+	INCQ  R9
+	TESTQ $0x00000003, R9
+	JNE   pad
+	MOVB  CL, (AX)(DI*1)
+
+done:
+	MOVQ R8, ret+48(FP)
+	RET
+
+// func encodeDeltaUint32SSE41(encoded []byte, data []uint32, previous uint32) int
+// Requires: SSE2, SSE4.1, SSSE3
+TEXT ·encodeDeltaUint32SSE41(SB), NOSPLIT, $0-64
+	MOVQ encoded_base+0(FP), AX
+	MOVQ encoded_len+8(FP), CX
+
+	// Revert to scalar processing if we are within 16 bytes of the end.
+	SUBQ $0x10, CX
+	MOVQ data_base+24(FP), DX
+	MOVQ data_len+32(FP), BX
+
+	// Revert to scalar processing if we have less than 4 values to process.
+	MOVQ BX, SI
+	SUBQ $0x04, SI
+
+	// Initialize the control index.
+	XORQ DI, DI
+
+	// Initialize the data index. (len(data) + 3) >> 2
+	MOVQ BX, R8
+	ADDQ $0x03, R8
+	SHRQ $0x02, R8
+
+	// Initialize the input index.
+	XORQ R9, R9
+
+	// The byte count lookup table.
+	LEAQ dataByteCount<>+0(SB), R10
+
+	// The byte mask lookup table.
+	LEAQ   encodeByteMask<>+0(SB), R11
+	MOVL   previous+48(FP), R12
+	MOVD   R12, X0
+	PSHUFD $0x00, X0, X0
+
+simd:
+	// Check if less than 16 encoded bytes remain and jump to scalar.
+	CMPQ R8, CX
+	JGT  scalarStart
+
+	// Check if less than 4 values remain and jump to scalar.
+	CMPQ R9, SI
+	JGT  scalarStart
+
+	// Load 4 values.
+	MOVOU (DX)(R9*4), X1
+
+	// Calculate deltas.
+	MOVOU X1, X2
+
+	// (previous_3, data_0, data_1, data_2)
+	PALIGNR $0x0c, X0, X2
+
+	// Save the current values as previous for the next block.
+	MOVOU X1, X0
+
+	// (data_0 - previous_3, data_1 - data_0, data_2 - data_1, data_3 - data_2)
+	PSUBL X2, X1
+
+	// Start with a byte length code of 3 in each lane.
+	PCMPEQL X2, X2
+	PSRLL   $0x1e, X2
+
+	// Decrement the code for each byte width the value fits in.
+	MOVOU   X1, X3
+	PMINUD  byteMax<>+0(SB), X3
+	PCMPEQL X1, X3
+	PADDL   X3, X2
+	MOVOU   X1, X3
+	PMINUD  byteMax<>+16(SB), X3
+	PCMPEQL X1, X3
+	PADDL   X3, X2
+	MOVOU   X1, X3
+	PMINUD  byteMax<>+32(SB), X3
+	PCMPEQL X1, X3
+	PADDL   X3, X2
+
+	// Gather the low byte of each code and pack into the control byte.
+	PSHUFB packCodes<>+0(SB), X2
+	MOVQ   X2, R12
+	IMUL3L $0x01041040, R12, R12
+	SHRL   $0x18, R12
+
+	// Store control byte.
+	MOVB R12, (AX)(DI*1)
+	INCQ DI
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R12*1), R13
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R12
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R12*1), X1
+
+	// Store 16 data bytes.
+	MOVOU X1, (AX)(R8*1)
+
+	// Increment the indices.
+	ADDQ $0x04, R9
+	ADDQ R13, R8
+	JMP  simd
+
+scalarStart:
+	// Extract the last value of the SIMD loop as previous.
+	PSHUFD $0xff, X0, X0
+	MOVD   X0, R12
+	XORL   CX, CX
+
+scalar:
+	// Process a single value at a time.
+	CMPQ R9, BX
+	JE   finish
+	MOVL (DX)(R9*4), SI
+
+	// Calculate delta.
+	MOVL SI, R10
+	SUBL R12, SI
+	MOVL R10, R12
+
+	// Shift control byte to make room for the next code.
+	SHRL $0x02, CX
+
+	// Switch on the number of bytes needed to hold the value.
+	CMPL SI, $0x000000ff
+	JBE  oneByte
+	CMPL SI, $0x0000ffff
+	JBE  twoByte
+	CMPL SI, $0x00ffffff
+	JBE  threeByte
+	MOVL SI, (AX)(R8*1)
+	ADDQ $0x04, R8
+	ORL  $0x000000c0, CX
+	JMP  nextValue
+
+threeByte:
+	MOVW SI, (AX)(R8*1)
+	SHRL $0x10, SI
+	MOVB SI, 2(AX)(R8*1)
+	ADDQ $0x03, R8
+	ORL  $0x00000080, CX
+	JMP  nextValue
+
+twoByte:
+	MOVW SI, (AX)(R8*1)
+	ADDQ $0x02, R8
+	ORL  $0x00000040, CX
+	JMP  nextValue
+
+oneByte:
+	MOVB SI, (AX)(R8*1)
+	INCQ R8
+
+nextValue:
+	INCQ R9
+
+	// Store the control byte if the block of 4 values is complete.
+	TESTQ $0x00000003, R9
+	JNE   scalar
+	MOVB  CL, (AX)(DI*1)
+	INCQ  DI
+	XORL  CX, CX
+	JMP   scalar
+
+finish:
+	// Check if the last block was complete or the control byte needs to be shifted and written.
+	TESTQ $0x00000003, R9
+	JE    done
+
+pad:
+	SHRL  $0x02, CX
+	INCQ  R9
+	TESTQ $0x00000003, R9
+	JNE   pad
+	MOVB  CL, (AX)(DI*1)
+
+done:
+	MOVQ R8, ret+56(FP)
+	RET
+
+// func encodeInt32SSE41(encoded []byte, data []int32) int
+// Requires: SSE2, SSE4.1, SSSE3
+TEXT ·encodeInt32SSE41(SB), NOSPLIT, $0-56
+	MOVQ encoded_base+0(FP), AX
+	MOVQ encoded_len+8(FP), CX
+
+	// Revert to scalar processing if we are within 16 bytes of the end.
+	SUBQ $0x10, CX
+	MOVQ data_base+24(FP), DX
+	MOVQ data_len+32(FP), BX
+
+	// Revert to scalar processing if we have less than 4 values to process.
+	MOVQ BX, SI
+	SUBQ $0x04, SI
+
+	// Initialize the control index.
+	XORQ DI, DI
+
+	// Initialize the data index. (len(data) + 3) >> 2
+	MOVQ BX, R8
+	ADDQ $0x03, R8
+	SHRQ $0x02, R8
+
+	// Initialize the input index.
+	XORQ R9, R9
+
+	// The byte count lookup table.
+	LEAQ dataByteCount<>+0(SB), R10
+
+	// The byte mask lookup table.
+	LEAQ encodeByteMask<>+0(SB), R11
+
+simd:
+	// Check if less than 16 encoded bytes remain and jump to scalar.
+	CMPQ R8, CX
+	JGT  scalarStart
+
+	// Check if less than 4 values remain and jump to scalar.
+	CMPQ R9, SI
+	JGT  scalarStart
+
+	// Load 4 values.
+	MOVOU (DX)(R9*4), X0
+
+	// Zigzag encode.
+	MOVOU X0, X1
+
+	// (x >> 31)
+	PSRAL $0x1f, X1
+
+	// (x << 1)
+	PSLLL $0x01, X0
+
+	// (x << 1) ^ (x >> 31)
+	PXOR X1, X0
+
+	// Start with a byte length code of 3 in each lane.
+	PCMPEQL X1, X1
+	PSRLL   $0x1e, X1
+
+	// Decrement the code for each byte width the value fits in.
+	MOVOU   X0, X2
+	PMINUD  byteMax<>+0(SB), X2
+	PCMPEQL X0, X2
+	PADDL   X2, X1
+	MOVOU   X0, X2
+	PMINUD  byteMax<>+16(SB), X2
+	PCMPEQL X0, X2
+	PADDL   X2, X1
+	MOVOU   X0, X2
+	PMINUD  byteMax<>+32(SB), X2
+	PCMPEQL X0, X2
+	PADDL   X2, X1
+
+	// Gather the low byte of each code and pack into the control byte.
+	PSHUFB packCodes<>+0(SB), X1
+	MOVQ   X1, R12
+	IMUL3L $0x01041040, R12, R12
+	SHRL   $0x18, R12
+
+	// Store control byte.
+	MOVB R12, (AX)(DI*1)
+	INCQ DI
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R12*1), R13
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R12
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R12*1), X0
+
+	// Store 16 data bytes.
+	MOVOU X0, (AX)(R8*1)
+
+	// Increment the indices.
+	ADDQ $0x04, R9
+	ADDQ R13, R8
+	JMP  simd
+
+scalarStart:
+	XORL CX, CX
+
+scalar:
+	// Process a single value at a time.
+	CMPQ R9, BX
+	JE   finish
+	MOVL (DX)(R9*4), SI
+
+	// Zigzag encode.
+	MOVL SI, R10
+	SARL $0x1f, R10
+	SHLL $0x01, SI
+	XORL R10, SI
+
+	// Shift control byte to make room for the next code.
+	SHRL $0x02, CX
+
+	// Switch on the number of bytes needed to hold the value.
+	CMPL SI, $0x000000ff
+	JBE  oneByte
+	CMPL SI, $0x0000ffff
+	JBE  twoByte
+	CMPL SI, $0x00ffffff
+	JBE  threeByte
+	MOVL SI, (AX)(R8*1)
+	ADDQ $0x04, R8
+	ORL  $0x000000c0, CX
+	JMP  nextValue
+
+threeByte:
+	MOVW SI, (AX)(R8*1)
+	SHRL $0x10, SI
+	MOVB SI, 2(AX)(R8*1)
+	ADDQ $0x03, R8
+	ORL  $0x00000080, CX
+	JMP  nextValue
+
+twoByte:
+	MOVW SI, (AX)(R8*1)
+	ADDQ $0x02, R8
+	ORL  $0x00000040, CX
+	JMP  nextValue
+
+oneByte:
+	MOVB SI, (AX)(R8*1)
+	INCQ R8
+
+nextValue:
+	INCQ R9
+
+	// Store the control byte if the block of 4 values is complete.
+	TESTQ $0x00000003, R9
+	JNE   scalar
+	MOVB  CL, (AX)(DI*1)
+	INCQ  DI
+	XORL  CX, CX
+	JMP   scalar
+
+finish:
+	// Check if the last block was complete or the control byte needs to be shifted and written.
+	TESTQ $0x00000003, R9
+	JE    done
+
+pad:
+	SHRL  $0x02, CX
+	INCQ  R9
+	TESTQ $0x00000003, R9
+	JNE   pad
+	MOVB  CL, (AX)(DI*1)
+
+done:
+	MOVQ R8, ret+48(FP)
+	RET
+
+// func encodeDeltaInt32SSE41(encoded []byte, data []int32, previous int32) int
+// Requires: SSE2, SSE4.1, SSSE3
+TEXT ·encodeDeltaInt32SSE41(SB), NOSPLIT, $0-64
+	MOVQ encoded_base+0(FP), AX
+	MOVQ encoded_len+8(FP), CX
+
+	// Revert to scalar processing if we are within 16 bytes of the end.
+	SUBQ $0x10, CX
+	MOVQ data_base+24(FP), DX
+	MOVQ data_len+32(FP), BX
+
+	// Revert to scalar processing if we have less than 4 values to process.
+	MOVQ BX, SI
+	SUBQ $0x04, SI
+
+	// Initialize the control index.
+	XORQ DI, DI
+
+	// Initialize the data index. (len(data) + 3) >> 2
+	MOVQ BX, R8
+	ADDQ $0x03, R8
+	SHRQ $0x02, R8
+
+	// Initialize the input index.
+	XORQ R9, R9
+
+	// The byte count lookup table.
+	LEAQ dataByteCount<>+0(SB), R10
+
+	// The byte mask lookup table.
+	LEAQ   encodeByteMask<>+0(SB), R11
+	MOVL   previous+48(FP), R12
+	MOVD   R12, X0
+	PSHUFD $0x00, X0, X0
+
+simd:
+	// Check if less than 16 encoded bytes remain and jump to scalar.
+	CMPQ R8, CX
+	JGT  scalarStart
+
+	// Check if less than 4 values remain and jump to scalar.
+	CMPQ R9, SI
+	JGT  scalarStart
+
+	// Load 4 values.
+	MOVOU (DX)(R9*4), X1
+
+	// Calculate deltas.
+	MOVOU X1, X2
+
+	// (previous_3, data_0, data_1, data_2)
+	PALIGNR $0x0c, X0, X2
+
+	// Save the current values as previous for the next block.
+	MOVOU X1, X0
+
+	// (data_0 - previous_3, data_1 - data_0, data_2 - data_1, data_3 - data_2)
+	PSUBL X2, X1
+
+	// Zigzag encode.
+	MOVOU X1, X2
+
+	// (x >> 31)
+	PSRAL $0x1f, X2
+
+	// (x << 1)
+	PSLLL $0x01, X1
+
+	// (x << 1) ^ (x >> 31)
+	PXOR X2, X1
+
+	// Start with a byte length code of 3 in each lane.
+	PCMPEQL X2, X2
+	PSRLL   $0x1e, X2
+
+	// Decrement the code for each byte width the value fits in.
+	MOVOU   X1, X3
+	PMINUD  byteMax<>+0(SB), X3
+	PCMPEQL X1, X3
+	PADDL   X3, X2
+	MOVOU   X1, X3
+	PMINUD  byteMax<>+16(SB), X3
+	PCMPEQL X1, X3
+	PADDL   X3, X2
+	MOVOU   X1, X3
+	PMINUD  byteMax<>+32(SB), X3
+	PCMPEQL X1, X3
+	PADDL   X3, X2
+
+	// Gather the low byte of each code and pack into the control byte.
+	PSHUFB packCodes<>+0(SB), X2
+	MOVQ   X2, R12
+	IMUL3L $0x01041040, R12, R12
+	SHRL   $0x18, R12
+
+	// Store control byte.
+	MOVB R12, (AX)(DI*1)
+	INCQ DI
+
+	// Lookup count to increment data index.
+	MOVBQZX (R10)(R12*1), R13
+
+	// Lookup the PSHUFB mask.
+	SHLQ $0x04, R12
+
+	// Use mask to shuffle the relevant bytes into place.
+	PSHUFB (R11)(R12*1), X1
+
+	// Store 16 data bytes.
+	MOVOU X1, (AX)(R8*1)
+
+	// Increment the indices.
+	ADDQ $0x04, R9
+	ADDQ R13, R8
+	JMP  simd
+
+scalarStart:
+	// Extract the last value of the SIMD loop as previous.
+	PSHUFD $0xff, X0, X0
+	MOVD   X0, R12
+	XORL   CX, CX
+
+scalar:
+	// Process a single value at a time.
+	CMPQ R9, BX
+	JE   finish
+	MOVL (DX)(R9*4), SI
+
+	// Calculate delta.
+	MOVL SI, R10
+	SUBL R12, SI
+	MOVL R10, R12
+
+	// Zigzag encode.
+	MOVL SI, R10
+	SARL $0x1f, R10
+	SHLL $0x01, SI
+	XORL R10, SI
+
+	// Shift control byte to make room for the next code.
+	SHRL $0x02, CX
+
+	// Switch on the number of bytes needed to hold the value.
+	CMPL SI, $0x000000ff
+	JBE  oneByte
+	CMPL SI, $0x0000ffff
+	JBE  twoByte
+	CMPL SI, $0x00ffffff
+	JBE  threeByte
+	MOVL SI, (AX)(R8*1)
+	ADDQ $0x04, R8
+	ORL  $0x000000c0, CX
+	JMP  nextValue
+
+threeByte:
+	MOVW SI, (AX)(R8*1)
+	SHRL $0x10, SI
+	MOVB SI, 2(AX)(R8*1)
+	ADDQ $0x03, R8
+	ORL  $0x00000080, CX
+	JMP  nextValue
+
+twoByte:
+	MOVW SI, (AX)(R8*1)
+	ADDQ $0x02, R8
+	ORL  $0x00000040, CX
+	JMP  nextValue
+
+oneByte:
+	MOVB SI, (AX)(R8*1)
+	INCQ R8
+
+nextValue:
+	INCQ R9
+
+	// Store the control byte if the block of 4 values is complete.
+	TESTQ $0x00000003, R9
+	JNE   scalar
+	MOVB  CL, (AX)(DI*1)
+	INCQ  DI
+	XORL  CX, CX
+	JMP   scalar
+
+finish:
+	// Check if the last block was complete or the control byte needs to be shifted and written.
+	TESTQ $0x00000003, R9
+	JE    done
+
+pad:
+	SHRL  $0x02, CX
+	INCQ  R9
+	TESTQ $0x00000003, R9
+	JNE   pad
+	MOVB  CL, (AX)(DI*1)
+
+done:
+	MOVQ R8, ret+56(FP)
+	RET
@@ -0,0 +1,37 @@
+// Code generated by command: go run gen_encodedlen_popcnt.go -out encodedlen_popcnt_amd64.s. DO NOT EDIT.
+
+#include "textflag.h"
+
+// func sumCodesPOPCNT(control []byte) int
+// Requires: POPCNT
+TEXT ·sumCodesPOPCNT(SB), NOSPLIT, $0-32
+	MOVQ control_base+0(FP), AX
+	MOVQ control_len+8(FP), CX
+
+	// Masks of the low and high bits of each code.
+	MOVQ $0x5555555555555555, DX
+	MOVQ $0xaaaaaaaaaaaaaaaa, BX
+	XORQ SI, SI
+	XORQ DI, DI
+
+loop:
+	CMPQ DI, CX
+	JGE  done
+
+	// Load 8 control bytes.
+	MOVQ (AX)(DI*1), R8
+
+	// The sum of the codes is popcount(low bits) + 2 * popcount(high bits).
+	MOVQ    R8, R9
+	ANDQ    DX, R9
+	ANDQ    BX, R8
+	POPCNTQ R9, R9
+	POPCNTQ R8, R8
+	ADDQ    R9, SI
+	LEAQ    (SI)(R8*2), SI
+	ADDQ    $0x08, DI
+	JMP     loop
+
+done:
+	MOVQ SI, ret+24(FP)
+	RET
@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestEncodedLen(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeRandomUint32(r, size)
+		encoded := make([]byte, MaxSize32(size)+8)
+		encodedSize := EncodeUint32(encoded, data)
+		// trailing bytes must not change the result
+		r.Read(encoded[encodedSize:])
+		if got := EncodedLen(size, encoded); got != encodedSize {
+			t.Errorf("size %d: got EncodedLen: %d, expected: %d", size, got, encodedSize)
+		}
+		if got := EncodedLen(size, encoded[:encodedSize]); got != encodedSize {
+			t.Errorf("size %d: got EncodedLen: %d, expected: %d", size, got, encodedSize)
+		}
+	}
+}
+
+func TestDataLenScalar(t *testing.T) {
+	control := []byte{0x00, 0xFF, 0b_11_10_01_00}
+	if got, expected := dataLenScalar(control), 4+16+10; got != expected {
+		t.Errorf("got dataLenScalar: %d, expected: %d", got, expected)
+	}
+}
+
+func BenchmarkEncodedLen(b *testing.B) {
+	benchEncodedSize = EncodeUint32(benchEncoded, benchUint32Data)
+	b.SetBytes(int64((benchSize + 3) / 4))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodedLen(benchSize, benchEncoded)
+	}
+}
+
+func BenchmarkEncodedLenScalar(b *testing.B) {
+	benchEncodedSize = EncodeUint32(benchEncoded, benchUint32Data)
+	b.SetBytes(int64((benchSize + 3) / 4))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dataLenScalar(benchEncoded[:benchSize/4])
+	}
+}
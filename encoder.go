@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+// Encoder encodes uint32 values added one at a time, or a slice at a time,
+// without knowing their number up front.  The control and data bytes are
+// grown separately and joined by Bytes.
+type Encoder struct {
+	control []byte
+	data    []byte
+	count   int
+
+	delta    bool
+	zigzag   bool
+	initial  uint32
+	previous uint32
+
+	// buf holds the transformed values and scratch the encoded values of
+	// AddSlice.
+	buf     []uint32
+	scratch []byte
+}
+
+// NewEncoder returns an Encoder whose output decodes with DecodeUint32.
+func NewEncoder() *Encoder {
+	return &Encoder{}
+}
+
+// NewDeltaEncoder returns an Encoder whose output decodes with
+// DecodeDeltaUint32 using the initial value previous.
+func NewDeltaEncoder(previous uint32) *Encoder {
+	return &Encoder{delta: true, initial: previous, previous: previous}
+}
+
+// NewZigzagEncoder returns an Encoder whose output decodes with DecodeInt32.
+// The values added are the bits of int32, i.e. uint32(v).
+func NewZigzagEncoder() *Encoder {
+	return &Encoder{zigzag: true}
+}
+
+// NewDeltaZigzagEncoder returns an Encoder whose output decodes with
+// DecodeDeltaInt32 using the initial value previous.  The values added are
+// the bits of int32, i.e. uint32(v).
+func NewDeltaZigzagEncoder(previous int32) *Encoder {
+	return &Encoder{delta: true, zigzag: true, initial: uint32(previous), previous: uint32(previous)}
+}
+
+// transform applies the delta and zigzag encoding of the Encoder to v.
+func (e *Encoder) transform(v uint32) uint32 {
+	if e.delta {
+		v, e.previous = v-e.previous, v
+	}
+	if e.zigzag {
+		sv := int32(v)
+		v = uint32((sv >> 31) ^ (sv << 1))
+	}
+	return v
+}
+
+// Add encodes v.
+func (e *Encoder) Add(v uint32) {
+	v = e.transform(v)
+	if e.count&3 == 0 {
+		e.control = append(e.control, 0)
+	}
+	var code byte
+	switch {
+	case v < 1<<8:
+		e.data = append(e.data, byte(v))
+	case v < 1<<16:
+		e.data = append(e.data, byte(v), byte(v>>8))
+		code = 1
+	case v < 1<<24:
+		e.data = append(e.data, byte(v), byte(v>>8), byte(v>>16))
+		code = 2
+	default:
+		e.data = append(e.data, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+		code = 3
+	}
+	e.control[len(e.control)-1] |= code << (2 * uint(e.count&3))
+	e.count++
+}
+
+// AddSlice encodes the values of data in order.  Once the values added fill
+// whole control bytes the bulk of data is encoded with EncodeUint32.
+func (e *Encoder) AddSlice(data []uint32) {
+	for len(data) > 0 && e.count&3 != 0 {
+		e.Add(data[0])
+		data = data[1:]
+	}
+	n := len(data) &^ 3
+	if n > 0 {
+		values := data[:n]
+		if e.delta || e.zigzag {
+			if cap(e.buf) < n {
+				e.buf = make([]uint32, n)
+			}
+			values = e.buf[:n]
+			for i, v := range data[:n] {
+				values[i] = e.transform(v)
+			}
+		}
+		if len(e.scratch) < MaxSize32(n) {
+			e.scratch = make([]byte, MaxSize32(n))
+		}
+		size := EncodeUint32(e.scratch, values)
+		e.control = append(e.control, e.scratch[:n>>2]...)
+		e.data = append(e.data, e.scratch[n>>2:size]...)
+		e.count += n
+	}
+	for _, v := range data[n:] {
+		e.Add(v)
+	}
+}
+
+// Len returns the number of values added.
+func (e *Encoder) Len() int {
+	return e.count
+}
+
+// Size returns the size of the encoded values, i.e. len(e.Bytes()).
+func (e *Encoder) Size() int {
+	return len(e.control) + len(e.data)
+}
+
+// Bytes returns a newly allocated slice holding the Stream VByte encoding of
+// the values added.
+func (e *Encoder) Bytes() []byte {
+	encoded := make([]byte, 0, e.Size())
+	encoded = append(encoded, e.control...)
+	return append(encoded, e.data...)
+}
+
+// Reset discards the values added, keeping the allocated buffers, and
+// restores the initial value of delta encoding.
+func (e *Encoder) Reset() {
+	e.control = e.control[:0]
+	e.data = e.data[:0]
+	e.count = 0
+	e.previous = e.initial
+}
@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestEncoder(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeRandomUint32(r, size)
+		int32Data := make([]int32, size)
+		for i, v := range data {
+			int32Data[i] = int32(v)
+		}
+		for _, tc := range []struct {
+			name    string
+			encoder *Encoder
+			encode  func(encoded []byte) int
+		}{
+			{"NewEncoder", NewEncoder(), func(encoded []byte) int { return EncodeUint32(encoded, data) }},
+			{"NewDeltaEncoder", NewDeltaEncoder(7), func(encoded []byte) int { return EncodeDeltaUint32(encoded, data, 7) }},
+			{"NewZigzagEncoder", NewZigzagEncoder(), func(encoded []byte) int { return EncodeInt32(encoded, int32Data) }},
+			{"NewDeltaZigzagEncoder", NewDeltaZigzagEncoder(-7), func(encoded []byte) int { return EncodeDeltaInt32(encoded, int32Data, -7) }},
+		} {
+			encoded := make([]byte, MaxSize32(size))
+			expected := encoded[:tc.encode(encoded)]
+
+			e := tc.encoder
+			for _, v := range data {
+				e.Add(v)
+			}
+			if e.Len() != size || e.Size() != len(expected) || !bytes.Equal(e.Bytes(), expected) {
+				t.Errorf("size %d: %s Add got %d bytes, expected: %d", size, tc.name, e.Size(), len(expected))
+			}
+
+			// Add uneven slices, crossing control bytes.
+			e.Reset()
+			for rest := data; len(rest) > 0; {
+				n := r.Intn(len(rest)) + 1
+				if r.Intn(2) == 0 {
+					e.AddSlice(rest[:n])
+				} else {
+					for _, v := range rest[:n] {
+						e.Add(v)
+					}
+				}
+				rest = rest[n:]
+			}
+			if e.Len() != size || !bytes.Equal(e.Bytes(), expected) {
+				t.Errorf("size %d: %s AddSlice got %d bytes, expected: %d", size, tc.name, e.Size(), len(expected))
+			}
+		}
+	}
+}
+
+func BenchmarkEncoderAdd(b *testing.B) {
+	e := NewEncoder()
+	b.SetBytes(int64(4 * benchSize))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e.Reset()
+		for _, v := range benchUint32Data {
+			e.Add(v)
+		}
+	}
+}
+
+func BenchmarkEncoderAddSlice(b *testing.B) {
+	e := NewEncoder()
+	b.SetBytes(int64(4 * benchSize))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e.Reset()
+		e.AddSlice(benchUint32Data)
+	}
+}
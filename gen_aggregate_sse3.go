@@ -0,0 +1,577 @@
+//go:build ignore
+// +build ignore
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+import (
+	"encoding/binary"
+
+	. "github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+// aggregateOp emits the instructions accumulating decoded values in registers.
+type aggregateOp interface {
+	// init initializes the accumulators.
+	init()
+	// vector accumulates 4 decoded values.
+	vector(x VecVirtual)
+	// reduce folds the vector accumulators into the scalar ones, n is the
+	// count of values accumulated so far.
+	reduce(n GPVirtual)
+	// scalar accumulates a single decoded value.
+	scalar(val GPVirtual)
+	// store writes the results.
+	store()
+}
+
+// sumOp sums the values into 64-bit accumulators.
+type sumOp struct {
+	signed bool
+	acc    VecVirtual
+	sum    GPVirtual
+}
+
+func (o *sumOp) init() {
+	Comment("Initialize the 64-bit lane sums.")
+	o.acc = XMM()
+	PXOR(o.acc, o.acc)
+}
+
+func (o *sumOp) vector(x VecVirtual) {
+	Comment("Widen to 64-bit lanes and add to the sums.")
+	ext := XMM()
+	if o.signed {
+		MOVOU(x, ext)
+		PSRAL(Imm(31), ext)
+	} else {
+		PXOR(ext, ext)
+	}
+	hi := XMM()
+	MOVOU(x, hi)
+	PUNPCKLLQ(ext, x)
+	PUNPCKHLQ(ext, hi)
+	PADDQ(x, o.acc)
+	PADDQ(hi, o.acc)
+}
+
+func (o *sumOp) reduce(n GPVirtual) {
+	Comment("Add the 64-bit lane sums.")
+	hi := XMM()
+	PSHUFD(Imm(0b_11_10_11_10), o.acc, hi)
+	PADDQ(hi, o.acc)
+	o.sum = GP64()
+	MOVQ(o.acc, o.sum)
+}
+
+func (o *sumOp) scalar(val GPVirtual) {
+	wide := GP64()
+	if o.signed {
+		MOVLQSX(val, wide)
+	} else {
+		MOVL(val, wide.As32())
+	}
+	ADDQ(wide, o.sum)
+}
+
+func (o *sumOp) store() {
+	Store(o.sum, ReturnIndex(0))
+}
+
+// minMaxOp tracks the minimum and maximum with signed comparisons, unsigned
+// values are biased by 0x80000000 to preserve their order.
+type minMaxOp struct {
+	signed           bool
+	minX, maxX, bias VecVirtual
+	min, max         GPVirtual
+}
+
+func (o *minMaxOp) init() {
+	Comment("Initialize the minimum and maximum to the extremes.")
+	tmp := GP32()
+	o.minX, o.maxX = XMM(), XMM()
+	MOVL(U32(0x7FFFFFFF), tmp)
+	MOVD(tmp, o.minX)
+	PSHUFD(Imm(0), o.minX, o.minX)
+	MOVL(U32(0x80000000), tmp)
+	MOVD(tmp, o.maxX)
+	PSHUFD(Imm(0), o.maxX, o.maxX)
+	if !o.signed {
+		o.bias = XMM()
+		MOVOU(o.maxX, o.bias)
+	}
+}
+
+// selectLane sets dst to x in the lanes selected by mask and leaves it unchanged otherwise.
+func selectLane(dst, x, mask VecVirtual) {
+	PAND(mask, x)
+	PANDN(dst, mask)
+	POR(mask, x)
+	MOVOU(x, dst)
+}
+
+func (o *minMaxOp) updateMin(x VecVirtual) {
+	Comment("Lanes where the minimum is greater than x.")
+	mask := XMM()
+	MOVOU(o.minX, mask)
+	PCMPGTL(x, mask)
+	selectLane(o.minX, x, mask)
+}
+
+func (o *minMaxOp) updateMax(x VecVirtual) {
+	Comment("Lanes where x is greater than the maximum.")
+	mask := XMM()
+	MOVOU(x, mask)
+	PCMPGTL(o.maxX, mask)
+	selectLane(o.maxX, x, mask)
+}
+
+func (o *minMaxOp) vector(x VecVirtual) {
+	if !o.signed {
+		Comment("Bias to compare unsigned values as signed.")
+		PXOR(o.bias, x)
+	}
+	lo := XMM()
+	MOVOU(x, lo)
+	o.updateMin(lo)
+	o.updateMax(x)
+}
+
+func (o *minMaxOp) reduce(n GPVirtual) {
+	Comment("Fold the lanes of the minimum and maximum.")
+	for _, shuffle := range []uint64{0b_01_00_11_10, 0b_10_11_00_01} {
+		x := XMM()
+		PSHUFD(Imm(shuffle), o.minX, x)
+		o.updateMin(x)
+		x = XMM()
+		PSHUFD(Imm(shuffle), o.maxX, x)
+		o.updateMax(x)
+	}
+	o.min, o.max = GP32(), GP32()
+	MOVD(o.minX, o.min)
+	MOVD(o.maxX, o.max)
+}
+
+func (o *minMaxOp) scalar(val GPVirtual) {
+	if !o.signed {
+		XORL(U32(0x80000000), val)
+	}
+	CMPL(val, o.min)
+	CMOVLLT(val, o.min)
+	CMPL(val, o.max)
+	CMOVLGT(val, o.max)
+}
+
+func (o *minMaxOp) store() {
+	if !o.signed {
+		Comment("Remove the bias.")
+		XORL(U32(0x80000000), o.min)
+		XORL(U32(0x80000000), o.max)
+	}
+	Store(o.min, ReturnIndex(0))
+	Store(o.max, ReturnIndex(1))
+}
+
+// countRangeOp counts the values in [lo, hi] with signed comparisons, unsigned
+// values are biased by 0x80000000 to preserve their order.
+type countRangeOp struct {
+	signed          bool
+	loX, hiX, outX  VecVirtual
+	bias            VecVirtual
+	lo, hi, inRange GPVirtual
+}
+
+func (o *countRangeOp) init() {
+	o.lo, o.hi = GP32(), GP32()
+	Load(Param("lo"), o.lo)
+	Load(Param("hi"), o.hi)
+	if !o.signed {
+		Comment("Bias to compare unsigned values as signed.")
+		XORL(U32(0x80000000), o.lo)
+		XORL(U32(0x80000000), o.hi)
+		tmp := GP32()
+		o.bias = XMM()
+		MOVL(U32(0x80000000), tmp)
+		MOVD(tmp, o.bias)
+		PSHUFD(Imm(0), o.bias, o.bias)
+	}
+	o.loX, o.hiX = XMM(), XMM()
+	MOVD(o.lo, o.loX)
+	PSHUFD(Imm(0), o.loX, o.loX)
+	MOVD(o.hi, o.hiX)
+	PSHUFD(Imm(0), o.hiX, o.hiX)
+	Comment("Initialize the per lane count of values out of range.")
+	o.outX = XMM()
+	PXOR(o.outX, o.outX)
+}
+
+func (o *countRangeOp) vector(x VecVirtual) {
+	if !o.signed {
+		PXOR(o.bias, x)
+	}
+	Comment("Lanes where lo > x or x > hi.")
+	below := XMM()
+	MOVOU(o.loX, below)
+	PCMPGTL(x, below)
+	PCMPGTL(o.hiX, x)
+	POR(below, x)
+	Comment("Subtracting the all ones mask counts the lanes out of range.")
+	PSUBL(x, o.outX)
+}
+
+func (o *countRangeOp) reduce(n GPVirtual) {
+	Comment("Sum the lanes counting values out of range.")
+	x := XMM()
+	PSHUFD(Imm(0b_01_00_11_10), o.outX, x)
+	PADDL(x, o.outX)
+	PSHUFD(Imm(0b_10_11_00_01), o.outX, x)
+	PADDL(x, o.outX)
+	out := GP64()
+	MOVQ(o.outX, out)
+	Comment("Every lane holds the sum, keep the zero extended high one.")
+	SHRQ(Imm(32), out)
+	o.inRange = GP64()
+	MOVQ(n, o.inRange)
+	SUBQ(out, o.inRange)
+}
+
+func (o *countRangeOp) scalar(val GPVirtual) {
+	if !o.signed {
+		XORL(U32(0x80000000), val)
+	}
+	one := GP64()
+	XORQ(one, one)
+	CMPL(val, o.lo)
+	SETGE(one.As8())
+	zero := GP64()
+	XORQ(zero, zero)
+	CMPL(val, o.hi)
+	CMOVQGT(zero, one)
+	ADDQ(one, o.inRange)
+}
+
+func (o *countRangeOp) store() {
+	Store(o.inRange, ReturnIndex(0))
+}
+
+// preamble loads the input data and returns variables referencing those values
+func preamble(dataByteCount, dataByteMask Mem) (encoded Mem, encodedCap Register, count Register, countTail GPVirtual, ci GPVirtual, di GPVirtual, n GPVirtual, byteCountPtr Mem, byteMaskptr Mem) {
+	encoded = Mem{Base: Load(Param("encoded").Base(), GP64())}
+	encodedCap = Load(Param("encoded").Cap(), GP64())
+	Comment("Revert to scalar processing if we are within 16 bytes of the end.")
+	SUBQ(Imm(16), encodedCap)
+
+	count = Load(Param("count"), GP64())
+	countTail = GP64()
+	Comment("Revert to scalar processing if we have less than 4 values to process.")
+	MOVQ(count, countTail)
+	SUBQ(Imm(4), countTail)
+
+	Comment("Initialize the control index.")
+	ci = GP64()
+	XORQ(ci, ci)
+
+	Comment("Initialize the data index. (count + 3) >> 2")
+	di = GP64()
+	MOVQ(count, di)
+	ADDQ(Imm(3), di)
+	SHRQ(Imm(2), di)
+
+	Comment("Initialize the value index.")
+	n = GP64()
+	XORQ(n, n)
+
+	Comment("The byte count lookup table.")
+	byteCountPtr = Mem{Base: GP64()}
+	LEAQ(dataByteCount, byteCountPtr.Base)
+
+	Comment("The byte mask lookup table.")
+	byteMaskptr = Mem{Base: GP64()}
+	LEAQ(dataByteMask, byteMaskptr.Base)
+	return encoded, encodedCap, count, countTail, ci, di, n, byteCountPtr, byteMaskptr
+}
+
+// decodeSIMDUint32 reads control byte and 4 uint32 from data bytes and returns the count of bytes read along with the dataBytes
+func decodeSIMDUint32(encoded Mem, ci, di GPVirtual, byteCountPtr, byteMaskptr Mem) (VecVirtual, GPVirtual) {
+	Comment("Load control byte.")
+	cb := GP64()
+	MOVBQZX(encoded.Idx(ci, 1), cb)
+	INCQ(ci)
+
+	Comment("Load 16 data bytes into XMM.")
+	dataBytes := XMM()
+	MOVOU(encoded.Idx(di, 1), dataBytes)
+
+	Comment("Lookup count to increment data index.")
+	byteCount := GP64()
+	MOVBQZX(byteCountPtr.Idx(cb, 1), byteCount)
+
+	Comment("Lookup the PSHUFB mask.")
+	SHLQ(Imm(4), cb)
+
+	Comment("Use mask to shuffle the relevant bytes into place.")
+	PSHUFB(byteMaskptr.Idx(cb, 1), dataBytes)
+
+	return dataBytes, byteCount
+}
+
+// decodeScalarUint32 reads control byte and returns the decoded uint32 value
+func decodeScalarUint32(n, ci, di GPVirtual, encoded Mem) (val GPVirtual) {
+	Comment("Determine if we need to load a new control byte.")
+	TESTQ(U32(3), n)
+	JNE(LabelRef("loadBytes"))
+
+	Comment("Load control byte.")
+	cb := GP64()
+	MOVBQZX(encoded.Idx(ci, 1), cb)
+	INCQ(ci)
+
+	Label("loadBytes")
+	Comment("Switch on the low two bits of the control byte.")
+	switchVal := GP64()
+	MOVQ(cb, switchVal)
+	ANDQ(Imm(3), switchVal)
+
+	JE(LabelRef("oneByte"))
+	CMPQ(switchVal, Imm(1))
+	JE(LabelRef("twoByte"))
+	CMPQ(switchVal, Imm(2))
+	JE(LabelRef("threeByte"))
+
+	val = GP32()
+
+	Label("fourByte")
+	MOVL(encoded.Idx(di, 1), val) // val = binary.LittleEndian.Uint32(encoded[di:])
+	ADDQ(Imm(4), di)              // di += 4
+	JMP(LabelRef("shiftControl"))
+
+	Label("threeByte")
+	hi := GP32()
+	MOVWLZX(encoded.Idx(di, 1), val)          // val = uint32(binary.LittleEndian.Uint16(encoded[di:]))
+	MOVBLZX(encoded.Idx(di, 1).Offset(2), hi) // hi = uint32(encoded[di+2])
+	SHLL(Imm(16), hi)                         // hi <<= 16
+	ORL(hi, val)                              // val = (hi | val)
+	ADDQ(Imm(3), di)                          // di +=3
+	JMP(LabelRef("shiftControl"))
+
+	Label("twoByte")
+	MOVWLZX(encoded.Idx(di, 1), val) // val = uint32(binary.LittleEndian.Uint16(encoded[di:]))
+	ADDQ(Imm(2), di)                 // di += 2
+	JMP(LabelRef("shiftControl"))
+
+	Label("oneByte")
+	MOVBLZX(encoded.Idx(di, 1), val) // val = uint32(encoded[di])
+	INCQ(di)                         // di++
+
+	Label("shiftControl")
+	Comment("Shift control byte to get next value.")
+	SHRQ(Imm(2), cb)
+
+	return val
+}
+
+func prefixSumSIMD(dataBytes, previousX VecVirtual) {
+	shifted := XMM()
+	Comment("Calculate prefix sum.")
+	MOVOU(dataBytes, shifted)
+	Comment("(0, 0, delta_0, delta_1)")
+	PSLLDQ(Imm(8), shifted)
+	Comment("(delta_0, delta_1, delta_2 + delta_0, delta_3 + delta_1)")
+	PADDD(shifted, dataBytes)
+	MOVOU(dataBytes, shifted)
+	Comment("(0, delta_0, delta_1, delta_2 + delta_0)")
+	PSLLDQ(Imm(4), shifted)
+	Comment("(delta_0, delta_0 + delta_1, delta_0 + delta_1 + delta_2, delta_0 + delta_1 + delta_2 + delta_delta_3)")
+	PADDD(shifted, dataBytes)
+	Comment("Add the previous last decoded value to all lanes.")
+	PADDD(previousX, dataBytes)
+	Comment("Propagate last decoded value to all lanes of previous.")
+	PSHUFD(Imm(0b_11_11_11_11), dataBytes, previousX)
+}
+
+func zigzagDecodeScalar(val GPVirtual) {
+	Comment("Zigzag decode.")
+	tmp := GP32()
+	MOVL(val, tmp)
+	SHRL(Imm(1), tmp)
+	ANDL(Imm(1), val)
+	NEGL(val)
+	XORL(tmp, val)
+}
+
+func zigzagDecodeSIMD(dataBytes VecVirtual) {
+	Comment("Zigzag decode.")
+	tmpX := XMM()
+	MOVOU(dataBytes, tmpX)
+	Comment("(x >> 1)")
+	PSRLL(Imm(1), tmpX)
+	oneX := XMM()
+	Comment("Set to all ones.")
+	PCMPEQL(oneX, oneX)
+	Comment("Shift to one in each lane.")
+	PSRLL(Imm(31), oneX)
+	Comment("(x & 1)")
+	PAND(dataBytes, oneX)
+	Comment("Set to all zeroes.")
+	PXOR(dataBytes, dataBytes)
+	Comment("-(x & 1)")
+	PSUBL(oneX, dataBytes)
+	Comment("(x >> 1) ^ - (x & 1)")
+	PXOR(tmpX, dataBytes)
+}
+
+// aggregator generates a function decoding 4 values at a time into registers
+// and accumulating them with op, the delta and zigzag flags select the
+// transforms applied to the decoded values
+func aggregator(name, signature, doc string, delta, zigzag bool, op aggregateOp, dataByteCount, dataByteMask Mem) {
+	TEXT(name, NOSPLIT, signature)
+	Doc(name + " " + doc + " decoding 4 values at a time using SSE3 instructions (PSHUFB)")
+
+	encoded, encodedCap, count, countTail, ci, di, n, byteCountPtr, byteMaskptr := preamble(dataByteCount, dataByteMask)
+
+	var previous GPVirtual
+	var previousX VecVirtual
+	if delta {
+		previous = GP32()
+		Load(Param("previous"), previous)
+		previousX = XMM()
+		MOVD(previous, previousX)
+		PSHUFD(Imm(0b_00_00_00_00), previousX, previousX)
+	}
+	op.init()
+
+	Label("simd")
+	Comment("Check if less than 16 encoded bytes remain and jump to scalar.")
+	CMPQ(di, encodedCap)
+	JGT(LabelRef("scalar"))
+	Comment("Check if less than 4 values remain and jump to scalar.")
+	CMPQ(n, countTail)
+	JGT(LabelRef("scalar"))
+
+	dataBytes, byteCount := decodeSIMDUint32(encoded, ci, di, byteCountPtr, byteMaskptr)
+	if zigzag {
+		zigzagDecodeSIMD(dataBytes)
+	}
+	if delta {
+		prefixSumSIMD(dataBytes, previousX)
+	}
+	op.vector(dataBytes)
+
+	Comment("Increment the indices.")
+	ADDQ(Imm(4), n)
+	ADDQ(byteCount, di)
+	JMP(LabelRef("simd"))
+
+	Label("scalar")
+	if delta {
+		MOVD(previousX, previous)
+	}
+	op.reduce(n)
+	Comment("Process a single value at a time.")
+
+	Label("scalarLoop")
+	CMPQ(n, count)
+	JE(LabelRef("done"))
+
+	val := decodeScalarUint32(n, ci, di, encoded)
+	if zigzag {
+		zigzagDecodeScalar(val)
+	}
+	if delta {
+		Comment("Add the previous decoded value to the delta.")
+		ADDL(val, previous)
+		MOVL(previous, val)
+	}
+	op.scalar(val)
+	INCQ(n)
+	JMP(LabelRef("scalarLoop"))
+
+	Label("done")
+	op.store()
+	RET()
+}
+
+func main() {
+
+	// Lookup table of the count of data bytes (4 to 16) referenced by a control byte.
+	dataByteCount := GLOBL("dataByteCount", RODATA|NOPTR)
+	for i := 0; i < 256; i++ {
+		count := byte(i&3) + byte((i>>2)&3) + byte((i>>4)&3) + byte((i>>6)&3) + 4
+		DATA(i, U8(count))
+	}
+
+	// Lookup table of the PSUFB mask referenced by a control byte to move data bytes
+	// into the correct location.
+	dataByteMask := GLOBL("dataByteMask", RODATA|NOPTR)
+	for i := 0; i < 256; i++ {
+		curIndex, controlByte := byte(0), byte(i)
+		mask := [16]byte{}
+		for j := 0; j < 4; j++ {
+			byteCount := controlByte & 3
+			for k := 0; k < 4; k++ {
+				if k <= int(byteCount) {
+					mask[4*j+k] = curIndex
+					curIndex++
+				} else {
+					mask[4*j+k] = 0xFF
+				}
+			}
+			controlByte >>= 2
+		}
+		lowerHalf := binary.LittleEndian.Uint64(mask[0:8])
+		upperHalf := binary.LittleEndian.Uint64(mask[8:16])
+		DATA(16*i, U64(lowerHalf))
+		DATA(16*i+8, U64(upperHalf))
+	}
+
+	variants := []struct {
+		name, typ     string
+		delta, zigzag bool
+	}{
+		{"Uint32", "uint32", false, false},
+		{"DeltaUint32", "uint32", true, false},
+		{"Int32", "int32", false, true},
+		{"DeltaInt32", "int32", true, true},
+	}
+	for _, v := range variants {
+		params := "encoded []byte, count int"
+		if v.delta {
+			params += ", previous " + v.typ
+		}
+		sumType := "uint64"
+		if v.zigzag {
+			sumType = "int64"
+		}
+		aggregator("sum"+v.name+"SSE3", "func ("+params+") "+sumType, "returns the sum of count values",
+			v.delta, v.zigzag, &sumOp{signed: v.zigzag}, dataByteCount, dataByteMask)
+		aggregator("minMax"+v.name+"SSE3", "func ("+params+") (min, max "+v.typ+")", "returns the minimum and maximum of count values",
+			v.delta, v.zigzag, &minMaxOp{signed: v.zigzag}, dataByteCount, dataByteMask)
+		aggregator("countRange"+v.name+"SSE3", "func ("+params+", lo, hi "+v.typ+") int", "returns the count of values in [lo, hi]",
+			v.delta, v.zigzag, &countRangeOp{signed: v.zigzag}, dataByteCount, dataByteMask)
+	}
+
+	Generate()
+}
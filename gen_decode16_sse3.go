@@ -0,0 +1,288 @@
+//go:build ignore
+// +build ignore
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+import (
+	"encoding/binary"
+
+	. "github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+// preamble16 loads the input data and returns variables referencing those values
+func preamble16(dataByteCount, dataByteMask Mem) (encoded Mem, encodedCap Register, data Mem, dataLen Register, dataTail GPVirtual, ci GPVirtual, di GPVirtual, n GPVirtual, byteCountPtr Mem, byteMaskptr Mem) {
+	encoded = Mem{Base: Load(Param("encoded").Base(), GP64())}
+	encodedCap = Load(Param("encoded").Cap(), GP64())
+	Comment("Revert to scalar processing if we are within 16 bytes of the end.")
+	SUBQ(Imm(16), encodedCap)
+
+	data = Mem{Base: Load(Param("data").Base(), GP64())}
+	dataLen = Load(Param("data").Len(), GP64())
+	dataTail = GP64()
+	Comment("Revert to scalar processing if we have less than 8 values to process.")
+	MOVQ(dataLen, dataTail)
+	SUBQ(Imm(8), dataTail)
+
+	Comment("Initialize the control index.")
+	ci = GP64()
+	XORQ(ci, ci)
+
+	Comment("Initialize the data index. (len(data) + 7) >> 3")
+	di = GP64()
+	MOVQ(dataLen, di)
+	ADDQ(Imm(7), di)
+	SHRQ(Imm(3), di)
+
+	Comment("Initialize the output index.")
+	n = GP64()
+	XORQ(n, n)
+
+	Comment("The byte count lookup table.")
+	byteCountPtr = Mem{Base: GP64()}
+	LEAQ(dataByteCount, byteCountPtr.Base)
+
+	Comment("The byte mask lookup table.")
+	byteMaskptr = Mem{Base: GP64()}
+	LEAQ(dataByteMask, byteMaskptr.Base)
+	return encoded, encodedCap, data, dataLen, dataTail, ci, di, n, byteCountPtr, byteMaskptr
+}
+
+// decodeSIMDUint16 reads control byte and 8 uint16 from data bytes and returns the count of bytes read along with the dataBytes
+func decodeSIMDUint16(encoded Mem, ci, di GPVirtual, byteCountPtr, byteMaskptr Mem) (VecVirtual, GPVirtual) {
+	Comment("Load control byte.")
+	cb := GP64()
+	MOVBQZX(encoded.Idx(ci, 1), cb)
+	INCQ(ci)
+
+	Comment("Load 16 data bytes into XMM.")
+	dataBytes := XMM()
+	MOVOU(encoded.Idx(di, 1), dataBytes)
+
+	Comment("Lookup count to increment data index.")
+	byteCount := GP64()
+	MOVBQZX(byteCountPtr.Idx(cb, 1), byteCount)
+
+	Comment("Lookup the PSHUFB mask.")
+	SHLQ(Imm(4), cb)
+
+	Comment("Use mask to shuffle the relevant bytes into place.")
+	PSHUFB(byteMaskptr.Idx(cb, 1), dataBytes)
+
+	return dataBytes, byteCount
+}
+
+// decodeScalarUint16 reads control byte and returns the decoded uint16 value
+func decodeScalarUint16(n, ci, di GPVirtual, encoded Mem) GPVirtual {
+	Comment("Determine if we need to load a new control byte.")
+	TESTQ(U32(7), n)
+	JNE(LabelRef("loadBytes"))
+
+	Comment("Load control byte.")
+	cb := GP64()
+	MOVBQZX(encoded.Idx(ci, 1), cb)
+	INCQ(ci)
+
+	Label("loadBytes")
+	val := GP32()
+	Comment("Test the low bit of the control byte.")
+	TESTQ(U32(1), cb)
+	JNE(LabelRef("twoByte"))
+
+	MOVBLZX(encoded.Idx(di, 1), val) // val = uint16(encoded[di])
+	INCQ(di)                         // di++
+	JMP(LabelRef("shiftControl"))
+
+	Label("twoByte")
+	MOVWLZX(encoded.Idx(di, 1), val) // val = binary.LittleEndian.Uint16(encoded[di:])
+	ADDQ(Imm(2), di)                 // di += 2
+
+	Label("shiftControl")
+	Comment("Shift control byte to get next value.")
+	SHRQ(Imm(1), cb)
+
+	return val
+}
+
+func prefixSumSIMD16(dataBytes, previousX VecVirtual) {
+	shifted := XMM()
+	Comment("Calculate prefix sum.")
+	for _, shift := range []int{2, 4, 8} {
+		MOVOU(dataBytes, shifted)
+		PSLLDQ(Imm(uint64(shift)), shifted)
+		PADDW(shifted, dataBytes)
+	}
+	Comment("Add the previous last decoded value to all lanes.")
+	PADDW(previousX, dataBytes)
+	Comment("Propagate last decoded value to all lanes of previous.")
+	PSHUFHW(Imm(0b_11_11_11_11), dataBytes, previousX)
+	PSHUFD(Imm(0b_11_11_11_11), previousX, previousX)
+}
+
+func zigzagDecodeScalar16(val GPVirtual) {
+	Comment("Zigzag decode.")
+	tmp := GP32()
+	MOVL(val, tmp)
+	SHRL(Imm(1), tmp)
+	ANDL(Imm(1), val)
+	NEGL(val)
+	XORL(tmp, val)
+}
+
+func zigzagDecodeSIMD16(dataBytes VecVirtual) {
+	Comment("Zigzag decode.")
+	tmpX := XMM()
+	MOVOU(dataBytes, tmpX)
+	Comment("(x >> 1)")
+	PSRLW(Imm(1), tmpX)
+	oneX := XMM()
+	Comment("Set to all ones.")
+	PCMPEQW(oneX, oneX)
+	Comment("Shift to one in each lane.")
+	PSRLW(Imm(15), oneX)
+	Comment("(x & 1)")
+	PAND(dataBytes, oneX)
+	Comment("Set to all zeroes.")
+	PXOR(dataBytes, dataBytes)
+	Comment("-(x & 1)")
+	PSUBW(oneX, dataBytes)
+	Comment("(x >> 1) ^ - (x & 1)")
+	PXOR(tmpX, dataBytes)
+}
+
+// decoder16 generates a function decoding 8 values at a time, the delta and
+// zigzag flags select the transforms applied to the decoded values
+func decoder16(name, signature string, delta, zigzag bool, dataByteCount, dataByteMask Mem) {
+	TEXT(name, NOSPLIT, signature)
+	Doc(name + " decodes 8 values at a time using SSE3 instructions (PSHUFB) and returns the number of bytes read")
+
+	encoded, encodedCap, data, dataLen, dataTail, ci, di, n, byteCountPtr, byteMaskptr := preamble16(dataByteCount, dataByteMask)
+
+	var previous GPVirtual
+	var previousX VecVirtual
+	if delta {
+		previous = GP32()
+		Load(Param("previous"), previous)
+		previousX = XMM()
+		MOVD(previous, previousX)
+		PSHUFLW(Imm(0b_00_00_00_00), previousX, previousX)
+		PSHUFD(Imm(0b_00_00_00_00), previousX, previousX)
+	}
+
+	Label("simd")
+	Comment("Check if less than 16 encoded bytes remain and jump to scalar.")
+	CMPQ(di, encodedCap)
+	JGT(LabelRef("scalar"))
+	Comment("Check if less than 8 values remain and jump to scalar.")
+	CMPQ(n, dataTail)
+	JGT(LabelRef("scalar"))
+
+	dataBytes, byteCount := decodeSIMDUint16(encoded, ci, di, byteCountPtr, byteMaskptr)
+	if zigzag {
+		zigzagDecodeSIMD16(dataBytes)
+	}
+	if delta {
+		prefixSumSIMD16(dataBytes, previousX)
+	}
+
+	Comment("Store 8 uint16.")
+	MOVOU(dataBytes, data.Idx(n, 2))
+
+	Comment("Increment the indices.")
+	ADDQ(Imm(8), n)
+	ADDQ(byteCount, di)
+	JMP(LabelRef("simd"))
+
+	Label("scalar")
+	if delta {
+		MOVD(previousX, previous)
+	}
+	Comment("Process a single value at a time.")
+
+	Label("scalarLoop")
+	CMPQ(n, dataLen)
+	JE(LabelRef("done"))
+
+	val := decodeScalarUint16(n, ci, di, encoded)
+	if zigzag {
+		zigzagDecodeScalar16(val)
+	}
+	if delta {
+		Comment("Add the previous decoded value to the delta.")
+		ADDL(val, previous)
+		MOVL(previous, val)
+	}
+	MOVW(val.As16(), data.Idx(n, 2)) // data[i] = val
+	INCQ(n)
+	JMP(LabelRef("scalarLoop"))
+
+	Label("done")
+	Store(di, ReturnIndex(0))
+	RET()
+}
+
+func main() {
+
+	// Lookup table of the count of data bytes (8 to 16) referenced by a control byte.
+	dataByteCount := GLOBL("dataByteCount16", RODATA|NOPTR)
+	for i := 0; i < 256; i++ {
+		count := byte(8)
+		for j := 0; j < 8; j++ {
+			count += byte(i>>uint(j)) & 1
+		}
+		DATA(i, U8(count))
+	}
+
+	// Lookup table of the PSUFB mask referenced by a control byte to move data bytes
+	// into the correct location.
+	dataByteMask := GLOBL("dataByteMask16", RODATA|NOPTR)
+	for i := 0; i < 256; i++ {
+		curIndex, controlByte := byte(0), byte(i)
+		mask := [16]byte{}
+		for j := 0; j < 8; j++ {
+			mask[2*j] = curIndex
+			curIndex++
+			if controlByte&1 != 0 {
+				mask[2*j+1] = curIndex
+				curIndex++
+			} else {
+				mask[2*j+1] = 0xFF
+			}
+			controlByte >>= 1
+		}
+		lowerHalf := binary.LittleEndian.Uint64(mask[0:8])
+		upperHalf := binary.LittleEndian.Uint64(mask[8:16])
+		DATA(16*i, U64(lowerHalf))
+		DATA(16*i+8, U64(upperHalf))
+	}
+
+	decoder16("decodeUint16SSE3", "func (data []uint16, encoded []byte) int", false, false, dataByteCount, dataByteMask)
+	decoder16("decodeDeltaUint16SSE3", "func (data []uint16, encoded []byte, previous uint16) int", true, false, dataByteCount, dataByteMask)
+	decoder16("decodeInt16SSE3", "func (data []int16, encoded []byte) int", false, true, dataByteCount, dataByteMask)
+	decoder16("decodeDeltaInt16SSE3", "func (data []int16, encoded []byte, previous int16) int", true, true, dataByteCount, dataByteMask)
+
+	Generate()
+}
@@ -0,0 +1,303 @@
+//go:build ignore
+// +build ignore
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+import (
+	"encoding/binary"
+
+	. "github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+// preamble64 loads the input data and returns variables referencing those values
+func preamble64(dataByteCount, dataByteMask Mem) (encoded Mem, encodedCap Register, data Mem, dataLen Register, dataTail GPVirtual, ci GPVirtual, di GPVirtual, n GPVirtual, byteCountPtr Mem, byteMaskptr Mem) {
+	encoded = Mem{Base: Load(Param("encoded").Base(), GP64())}
+	encodedCap = Load(Param("encoded").Cap(), GP64())
+	Comment("Revert to scalar processing if we are within 64 bytes of the end.")
+	SUBQ(Imm(64), encodedCap)
+
+	data = Mem{Base: Load(Param("data").Base(), GP64())}
+	dataLen = Load(Param("data").Len(), GP64())
+	dataTail = GP64()
+	Comment("Revert to scalar processing if we have less than 8 values to process.")
+	MOVQ(dataLen, dataTail)
+	SUBQ(Imm(8), dataTail)
+
+	Comment("Initialize the control index.")
+	ci = GP64()
+	XORQ(ci, ci)
+
+	Comment("Initialize the data index. (3*len(data) + 7) >> 3")
+	di = GP64()
+	LEAQ(Mem{Base: dataLen, Index: dataLen, Scale: 2, Disp: 7}, di)
+	SHRQ(Imm(3), di)
+
+	Comment("Initialize the output index.")
+	n = GP64()
+	XORQ(n, n)
+
+	Comment("The byte count lookup table.")
+	byteCountPtr = Mem{Base: GP64()}
+	LEAQ(dataByteCount, byteCountPtr.Base)
+
+	Comment("The byte mask lookup table.")
+	byteMaskptr = Mem{Base: GP64()}
+	LEAQ(dataByteMask, byteMaskptr.Base)
+	return encoded, encodedCap, data, dataLen, dataTail, ci, di, n, byteCountPtr, byteMaskptr
+}
+
+// loadControlGroup64 reads the 3 control bytes holding the codes of the next 8 values
+func loadControlGroup64(encoded Mem, ci GPVirtual) GPVirtual {
+	Comment("Load 3 control bytes holding 8 codes.")
+	cb := GP32()
+	hi := GP32()
+	MOVWLZX(encoded.Idx(ci, 1), cb)
+	MOVBLZX(encoded.Idx(ci, 1).Offset(2), hi)
+	SHLL(Imm(16), hi)
+	ORL(hi, cb)
+	ADDQ(Imm(3), ci)
+	return cb
+}
+
+// decodeSIMDUint64 decodes 2 uint64 from the data bytes using the low 6 bits of cb
+// and returns the decoded values along with the count of bytes read
+func decodeSIMDUint64(encoded Mem, cb, di GPVirtual, byteCountPtr, byteMaskptr Mem) (VecVirtual, GPVirtual) {
+	Comment("Extract the codes of the next 2 values.")
+	key := GP64()
+	MOVL(cb, key.As32())
+	ANDL(Imm(63), key.As32())
+	SHRL(Imm(6), cb)
+
+	Comment("Load 16 data bytes into XMM.")
+	dataBytes := XMM()
+	MOVOU(encoded.Idx(di, 1), dataBytes)
+
+	Comment("Lookup count to increment data index.")
+	byteCount := GP64()
+	MOVBQZX(byteCountPtr.Idx(key, 1), byteCount)
+
+	Comment("Lookup the PSHUFB mask.")
+	SHLQ(Imm(4), key)
+
+	Comment("Use mask to shuffle the relevant bytes into place.")
+	PSHUFB(byteMaskptr.Idx(key, 1), dataBytes)
+
+	return dataBytes, byteCount
+}
+
+// decodeScalarUint64 reads the code of value n and returns the decoded uint64 value
+func decodeScalarUint64(n, di GPVirtual, encoded Mem) GPVirtual {
+	Comment("Locate the 3-bit code of value n at bit 3*n of the control bytes.")
+	bitPos := GP64()
+	LEAQ(Mem{Base: n, Index: n, Scale: 2}, bitPos)
+	ci := GP64()
+	MOVQ(bitPos, ci)
+	SHRQ(Imm(3), ci)
+	code := GP64()
+	MOVWQZX(encoded.Idx(ci, 1), code)
+	MOVQ(bitPos, RCX)
+	ANDQ(Imm(7), RCX)
+	SHRQ(CL, code)
+	ANDQ(Imm(7), code)
+
+	Comment("Read code+1 bytes from the most significant down.")
+	val := GP64()
+	XORQ(val, val)
+	k := GP64()
+	MOVQ(code, k)
+	valueBytes := Mem{Base: GP64()}
+	LEAQ(encoded.Idx(di, 1), valueBytes.Base)
+	b := GP64()
+	Label("loadByte")
+	SHLQ(Imm(8), val)
+	MOVBQZX(valueBytes.Idx(k, 1), b)
+	ORQ(b, val)
+	DECQ(k)
+	JGE(LabelRef("loadByte"))
+
+	Comment("Increment the data index by code+1.")
+	LEAQ(Mem{Base: di, Index: code, Scale: 1, Disp: 1}, di)
+	return val
+}
+
+func prefixSumSIMD64(dataBytes, previousX VecVirtual) {
+	shifted := XMM()
+	Comment("Calculate prefix sum.")
+	MOVOU(dataBytes, shifted)
+	Comment("(0, delta_0)")
+	PSLLDQ(Imm(8), shifted)
+	Comment("(delta_0, delta_0 + delta_1)")
+	PADDQ(shifted, dataBytes)
+	Comment("Add the previous last decoded value to all lanes.")
+	PADDQ(previousX, dataBytes)
+	Comment("Propagate last decoded value to all lanes of previous.")
+	PSHUFD(Imm(0b_11_10_11_10), dataBytes, previousX)
+}
+
+func zigzagDecodeScalar64(val GPVirtual) {
+	Comment("Zigzag decode.")
+	tmp := GP64()
+	MOVQ(val, tmp)
+	SHRQ(Imm(1), tmp)
+	ANDQ(Imm(1), val)
+	NEGQ(val)
+	XORQ(tmp, val)
+}
+
+func zigzagDecodeSIMD64(dataBytes VecVirtual) {
+	Comment("Zigzag decode.")
+	tmpX := XMM()
+	MOVOU(dataBytes, tmpX)
+	Comment("(x >> 1)")
+	PSRLQ(Imm(1), tmpX)
+	oneX := XMM()
+	Comment("Set to all ones.")
+	PCMPEQL(oneX, oneX)
+	Comment("Shift to one in each lane.")
+	PSRLQ(Imm(63), oneX)
+	Comment("(x & 1)")
+	PAND(dataBytes, oneX)
+	Comment("Set to all zeroes.")
+	PXOR(dataBytes, dataBytes)
+	Comment("-(x & 1)")
+	PSUBQ(oneX, dataBytes)
+	Comment("(x >> 1) ^ - (x & 1)")
+	PXOR(tmpX, dataBytes)
+}
+
+// decoder64 generates a function decoding 8 values at a time, the delta and
+// zigzag flags select the transforms applied to the decoded values
+func decoder64(name, signature string, delta, zigzag bool, dataByteCount, dataByteMask Mem) {
+	TEXT(name, NOSPLIT, signature)
+	Doc(name + " decodes 8 values at a time using SSE3 instructions (PSHUFB) and returns the number of bytes read")
+
+	encoded, encodedCap, data, dataLen, dataTail, ci, di, n, byteCountPtr, byteMaskptr := preamble64(dataByteCount, dataByteMask)
+
+	var previous GPVirtual
+	var previousX VecVirtual
+	if delta {
+		previous = GP64()
+		Load(Param("previous"), previous)
+		previousX = XMM()
+		MOVQ(previous, previousX)
+		PSHUFD(Imm(0b_01_00_01_00), previousX, previousX)
+	}
+
+	Label("simd")
+	Comment("Check if less than 64 encoded bytes remain and jump to scalar.")
+	CMPQ(di, encodedCap)
+	JGT(LabelRef("scalar"))
+	Comment("Check if less than 8 values remain and jump to scalar.")
+	CMPQ(n, dataTail)
+	JGT(LabelRef("scalar"))
+
+	cb := loadControlGroup64(encoded, ci)
+	for pair := 0; pair < 4; pair++ {
+		dataBytes, byteCount := decodeSIMDUint64(encoded, cb, di, byteCountPtr, byteMaskptr)
+		if zigzag {
+			zigzagDecodeSIMD64(dataBytes)
+		}
+		if delta {
+			prefixSumSIMD64(dataBytes, previousX)
+		}
+		Comment("Store 2 uint64.")
+		MOVOU(dataBytes, data.Idx(n, 8).Offset(16*pair))
+		ADDQ(byteCount, di)
+	}
+
+	Comment("Increment the output index.")
+	ADDQ(Imm(8), n)
+	JMP(LabelRef("simd"))
+
+	Label("scalar")
+	if delta {
+		MOVQ(previousX, previous)
+	}
+	Comment("Process a single value at a time.")
+
+	Label("scalarLoop")
+	CMPQ(n, dataLen)
+	JE(LabelRef("done"))
+
+	val := decodeScalarUint64(n, di, encoded)
+	if zigzag {
+		zigzagDecodeScalar64(val)
+	}
+	if delta {
+		Comment("Add the previous decoded value to the delta.")
+		ADDQ(val, previous)
+		MOVQ(previous, val)
+	}
+	MOVQ(val, data.Idx(n, 8)) // data[i] = val
+	INCQ(n)
+	JMP(LabelRef("scalarLoop"))
+
+	Label("done")
+	Store(di, ReturnIndex(0))
+	RET()
+}
+
+func main() {
+
+	// Lookup table of the count of data bytes (2 to 16) referenced by the codes of 2 values.
+	dataByteCount := GLOBL("dataByteCount64", RODATA|NOPTR)
+	for i := 0; i < 64; i++ {
+		count := byte(i&7) + byte((i>>3)&7) + 2
+		DATA(i, U8(count))
+	}
+
+	// Lookup table of the PSUFB mask referenced by the codes of 2 values to move data bytes
+	// into the correct location.
+	dataByteMask := GLOBL("dataByteMask64", RODATA|NOPTR)
+	for i := 0; i < 64; i++ {
+		curIndex, codes := byte(0), byte(i)
+		mask := [16]byte{}
+		for j := 0; j < 2; j++ {
+			byteCount := codes & 7
+			for k := 0; k < 8; k++ {
+				if k <= int(byteCount) {
+					mask[8*j+k] = curIndex
+					curIndex++
+				} else {
+					mask[8*j+k] = 0xFF
+				}
+			}
+			codes >>= 3
+		}
+		lowerHalf := binary.LittleEndian.Uint64(mask[0:8])
+		upperHalf := binary.LittleEndian.Uint64(mask[8:16])
+		DATA(16*i, U64(lowerHalf))
+		DATA(16*i+8, U64(upperHalf))
+	}
+
+	decoder64("decodeUint64SSE3", "func (data []uint64, encoded []byte) int", false, false, dataByteCount, dataByteMask)
+	decoder64("decodeDeltaUint64SSE3", "func (data []uint64, encoded []byte, previous uint64) int", true, false, dataByteCount, dataByteMask)
+	decoder64("decodeInt64SSE3", "func (data []int64, encoded []byte) int", false, true, dataByteCount, dataByteMask)
+	decoder64("decodeDeltaInt64SSE3", "func (data []int64, encoded []byte, previous int64) int", true, true, dataByteCount, dataByteMask)
+
+	Generate()
+}
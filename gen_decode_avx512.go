@@ -0,0 +1,416 @@
+//go:build ignore
+// +build ignore
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+import (
+	"encoding/binary"
+
+	. "github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+// preamble loads the input data and returns variables referencing those values
+func preamble(dataByteCount, dataByteMask Mem) (encoded Mem, encodedCap Register, data Mem, dataLen Register, ci GPVirtual, di GPVirtual, n GPVirtual, byteCountPtr Mem, byteMaskPtr Mem) {
+	encoded = Mem{Base: Load(Param("encoded").Base(), GP64())}
+	encodedCap = Load(Param("encoded").Cap(), GP64())
+
+	data = Mem{Base: Load(Param("data").Base(), GP64())}
+	dataLen = Load(Param("data").Len(), GP64())
+
+	Comment("Initialize the control index.")
+	ci = GP64()
+	XORQ(ci, ci)
+
+	Comment("Initialize the data index. (len(data) + 3) >> 2")
+	di = GP64()
+	MOVQ(dataLen, di)
+	ADDQ(Imm(3), di)
+	SHRQ(Imm(2), di)
+
+	Comment("Initialize the output index.")
+	n = GP64()
+	XORQ(n, n)
+
+	Comment("The byte count lookup table.")
+	byteCountPtr = Mem{Base: GP64()}
+	LEAQ(dataByteCount, byteCountPtr.Base)
+
+	Comment("The byte mask lookup table.")
+	byteMaskPtr = Mem{Base: GP64()}
+	LEAQ(dataByteMask, byteMaskPtr.Base)
+	return encoded, encodedCap, data, dataLen, ci, di, n, byteCountPtr, byteMaskPtr
+}
+
+// checkRemaining jumps to label if fewer than values remain in data or fewer
+// than bytes remain in encoded
+func checkRemaining(values, bytes int, n, di GPVirtual, dataLen, encodedCap Register, label string) {
+	Comment("Check if enough encoded bytes and values remain.")
+	end := GP64()
+	LEAQ(Mem{Base: di, Disp: bytes}, end)
+	CMPQ(end, encodedCap)
+	JGT(LabelRef(label))
+	LEAQ(Mem{Base: n, Disp: values}, end)
+	CMPQ(end, dataLen)
+	JGT(LabelRef(label))
+}
+
+// decodeAVX512Uint32 reads 4 control bytes and 16 uint32 from data bytes and
+// returns the count of bytes read along with the data bytes
+func decodeAVX512Uint32(encoded Mem, ci, di GPVirtual, byteCountPtr, byteMaskPtr Mem, laneIndex VecVirtual) (VecVirtual, GPVirtual) {
+	Comment("Load the first control byte and PSHUFB mask into the low lane.")
+	cb := GP64()
+	MOVBQZX(encoded.Idx(ci, 1), cb)
+	byteCount := GP64()
+	MOVBQZX(byteCountPtr.Idx(cb, 1), byteCount)
+	SHLQ(Imm(4), cb)
+	mask := ZMM()
+	VMOVDQU(byteMaskPtr.Idx(cb, 1), mask.AsX())
+
+	Comment("The offset of each block of data bytes within the 64 loaded bytes.")
+	offsets := XMM()
+	VPXOR(offsets, offsets, offsets)
+	for i := 1; i < 4; i++ {
+		VPINSRB(Imm(uint64(i)), byteCount.As32(), offsets, offsets)
+		MOVBQZX(encoded.Idx(ci, 1).Offset(i), cb)
+		ADDB(byteCountPtr.Idx(cb, 1), byteCount.As8())
+		SHLQ(Imm(4), cb)
+		VINSERTI32X4(Imm(uint64(i)), byteMaskPtr.Idx(cb, 1), mask, mask)
+	}
+	ADDQ(Imm(4), ci)
+
+	Comment("Bytes which are zeroed have the high bit set in the mask.")
+	keep := K()
+	VPMOVB2M(mask, keep)
+	KNOTQ(keep, keep)
+
+	Comment("Broadcast the offset of each block to its lane and add to the mask.")
+	offsetsZ := ZMM()
+	VPERMB(offsets.AsZ(), laneIndex, offsetsZ)
+	VPADDB(offsetsZ, mask, mask)
+
+	Comment("Load 64 data bytes and permute the relevant bytes into place.")
+	encodedBytes, dataBytes := ZMM(), ZMM()
+	VMOVDQU64(encoded.Idx(di, 1), encodedBytes)
+	VPERMB_Z(encodedBytes, mask, keep, dataBytes)
+
+	return dataBytes, byteCount
+}
+
+// decodeSIMDUint32 reads control byte and 4 uint32 from data bytes and returns the count of bytes read along with the dataBytes
+func decodeSIMDUint32(encoded Mem, ci, di GPVirtual, byteCountPtr, byteMaskPtr Mem) (VecVirtual, GPVirtual) {
+	Comment("Load control byte.")
+	cb := GP64()
+	MOVBQZX(encoded.Idx(ci, 1), cb)
+	INCQ(ci)
+
+	Comment("Load 16 data bytes into XMM.")
+	dataBytes := XMM()
+	VMOVDQU(encoded.Idx(di, 1), dataBytes)
+
+	Comment("Lookup count to increment data index.")
+	byteCount := GP64()
+	MOVBQZX(byteCountPtr.Idx(cb, 1), byteCount)
+
+	Comment("Lookup the PSHUFB mask.")
+	SHLQ(Imm(4), cb)
+
+	Comment("Use mask to shuffle the relevant bytes into place.")
+	VPSHUFB(byteMaskPtr.Idx(cb, 1), dataBytes, dataBytes)
+
+	return dataBytes, byteCount
+}
+
+// decodeScalarUint32 reads control byte and returns the decoded uint32 value
+func decodeScalarUint32(n, ci, di GPVirtual, encoded Mem) (val GPVirtual) {
+	Comment("Determine if we need to load a new control byte.")
+	TESTQ(U32(3), n)
+	JNE(LabelRef("loadBytes"))
+
+	Comment("Load control byte.")
+	cb := GP64()
+	MOVBQZX(encoded.Idx(ci, 1), cb)
+	INCQ(ci)
+
+	Label("loadBytes")
+	Comment("Switch on the low two bits of the control byte.")
+	switchVal := GP64()
+	MOVQ(cb, switchVal)
+	ANDQ(Imm(3), switchVal)
+
+	JE(LabelRef("oneByte"))
+	CMPQ(switchVal, Imm(1))
+	JE(LabelRef("twoByte"))
+	CMPQ(switchVal, Imm(2))
+	JE(LabelRef("threeByte"))
+
+	val = GP32()
+
+	Label("fourByte")
+	MOVL(encoded.Idx(di, 1), val) // val = binary.LittleEndian.Uint32(encoded[di:])
+	ADDQ(Imm(4), di)              // di += 4
+	JMP(LabelRef("shiftControl"))
+
+	Label("threeByte")
+	hi := GP32()
+	MOVWLZX(encoded.Idx(di, 1), val)          // val = uint32(binary.LittleEndian.Uint16(encoded[di:]))
+	MOVBLZX(encoded.Idx(di, 1).Offset(2), hi) // hi = uint32(encoded[di+2])
+	SHLL(Imm(16), hi)                         // hi <<= 16
+	ORL(hi, val)                              // val = (hi | val)
+	ADDQ(Imm(3), di)                          // di +=3
+	JMP(LabelRef("shiftControl"))
+
+	Label("twoByte")
+	MOVWLZX(encoded.Idx(di, 1), val) // val = uint32(binary.LittleEndian.Uint16(encoded[di:]))
+	ADDQ(Imm(2), di)                 // di += 2
+	JMP(LabelRef("shiftControl"))
+
+	Label("oneByte")
+	MOVBLZX(encoded.Idx(di, 1), val) // val = uint32(encoded[di])
+	INCQ(di)                         // di++
+
+	Label("shiftControl")
+	Comment("Shift control byte to get next value.")
+	SHRQ(Imm(2), cb)
+
+	return val
+}
+
+// prefixSumAVX512 adds the prefix sum of the 16 deltas in dataBytes to the
+// previous value broadcast in previousZ and broadcasts the last value to previousZ
+func prefixSumAVX512(dataBytes, previousZ, zero VecVirtual) {
+	shifted, totals := ZMM(), ZMM()
+	Comment("Calculate prefix sum within each lane.")
+	VPSLLDQ(Imm(8), dataBytes, shifted)
+	VPADDD(shifted, dataBytes, dataBytes)
+	VPSLLDQ(Imm(4), dataBytes, shifted)
+	VPADDD(shifted, dataBytes, dataBytes)
+	Comment("Calculate the prefix sum of the lane totals.")
+	VPSHUFD(Imm(0b_11_11_11_11), dataBytes, totals)
+	VALIGND(Imm(12), zero, totals, shifted)
+	VPADDD(shifted, totals, totals)
+	VALIGND(Imm(8), zero, totals, shifted)
+	VPADDD(shifted, totals, totals)
+	Comment("Add the total of all preceding lanes to each lane.")
+	VALIGND(Imm(12), zero, totals, shifted)
+	VPADDD(shifted, dataBytes, dataBytes)
+	Comment("Add the previous last decoded value to all lanes.")
+	VPADDD(previousZ, dataBytes, dataBytes)
+	Comment("Propagate last decoded value to all lanes of previous.")
+	VSHUFI32X4(Imm(0b_11_11_11_11), dataBytes, dataBytes, previousZ)
+	VPSHUFD(Imm(0b_11_11_11_11), previousZ, previousZ)
+}
+
+func prefixSumSIMD(dataBytes VecVirtual, previousX Register) {
+	shifted := XMM()
+	Comment("Calculate prefix sum.")
+	VPSLLDQ(Imm(8), dataBytes, shifted)
+	VPADDD(shifted, dataBytes, dataBytes)
+	VPSLLDQ(Imm(4), dataBytes, shifted)
+	VPADDD(shifted, dataBytes, dataBytes)
+	Comment("Add the previous last decoded value to all lanes.")
+	VPADDD(previousX, dataBytes, dataBytes)
+	Comment("Propagate last decoded value to all lanes of previous.")
+	VPSHUFD(Imm(0b_11_11_11_11), dataBytes, previousX)
+}
+func zigzagDecodeScalar(val GPVirtual) {
+	Comment("Zigzag decode.")
+	tmp := GP32()
+	MOVL(val, tmp)
+	SHRL(Imm(1), tmp)
+	ANDL(Imm(1), val)
+	NEGL(val)
+	XORL(tmp, val)
+}
+
+// zigzagDecodeSIMD zigzag decodes dataBytes, which may be XMM or ZMM, using
+// oneMask of the same size which has the low bit set in each lane
+func zigzagDecodeSIMD(dataBytes VecVirtual, oneMask, zero Register) {
+	Comment("Zigzag decode.")
+	tmp := XMM()
+	if dataBytes.Size() == 64 {
+		tmp = ZMM()
+	}
+	Comment("-(x & 1)")
+	VPANDD(oneMask, dataBytes, tmp)
+	VPSUBD(tmp, zero, tmp)
+	Comment("(x >> 1) ^ - (x & 1)")
+	VPSRLD(Imm(1), dataBytes, dataBytes)
+	VPXORD(tmp, dataBytes, dataBytes)
+}
+
+// decoder generates an AVX-512 decoding function with the given transforms applied to the output
+func decoder(name, signature, typ string, delta, zigzag bool, dataByteCount, dataByteMask, laneIndexData Mem) {
+	TEXT(name, NOSPLIT, signature)
+	Doc(name + " decodes 16 " + typ + " at a time using AVX-512 instructions (VPERMB) and returns the number of bytes read")
+
+	encoded, encodedCap, data, dataLen, ci, di, n, byteCountPtr, byteMaskPtr := preamble(dataByteCount, dataByteMask)
+
+	laneIndex := ZMM()
+	VMOVDQU64(laneIndexData, laneIndex)
+
+	var previousZ VecVirtual
+	if delta {
+		previousZ = ZMM()
+		VPBROADCASTD(Load(Param("previous"), GP32()), previousZ)
+	}
+
+	var oneMask, zero VecVirtual
+	if zigzag || delta {
+		zero = ZMM()
+		VPXORD(zero, zero, zero)
+	}
+	if zigzag {
+		oneMask = ZMM()
+		VPTERNLOGD(Imm(0xFF), oneMask, oneMask, oneMask)
+		VPSRLD(Imm(31), oneMask, oneMask)
+	}
+
+	Label("avx512")
+	Comment("Decode 16 values at a time while 64 encoded bytes and 16 values remain.")
+	checkRemaining(16, 64, n, di, dataLen, encodedCap, "simd")
+
+	dataBytes, bytecount := decodeAVX512Uint32(encoded, ci, di, byteCountPtr, byteMaskPtr, laneIndex)
+
+	if zigzag {
+		zigzagDecodeSIMD(dataBytes, oneMask, zero)
+	}
+	if delta {
+		prefixSumAVX512(dataBytes, previousZ, zero)
+	}
+
+	Comment("Store 16 values.")
+	VMOVDQU32(dataBytes, data.Idx(n, 4))
+
+	Comment("Increment the indices.")
+	ADDQ(Imm(16), n)
+	ADDQ(bytecount, di)
+
+	JMP(LabelRef("avx512"))
+
+	Label("simd")
+	Comment("Decode 4 values at a time while 16 encoded bytes and 4 values remain.")
+	checkRemaining(4, 16, n, di, dataLen, encodedCap, "scalarStart")
+
+	dataBytesX, bytecount := decodeSIMDUint32(encoded, ci, di, byteCountPtr, byteMaskPtr)
+
+	if zigzag {
+		zigzagDecodeSIMD(dataBytesX, oneMask.AsX(), zero.AsX())
+	}
+	if delta {
+		prefixSumSIMD(dataBytesX, previousZ.AsX())
+	}
+
+	Comment("Store 4 values.")
+	VMOVDQU(dataBytesX, data.Idx(n, 4))
+
+	Comment("Increment the indices.")
+	ADDQ(Imm(4), n)
+	ADDQ(bytecount, di)
+
+	JMP(LabelRef("simd"))
+
+	Label("scalarStart")
+	var previous GPVirtual
+	if delta {
+		Comment("Extract the last decoded value as previous.")
+		previous = GP32()
+		VMOVD(previousZ.AsX(), previous)
+	}
+
+	Label("scalar")
+	Comment("Process a single value at a time.")
+
+	CMPQ(n, dataLen)
+	JE(LabelRef("done"))
+
+	val := decodeScalarUint32(n, ci, di, encoded)
+	if zigzag {
+		zigzagDecodeScalar(val)
+	}
+	if delta {
+		Comment("Add the previous decoded value to the delta.")
+		ADDL(val, previous) // previous += val
+		MOVL(previous, val) // val = previous
+	}
+
+	MOVL(val, data.Idx(n, 4)) // data[i] = val
+	INCQ(n)
+	JMP(LabelRef("scalar"))
+
+	Label("done")
+	VZEROUPPER()
+	Store(di, ReturnIndex(0))
+	RET()
+}
+
+func main() {
+
+	// Lookup table of the count of data bytes (4 to 16) referenced by a control byte.
+	dataByteCount := GLOBL("dataByteCount", RODATA|NOPTR)
+	for i := 0; i < 256; i++ {
+		count := byte(i&3) + byte((i>>2)&3) + byte((i>>4)&3) + byte((i>>6)&3) + 4
+		DATA(i, U8(count))
+	}
+
+	// Lookup table of the PSUFB mask referenced by a control byte to move data bytes
+	// into the correct location.
+	dataByteMask := GLOBL("dataByteMask", RODATA|NOPTR)
+	for i := 0; i < 256; i++ {
+		curIndex, controlByte := byte(0), byte(i)
+		mask := [16]byte{}
+		for j := 0; j < 4; j++ {
+			byteCount := controlByte & 3
+			for k := 0; k < 4; k++ {
+				if k <= int(byteCount) {
+					mask[4*j+k] = curIndex
+					curIndex++
+				} else {
+					mask[4*j+k] = 0xFF
+				}
+			}
+			controlByte >>= 2
+		}
+		lowerHalf := binary.LittleEndian.Uint64(mask[0:8])
+		upperHalf := binary.LittleEndian.Uint64(mask[8:16])
+		DATA(16*i, U64(lowerHalf))
+		DATA(16*i+8, U64(upperHalf))
+	}
+
+	// Index of the lane for each byte to broadcast the block offsets with VPERMB.
+	laneIndex := GLOBL("laneIndex", RODATA|NOPTR)
+	for i := 0; i < 4; i++ {
+		DATA(16*i, U64(0x0101010101010101*uint64(i)))
+		DATA(16*i+8, U64(0x0101010101010101*uint64(i)))
+	}
+
+	decoder("decodeUint32AVX512", "func (data []uint32, encoded []byte) int", "uint32", false, false, dataByteCount, dataByteMask, laneIndex)
+	decoder("decodeDeltaUint32AVX512", "func (data []uint32, encoded []byte, previous uint32) int", "uint32", true, false, dataByteCount, dataByteMask, laneIndex)
+	decoder("decodeInt32AVX512", "func (data []int32, encoded []byte) int", "int32", false, true, dataByteCount, dataByteMask, laneIndex)
+	decoder("decodeDeltaInt32AVX512", "func (data []int32, encoded []byte, previous int32) int", "int32", true, true, dataByteCount, dataByteMask, laneIndex)
+
+	Generate()
+}
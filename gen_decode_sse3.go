@@ -1,3 +1,4 @@
+//go:build ignore
 // +build ignore
 
 /*
@@ -234,8 +235,8 @@ func main() {
 		DATA(16*i+8, U64(upperHalf))
 	}
 
-	TEXT("decodeUint32SSE3", NOSPLIT, "func (data []uint32, encoded []byte)")
-	Doc("decodeUint32SSE3 decodes 4 uint32 at a time using SSE3 instructions (PSHUFB)")
+	TEXT("decodeUint32SSE3", NOSPLIT, "func (data []uint32, encoded []byte) int")
+	Doc("decodeUint32SSE3 decodes 4 uint32 at a time using SSE3 instructions (PSHUFB) and returns the number of bytes read")
 	{
 		encoded, encodedCap, data, dataLen, dataTail, ci, di, n, byteCountPtr, byteMaskptr := preamble(dataByteCount, dataByteMask)
 
@@ -271,11 +272,12 @@ func main() {
 		JMP(LabelRef("scalar"))
 
 		Label("done")
+		Store(di, ReturnIndex(0))
 		RET()
 	}
 
-	TEXT("decodeDeltaUint32SSE3", NOSPLIT, "func (data []uint32, encoded []byte, previous uint32)")
-	Doc("decodeDeltaUint32SSE3 decodes 4 uint32 at a time using SSE3 instructions (PSHUFB)")
+	TEXT("decodeDeltaUint32SSE3", NOSPLIT, "func (data []uint32, encoded []byte, previous uint32) int")
+	Doc("decodeDeltaUint32SSE3 decodes 4 uint32 at a time using SSE3 instructions (PSHUFB) and returns the number of bytes read")
 	{
 		encoded, encodedCap, data, dataLen, dataTail, ci, di, n, byteCountPtr, byteMaskptr := preamble(dataByteCount, dataByteMask)
 		previous := Load(Param("previous"), GP32())
@@ -322,12 +324,12 @@ func main() {
 		JMP(LabelRef("scalar"))
 
 		Label("done")
-
+		Store(di, ReturnIndex(0))
 		RET()
 	}
 
-	TEXT("decodeInt32SSE3", NOSPLIT, "func (data []int32, encoded []byte)")
-	Doc("decodeInt32SSE3 decodes 4 int32 at a time using SSE3 instructions (PSHUFB)")
+	TEXT("decodeInt32SSE3", NOSPLIT, "func (data []int32, encoded []byte) int")
+	Doc("decodeInt32SSE3 decodes 4 int32 at a time using SSE3 instructions (PSHUFB) and returns the number of bytes read")
 	{
 		encoded, encodedCap, data, dataLen, dataTail, ci, di, n, byteCountPtr, byteMaskptr := preamble(dataByteCount, dataByteMask)
 
@@ -366,11 +368,12 @@ func main() {
 		JMP(LabelRef("scalar"))
 
 		Label("done")
+		Store(di, ReturnIndex(0))
 		RET()
 	}
 
-	TEXT("decodeDeltaInt32SSE3", NOSPLIT, "func (data []int32, encoded []byte, previous int32)")
-	Doc("decodeDeltaInt32SSE3 decodes 4 int32 at a time using SSE3 instructions (PSHUFB)")
+	TEXT("decodeDeltaInt32SSE3", NOSPLIT, "func (data []int32, encoded []byte, previous int32) int")
+	Doc("decodeDeltaInt32SSE3 decodes 4 int32 at a time using SSE3 instructions (PSHUFB) and returns the number of bytes read")
 	{
 		encoded, encodedCap, data, dataLen, dataTail, ci, di, n, byteCountPtr, byteMaskptr := preamble(dataByteCount, dataByteMask)
 		previous := Load(Param("previous"), GP32())
@@ -419,7 +422,7 @@ func main() {
 		JMP(LabelRef("scalar"))
 
 		Label("done")
-
+		Store(di, ReturnIndex(0))
 		RET()
 	}
 
@@ -0,0 +1,331 @@
+//go:build ignore
+// +build ignore
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+import (
+	. "github.com/mmcloughlin/avo/build"
+	"github.com/mmcloughlin/avo/ir"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+// preamble loads the input data and returns variables referencing those values
+func preamble() (encoded Mem, encodedLen Register, data Mem, dataLen Register, ci GPVirtual, di GPVirtual, n GPVirtual) {
+	encoded = Mem{Base: Load(Param("encoded").Base(), GP64())}
+	encodedLen = Load(Param("encoded").Len(), GP64())
+
+	data = Mem{Base: Load(Param("data").Base(), GP64())}
+	dataLen = Load(Param("data").Len(), GP64())
+
+	Comment("Initialize the control index.")
+	ci = GP64()
+	XORQ(ci, ci)
+
+	Comment("Initialize the data index. (len(data) + 3) >> 2")
+	di = GP64()
+	MOVQ(dataLen, di)
+	ADDQ(Imm(3), di)
+	SHRQ(Imm(2), di)
+
+	Comment("Initialize the input index.")
+	n = GP64()
+	XORQ(n, n)
+	return encoded, encodedLen, data, dataLen, ci, di, n
+}
+
+// broadcast returns a vector with v in each 32-bit lane
+func broadcast(v uint32) VecVirtual {
+	tmp, vec := GP32(), ZMM()
+	MOVL(U32(v), tmp)
+	VPBROADCASTD(tmp, vec)
+	return vec
+}
+
+// constants holds the vectors used by the AVX-512 encoder
+type constants struct {
+	one, byteIndex, replicate, packCodes VecVirtual
+	byteMax                              [3]VecVirtual
+}
+
+func loadConstants() constants {
+	c := constants{}
+	Comment("The constants used to compute the byte length codes.")
+	c.one = broadcast(1)
+	for i, max := range []uint32{0xFF, 0xFFFF, 0xFFFFFF} {
+		c.byteMax[i] = broadcast(max)
+	}
+	c.byteIndex = broadcast(0x03020100)
+	c.replicate = broadcast(0x01010101)
+	c.packCodes = broadcast(0x01041040)
+	return c
+}
+
+// vpcompressb emits VPCOMPRESSB with zeroing which packs the bytes of src
+// selected by mask contiguously in the low bytes of dst.
+func vpcompressb(src, mask, dst Op) {
+	Instruction(&ir.Instruction{
+		Opcode:   "VPCOMPRESSB",
+		Suffixes: []string{"Z"},
+		Operands: []Op{src, mask, dst},
+		Inputs:   []Op{src, mask},
+		Outputs:  []Op{dst},
+		ISA:      []string{"AVX512VBMI2"},
+	})
+}
+
+// encodeAVX512Uint32 writes the 4 control bytes and the data bytes of the 16 uint32 in dataBytes
+// and returns the count of data bytes written
+func encodeAVX512Uint32(c constants, dataBytes VecVirtual, encoded Mem, ci, di GPVirtual) GPVirtual {
+	Comment("Increment the byte length code for each byte width the value exceeds.")
+	codes := ZMM()
+	VPXORD(codes, codes, codes)
+	exceeds := K()
+	for _, max := range c.byteMax {
+		VPCMPUD(Imm(6), max, dataBytes, exceeds)
+		VPADDD(c.one, codes, exceeds, codes)
+	}
+
+	Comment("Select the bytes whose index within the value is at most the code.")
+	replicated := ZMM()
+	VPMULLD(c.replicate, codes, replicated)
+	keep := K()
+	VPCMPUB(Imm(2), replicated, c.byteIndex, keep)
+	byteCount := GP64()
+	KMOVQ(keep, byteCount)
+	POPCNTQ(byteCount, byteCount)
+
+	Comment("Pack the selected bytes and store 64 data bytes.")
+	packed := ZMM()
+	vpcompressb(dataBytes, keep, packed)
+	VMOVDQU64(packed, encoded.Idx(di, 1))
+
+	Comment("Pack the codes of each group of 4 values into a control byte.")
+	codesX := XMM()
+	VPMOVDB(codes, codesX)
+	VPMULLD(c.packCodes.AsX(), codesX, codesX)
+	VPSRLD(Imm(24), codesX, codesX)
+	VPMOVDB(codesX, codesX)
+
+	Comment("Store 4 control bytes.")
+	VMOVD(codesX, encoded.Idx(ci, 1))
+	ADDQ(Imm(4), ci)
+
+	return byteCount
+}
+
+// encodeScalarUint32 writes the data bytes of val and shifts the byte length
+// code into the pending control byte cb
+func encodeScalarUint32(val, cb, di GPVirtual, encoded Mem) {
+	Comment("Shift control byte to make room for the next code.")
+	SHRL(Imm(2), cb)
+
+	Comment("Switch on the number of bytes needed to hold the value.")
+	CMPL(val, U32(0xFF))
+	JBE(LabelRef("oneByte"))
+	CMPL(val, U32(0xFFFF))
+	JBE(LabelRef("twoByte"))
+	CMPL(val, U32(0xFFFFFF))
+	JBE(LabelRef("threeByte"))
+
+	Label("fourByte")
+	MOVL(val, encoded.Idx(di, 1)) // binary.LittleEndian.PutUint32(encoded[di:], val)
+	ADDQ(Imm(4), di)              // di += 4
+	ORL(U32(0b_11_00_00_00), cb)  // controlByte ^= 0b_11_00_00_00
+	JMP(LabelRef("nextValue"))
+
+	Label("threeByte")
+	MOVW(val.As16(), encoded.Idx(di, 1))          // binary.LittleEndian.PutUint16(encoded[di:], uint16(val))
+	SHRL(Imm(16), val)                            // val >>= 16
+	MOVB(val.As8(), encoded.Idx(di, 1).Offset(2)) // encoded[di+2] = byte(val)
+	ADDQ(Imm(3), di)                              // di += 3
+	ORL(U32(0b_10_00_00_00), cb)                  // controlByte ^= 0b_10_00_00_00
+	JMP(LabelRef("nextValue"))
+
+	Label("twoByte")
+	MOVW(val.As16(), encoded.Idx(di, 1)) // binary.LittleEndian.PutUint16(encoded[di:], uint16(val))
+	ADDQ(Imm(2), di)                     // di += 2
+	ORL(U32(0b_01_00_00_00), cb)         // controlByte ^= 0b_01_00_00_00
+	JMP(LabelRef("nextValue"))
+
+	Label("oneByte")
+	MOVB(val.As8(), encoded.Idx(di, 1)) // encoded[di] = byte(val)
+	INCQ(di)                            // di++
+
+	Label("nextValue")
+}
+
+// storeControlByte writes the pending control byte cb once 4 codes have been accumulated
+func storeControlByte(n, ci, cb GPVirtual, encoded Mem) {
+	Comment("Store the control byte if the block of 4 values is complete.")
+	TESTQ(U32(3), n)
+	JNE(LabelRef("scalar"))
+	MOVB(cb.As8(), encoded.Idx(ci, 1))
+	INCQ(ci)
+	XORL(cb, cb)
+	JMP(LabelRef("scalar"))
+}
+
+// finalControlByte shifts and writes the last partial control byte, if any.
+func finalControlByte(n, ci, cb GPVirtual, encoded Mem) {
+	Comment("Check if the last block was complete or the control byte needs to be shifted and written.")
+	TESTQ(U32(3), n)
+	JE(LabelRef("done"))
+
+	Label("pad")
+	SHRL(Imm(2), cb)
+	INCQ(n)
+	TESTQ(U32(3), n)
+	JNE(LabelRef("pad"))
+	MOVB(cb.As8(), encoded.Idx(ci, 1))
+
+	Label("done")
+}
+func zigzagEncodeScalar(val GPVirtual) {
+	Comment("Zigzag encode.")
+	tmp := GP32()
+	MOVL(val, tmp)
+	SARL(Imm(31), tmp)
+	SHLL(Imm(1), val)
+	XORL(tmp, val)
+}
+func zigzagEncodeSIMD(dataBytes VecVirtual) {
+	Comment("Zigzag encode.")
+	tmp := ZMM()
+	Comment("(x >> 31)")
+	VPSRAD(Imm(31), dataBytes, tmp)
+	Comment("(x << 1)")
+	VPSLLD(Imm(1), dataBytes, dataBytes)
+	Comment("(x << 1) ^ (x >> 31)")
+	VPXORD(tmp, dataBytes, dataBytes)
+}
+
+func deltaEncodeSIMD(dataBytes, previousZ VecVirtual) {
+	Comment("Calculate deltas.")
+	shifted := ZMM()
+	Comment("(previous_15, data_0, ..., data_14)")
+	VALIGND(Imm(15), previousZ, dataBytes, shifted)
+	Comment("Save the current values as previous for the next block.")
+	VMOVDQU64(dataBytes, previousZ)
+	Comment("(data_0 - previous_15, data_1 - data_0, ..., data_15 - data_14)")
+	VPSUBD(shifted, dataBytes, dataBytes)
+}
+
+func deltaEncodeScalar(val, previous GPVirtual) {
+	Comment("Calculate delta.")
+	tmp := GP32()
+	MOVL(val, tmp)
+	SUBL(previous, val)
+	MOVL(tmp, previous)
+}
+
+// encoder generates an AVX-512 encoding function with the given transforms applied to the input
+func encoder(name, signature, typ string, delta, zigzag bool) {
+	TEXT(name, NOSPLIT, signature)
+	Doc(name + " encodes 16 " + typ + " at a time using AVX-512 instructions (VPCOMPRESSB)")
+
+	encoded, encodedLen, data, dataLen, ci, di, n := preamble()
+	c := loadConstants()
+
+	var previousZ VecVirtual
+	if delta {
+		previousZ = ZMM()
+		VPBROADCASTD(Load(Param("previous"), GP32()), previousZ)
+	}
+
+	Label("avx512")
+	Comment("Check if less than 64 encoded bytes remain and jump to scalar.")
+	end := GP64()
+	LEAQ(Mem{Base: di, Disp: 64}, end)
+	CMPQ(end, encodedLen)
+	JGT(LabelRef("scalarStart"))
+	Comment("Check if less than 16 values remain and jump to scalar.")
+	LEAQ(Mem{Base: n, Disp: 16}, end)
+	CMPQ(end, dataLen)
+	JGT(LabelRef("scalarStart"))
+
+	Comment("Load 16 values.")
+	dataBytes := ZMM()
+	VMOVDQU32(data.Idx(n, 4), dataBytes)
+	if delta {
+		deltaEncodeSIMD(dataBytes, previousZ)
+	}
+	if zigzag {
+		zigzagEncodeSIMD(dataBytes)
+	}
+
+	bytecount := encodeAVX512Uint32(c, dataBytes, encoded, ci, di)
+
+	Comment("Increment the indices.")
+	ADDQ(Imm(16), n)
+	ADDQ(bytecount, di)
+
+	JMP(LabelRef("avx512"))
+
+	Label("scalarStart")
+	var previous GPVirtual
+	if delta {
+		Comment("Extract the last value of the SIMD loop as previous.")
+		VALIGND(Imm(15), previousZ, previousZ, previousZ)
+		previous = GP32()
+		VMOVD(previousZ.AsX(), previous)
+	}
+	cb := GP32()
+	XORL(cb, cb)
+
+	Label("scalar")
+	Comment("Process a single value at a time.")
+
+	CMPQ(n, dataLen)
+	JE(LabelRef("finish"))
+
+	val := GP32()
+	MOVL(data.Idx(n, 4), val) // val = data[i]
+	if delta {
+		deltaEncodeScalar(val, previous)
+	}
+	if zigzag {
+		zigzagEncodeScalar(val)
+	}
+
+	encodeScalarUint32(val, cb, di, encoded)
+	INCQ(n)
+	storeControlByte(n, ci, cb, encoded)
+
+	Label("finish")
+	finalControlByte(n, ci, cb, encoded)
+	VZEROUPPER()
+	Store(di, ReturnIndex(0))
+	RET()
+}
+
+func main() {
+	encoder("encodeUint32AVX512", "func (encoded []byte, data []uint32) int", "uint32", false, false)
+	encoder("encodeDeltaUint32AVX512", "func (encoded []byte, data []uint32, previous uint32) int", "uint32", true, false)
+	encoder("encodeInt32AVX512", "func (encoded []byte, data []int32) int", "int32", false, true)
+	encoder("encodeDeltaInt32AVX512", "func (encoded []byte, data []int32, previous int32) int", "int32", true, true)
+
+	Generate()
+}
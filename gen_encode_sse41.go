@@ -0,0 +1,367 @@
+//go:build ignore
+// +build ignore
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+import (
+	"encoding/binary"
+
+	. "github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+// tables holds references to the lookup tables and constants used by the encoders
+type tables struct {
+	dataByteCount, encodeByteMask, byteMax, packCodes Mem
+}
+
+// preamble loads the input data and returns variables referencing those values
+func preamble(t tables) (encoded Mem, encodedLen Register, data Mem, dataLen Register, dataTail GPVirtual, ci GPVirtual, di GPVirtual, n GPVirtual, byteCountPtr Mem, byteMaskPtr Mem) {
+	encoded = Mem{Base: Load(Param("encoded").Base(), GP64())}
+	encodedLen = Load(Param("encoded").Len(), GP64())
+	Comment("Revert to scalar processing if we are within 16 bytes of the end.")
+	SUBQ(Imm(16), encodedLen)
+
+	data = Mem{Base: Load(Param("data").Base(), GP64())}
+	dataLen = Load(Param("data").Len(), GP64())
+	dataTail = GP64()
+	Comment("Revert to scalar processing if we have less than 4 values to process.")
+	MOVQ(dataLen, dataTail)
+	SUBQ(Imm(4), dataTail)
+
+	Comment("Initialize the control index.")
+	ci = GP64()
+	XORQ(ci, ci)
+
+	Comment("Initialize the data index. (len(data) + 3) >> 2")
+	di = GP64()
+	MOVQ(dataLen, di)
+	ADDQ(Imm(3), di)
+	SHRQ(Imm(2), di)
+
+	Comment("Initialize the input index.")
+	n = GP64()
+	XORQ(n, n)
+
+	Comment("The byte count lookup table.")
+	byteCountPtr = Mem{Base: GP64()}
+	LEAQ(t.dataByteCount, byteCountPtr.Base)
+
+	Comment("The byte mask lookup table.")
+	byteMaskPtr = Mem{Base: GP64()}
+	LEAQ(t.encodeByteMask, byteMaskPtr.Base)
+	return encoded, encodedLen, data, dataLen, dataTail, ci, di, n, byteCountPtr, byteMaskPtr
+}
+
+// encodeSIMDUint32 writes the control byte and the data bytes of the 4 uint32 in dataBytes
+// and returns the count of data bytes written
+func encodeSIMDUint32(t tables, dataBytes VecVirtual, encoded Mem, ci, di GPVirtual, byteCountPtr, byteMaskPtr Mem) GPVirtual {
+	Comment("Start with a byte length code of 3 in each lane.")
+	codes := XMM()
+	PCMPEQL(codes, codes)
+	PSRLL(Imm(30), codes)
+
+	Comment("Decrement the code for each byte width the value fits in.")
+	fits := XMM()
+	for i := 0; i < 3; i++ {
+		MOVOU(dataBytes, fits)
+		PMINUD(t.byteMax.Offset(16*i), fits)
+		PCMPEQL(dataBytes, fits)
+		PADDL(fits, codes)
+	}
+
+	Comment("Gather the low byte of each code and pack into the control byte.")
+	PSHUFB(t.packCodes, codes)
+	cb := GP64()
+	MOVQ(codes, cb)
+	IMUL3L(U32(0x01041040), cb.As32(), cb.As32())
+	SHRL(Imm(24), cb.As32())
+
+	Comment("Store control byte.")
+	MOVB(cb.As8(), encoded.Idx(ci, 1))
+	INCQ(ci)
+
+	Comment("Lookup count to increment data index.")
+	byteCount := GP64()
+	MOVBQZX(byteCountPtr.Idx(cb, 1), byteCount)
+
+	Comment("Lookup the PSHUFB mask.")
+	SHLQ(Imm(4), cb)
+
+	Comment("Use mask to shuffle the relevant bytes into place.")
+	PSHUFB(byteMaskPtr.Idx(cb, 1), dataBytes)
+
+	Comment("Store 16 data bytes.")
+	MOVOU(dataBytes, encoded.Idx(di, 1))
+
+	return byteCount
+}
+
+// encodeScalarUint32 writes the data bytes of val and shifts the byte length
+// code into the pending control byte cb
+func encodeScalarUint32(val, cb, di GPVirtual, encoded Mem) {
+	Comment("Shift control byte to make room for the next code.")
+	SHRL(Imm(2), cb)
+
+	Comment("Switch on the number of bytes needed to hold the value.")
+	CMPL(val, U32(0xFF))
+	JBE(LabelRef("oneByte"))
+	CMPL(val, U32(0xFFFF))
+	JBE(LabelRef("twoByte"))
+	CMPL(val, U32(0xFFFFFF))
+	JBE(LabelRef("threeByte"))
+
+	Label("fourByte")
+	MOVL(val, encoded.Idx(di, 1)) // binary.LittleEndian.PutUint32(encoded[di:], val)
+	ADDQ(Imm(4), di)              // di += 4
+	ORL(U32(0b_11_00_00_00), cb)  // controlByte ^= 0b_11_00_00_00
+	JMP(LabelRef("nextValue"))
+
+	Label("threeByte")
+	MOVW(val.As16(), encoded.Idx(di, 1))          // binary.LittleEndian.PutUint16(encoded[di:], uint16(val))
+	SHRL(Imm(16), val)                            // val >>= 16
+	MOVB(val.As8(), encoded.Idx(di, 1).Offset(2)) // encoded[di+2] = byte(val)
+	ADDQ(Imm(3), di)                              // di += 3
+	ORL(U32(0b_10_00_00_00), cb)                  // controlByte ^= 0b_10_00_00_00
+	JMP(LabelRef("nextValue"))
+
+	Label("twoByte")
+	MOVW(val.As16(), encoded.Idx(di, 1)) // binary.LittleEndian.PutUint16(encoded[di:], uint16(val))
+	ADDQ(Imm(2), di)                     // di += 2
+	ORL(U32(0b_01_00_00_00), cb)         // controlByte ^= 0b_01_00_00_00
+	JMP(LabelRef("nextValue"))
+
+	Label("oneByte")
+	MOVB(val.As8(), encoded.Idx(di, 1)) // encoded[di] = byte(val)
+	INCQ(di)                            // di++
+
+	Label("nextValue")
+}
+
+// storeControlByte writes the pending control byte cb once 4 codes have been accumulated
+func storeControlByte(n, ci, cb GPVirtual, encoded Mem) {
+	Comment("Store the control byte if the block of 4 values is complete.")
+	TESTQ(U32(3), n)
+	JNE(LabelRef("scalar"))
+	MOVB(cb.As8(), encoded.Idx(ci, 1))
+	INCQ(ci)
+	XORL(cb, cb)
+	JMP(LabelRef("scalar"))
+}
+
+// finalControlByte shifts and writes the last partial control byte, if any.
+func finalControlByte(n, ci, cb GPVirtual, encoded Mem) {
+	Comment("Check if the last block was complete or the control byte needs to be shifted and written.")
+	TESTQ(U32(3), n)
+	JE(LabelRef("done"))
+
+	Label("pad")
+	SHRL(Imm(2), cb)
+	INCQ(n)
+	TESTQ(U32(3), n)
+	JNE(LabelRef("pad"))
+	MOVB(cb.As8(), encoded.Idx(ci, 1))
+
+	Label("done")
+}
+
+func zigzagEncodeScalar(val GPVirtual) {
+	Comment("Zigzag encode.")
+	tmp := GP32()
+	MOVL(val, tmp)
+	SARL(Imm(31), tmp)
+	SHLL(Imm(1), val)
+	XORL(tmp, val)
+}
+
+func zigzagEncodeSIMD(dataBytes VecVirtual) {
+	Comment("Zigzag encode.")
+	tmpX := XMM()
+	MOVOU(dataBytes, tmpX)
+	Comment("(x >> 31)")
+	PSRAL(Imm(31), tmpX)
+	Comment("(x << 1)")
+	PSLLL(Imm(1), dataBytes)
+	Comment("(x << 1) ^ (x >> 31)")
+	PXOR(tmpX, dataBytes)
+}
+
+func deltaEncodeSIMD(dataBytes, previousX VecVirtual) {
+	Comment("Calculate deltas.")
+	shifted := XMM()
+	MOVOU(dataBytes, shifted)
+	Comment("(previous_3, data_0, data_1, data_2)")
+	PALIGNR(Imm(12), previousX, shifted)
+	Comment("Save the current values as previous for the next block.")
+	MOVOU(dataBytes, previousX)
+	Comment("(data_0 - previous_3, data_1 - data_0, data_2 - data_1, data_3 - data_2)")
+	PSUBL(shifted, dataBytes)
+}
+
+func deltaEncodeScalar(val, previous GPVirtual) {
+	Comment("Calculate delta.")
+	tmp := GP32()
+	MOVL(val, tmp)
+	SUBL(previous, val)
+	MOVL(tmp, previous)
+}
+
+// encoder generates an SSE4.1 encoding function with the given transforms applied to the input
+func encoder(t tables, name, signature, typ string, delta, zigzag bool) {
+	TEXT(name, NOSPLIT, signature)
+	Doc(name + " encodes 4 " + typ + " at a time using SSE4.1 instructions (PMINUD, PSHUFB)")
+
+	encoded, encodedLen, data, dataLen, dataTail, ci, di, n, byteCountPtr, byteMaskPtr := preamble(t)
+
+	var previous GPVirtual
+	var previousX VecVirtual
+	if delta {
+		previous = GP32()
+		Load(Param("previous"), previous)
+		previousX = XMM()
+		MOVD(previous, previousX)
+		PSHUFD(Imm(0b_00_00_00_00), previousX, previousX)
+	}
+
+	Label("simd")
+	Comment("Check if less than 16 encoded bytes remain and jump to scalar.")
+	CMPQ(di, encodedLen)
+	JGT(LabelRef("scalarStart"))
+	Comment("Check if less than 4 values remain and jump to scalar.")
+	CMPQ(n, dataTail)
+	JGT(LabelRef("scalarStart"))
+
+	Comment("Load 4 values.")
+	dataBytes := XMM()
+	MOVOU(data.Idx(n, 4), dataBytes)
+	if delta {
+		deltaEncodeSIMD(dataBytes, previousX)
+	}
+	if zigzag {
+		zigzagEncodeSIMD(dataBytes)
+	}
+
+	bytecount := encodeSIMDUint32(t, dataBytes, encoded, ci, di, byteCountPtr, byteMaskPtr)
+
+	Comment("Increment the indices.")
+	ADDQ(Imm(4), n)
+	ADDQ(bytecount, di)
+
+	JMP(LabelRef("simd"))
+
+	Label("scalarStart")
+	if delta {
+		Comment("Extract the last value of the SIMD loop as previous.")
+		PSHUFD(Imm(0b_11_11_11_11), previousX, previousX)
+		MOVD(previousX, previous)
+	}
+	cb := GP32()
+	XORL(cb, cb)
+
+	Label("scalar")
+	Comment("Process a single value at a time.")
+
+	CMPQ(n, dataLen)
+	JE(LabelRef("finish"))
+
+	val := GP32()
+	MOVL(data.Idx(n, 4), val) // val = data[i]
+	if delta {
+		deltaEncodeScalar(val, previous)
+	}
+	if zigzag {
+		zigzagEncodeScalar(val)
+	}
+
+	encodeScalarUint32(val, cb, di, encoded)
+	INCQ(n)
+	storeControlByte(n, ci, cb, encoded)
+
+	Label("finish")
+	finalControlByte(n, ci, cb, encoded)
+	Store(di, ReturnIndex(0))
+	RET()
+}
+
+func main() {
+
+	// Lookup table of the count of data bytes (4 to 16) referenced by a control byte.
+	dataByteCount := GLOBL("dataByteCount", RODATA|NOPTR)
+	for i := 0; i < 256; i++ {
+		count := byte(i&3) + byte((i>>2)&3) + byte((i>>4)&3) + byte((i>>6)&3) + 4
+		DATA(i, U8(count))
+	}
+
+	// Lookup table of the PSHUFB mask referenced by a control byte to pack the
+	// relevant bytes of 4 uint32 contiguously.
+	encodeByteMask := GLOBL("encodeByteMask", RODATA|NOPTR)
+	for i := 0; i < 256; i++ {
+		curIndex, controlByte := 0, byte(i)
+		mask := [16]byte{}
+		for j := range mask {
+			mask[j] = 0xFF
+		}
+		for j := 0; j < 4; j++ {
+			byteCount := controlByte & 3
+			for k := 0; k <= int(byteCount); k++ {
+				mask[curIndex] = byte(4*j + k)
+				curIndex++
+			}
+			controlByte >>= 2
+		}
+		lowerHalf := binary.LittleEndian.Uint64(mask[0:8])
+		upperHalf := binary.LittleEndian.Uint64(mask[8:16])
+		DATA(16*i, U64(lowerHalf))
+		DATA(16*i+8, U64(upperHalf))
+	}
+
+	// The largest value that fits in 1, 2 and 3 bytes broadcast to each lane.
+	byteMax := GLOBL("byteMax", RODATA|NOPTR)
+	for i, max := range []uint32{0xFF, 0xFFFF, 0xFFFFFF} {
+		for j := 0; j < 4; j++ {
+			DATA(16*i+4*j, U32(max))
+		}
+	}
+
+	// PSHUFB mask to gather the low byte of each lane into the low 4 bytes.
+	packCodes := GLOBL("packCodes", RODATA|NOPTR)
+	DATA(0, U64(0xFFFFFFFF0C080400))
+	DATA(8, U64(0xFFFFFFFFFFFFFFFF))
+
+	t := tables{
+		dataByteCount:  dataByteCount,
+		encodeByteMask: encodeByteMask,
+		byteMax:        byteMax,
+		packCodes:      packCodes,
+	}
+
+	encoder(t, "encodeUint32SSE41", "func (encoded []byte, data []uint32) int", "uint32", false, false)
+	encoder(t, "encodeDeltaUint32SSE41", "func (encoded []byte, data []uint32, previous uint32) int", "uint32", true, false)
+	encoder(t, "encodeInt32SSE41", "func (encoded []byte, data []int32) int", "int32", false, true)
+	encoder(t, "encodeDeltaInt32SSE41", "func (encoded []byte, data []int32, previous int32) int", "int32", true, true)
+
+	Generate()
+}
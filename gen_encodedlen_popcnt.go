@@ -0,0 +1,77 @@
+//go:build ignore
+// +build ignore
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+import (
+	. "github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+)
+
+func main() {
+	TEXT("sumCodesPOPCNT", NOSPLIT, "func (control []byte) int")
+	Doc("sumCodesPOPCNT returns the sum of the 2-bit codes in control, 8 control bytes at a time using POPCNT, len(control) must be a multiple of 8")
+	{
+		control := Mem{Base: Load(Param("control").Base(), GP64())}
+		n := Load(Param("control").Len(), GP64())
+
+		Comment("Masks of the low and high bits of each code.")
+		lowMask, highMask := GP64(), GP64()
+		MOVQ(U64(0x5555555555555555), lowMask)
+		MOVQ(U64(0xAAAAAAAAAAAAAAAA), highMask)
+
+		sum := GP64()
+		XORQ(sum, sum)
+		i := GP64()
+		XORQ(i, i)
+
+		Label("loop")
+		CMPQ(i, n)
+		JGE(LabelRef("done"))
+
+		Comment("Load 8 control bytes.")
+		w := GP64()
+		MOVQ(control.Idx(i, 1), w)
+
+		Comment("The sum of the codes is popcount(low bits) + 2 * popcount(high bits).")
+		low, high := GP64(), GP64()
+		MOVQ(w, low)
+		ANDQ(lowMask, low)
+		ANDQ(highMask, w)
+		POPCNTQ(low, low)
+		POPCNTQ(w, high)
+		ADDQ(low, sum)
+		LEAQ(Mem{Base: sum, Index: high, Scale: 2}, sum)
+
+		ADDQ(Imm(8), i)
+		JMP(LabelRef("loop"))
+
+		Label("done")
+		Store(sum, ReturnIndex(0))
+		RET()
+	}
+
+	Generate()
+}
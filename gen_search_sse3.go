@@ -0,0 +1,313 @@
+//go:build ignore
+// +build ignore
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+import (
+	"encoding/binary"
+
+	. "github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+// decodeSIMDUint32 reads control byte and 4 uint32 from data bytes and returns the count of bytes read along with the dataBytes
+func decodeSIMDUint32(control, data Mem, ci, di GPVirtual, byteCountPtr, byteMaskptr Mem) (VecVirtual, GPVirtual) {
+	Comment("Load control byte.")
+	cb := GP64()
+	MOVBQZX(control.Idx(ci, 1), cb)
+	INCQ(ci)
+
+	Comment("Load 16 data bytes into XMM.")
+	dataBytes := XMM()
+	MOVOU(data.Idx(di, 1), dataBytes)
+
+	Comment("Lookup count to increment data index.")
+	byteCount := GP64()
+	MOVBQZX(byteCountPtr.Idx(cb, 1), byteCount)
+
+	Comment("Lookup the PSHUFB mask.")
+	SHLQ(Imm(4), cb)
+
+	Comment("Use mask to shuffle the relevant bytes into place.")
+	PSHUFB(byteMaskptr.Idx(cb, 1), dataBytes)
+
+	return dataBytes, byteCount
+}
+
+// decodeScalarUint32 reads control byte and returns the decoded uint32 value
+func decodeScalarUint32(n, ci, di GPVirtual, control, data Mem) (val GPVirtual) {
+	Comment("Determine if we need to load a new control byte.")
+	TESTQ(U32(3), n)
+	JNE(LabelRef("loadBytes"))
+
+	Comment("Load control byte.")
+	cb := GP64()
+	MOVBQZX(control.Idx(ci, 1), cb)
+	INCQ(ci)
+
+	Label("loadBytes")
+	Comment("Switch on the low two bits of the control byte.")
+	switchVal := GP64()
+	MOVQ(cb, switchVal)
+	ANDQ(Imm(3), switchVal)
+
+	JE(LabelRef("oneByte"))
+	CMPQ(switchVal, Imm(1))
+	JE(LabelRef("twoByte"))
+	CMPQ(switchVal, Imm(2))
+	JE(LabelRef("threeByte"))
+
+	val = GP32()
+
+	Label("fourByte")
+	MOVL(data.Idx(di, 1), val) // val = binary.LittleEndian.Uint32(data[di:])
+	ADDQ(Imm(4), di)           // di += 4
+	JMP(LabelRef("shiftControl"))
+
+	Label("threeByte")
+	hi := GP32()
+	MOVWLZX(data.Idx(di, 1), val)          // val = uint32(binary.LittleEndian.Uint16(data[di:]))
+	MOVBLZX(data.Idx(di, 1).Offset(2), hi) // hi = uint32(data[di+2])
+	SHLL(Imm(16), hi)                      // hi <<= 16
+	ORL(hi, val)                           // val = (hi | val)
+	ADDQ(Imm(3), di)                       // di +=3
+	JMP(LabelRef("shiftControl"))
+
+	Label("twoByte")
+	MOVWLZX(data.Idx(di, 1), val) // val = uint32(binary.LittleEndian.Uint16(data[di:]))
+	ADDQ(Imm(2), di)              // di += 2
+	JMP(LabelRef("shiftControl"))
+
+	Label("oneByte")
+	MOVBLZX(data.Idx(di, 1), val) // val = uint32(data[di])
+	INCQ(di)                      // di++
+
+	Label("shiftControl")
+	Comment("Shift control byte to get next value.")
+	SHRQ(Imm(2), cb)
+
+	return val
+}
+
+func prefixSumSIMD(dataBytes, previousX VecVirtual) {
+	shifted := XMM()
+	Comment("Calculate prefix sum.")
+	MOVOU(dataBytes, shifted)
+	Comment("(0, 0, delta_0, delta_1)")
+	PSLLDQ(Imm(8), shifted)
+	Comment("(delta_0, delta_1, delta_2 + delta_0, delta_3 + delta_1)")
+	PADDD(shifted, dataBytes)
+	MOVOU(dataBytes, shifted)
+	Comment("(0, delta_0, delta_1, delta_2 + delta_0)")
+	PSLLDQ(Imm(4), shifted)
+	Comment("(delta_0, delta_0 + delta_1, delta_0 + delta_1 + delta_2, delta_0 + delta_1 + delta_2 + delta_delta_3)")
+	PADDD(shifted, dataBytes)
+	Comment("Add the previous last decoded value to all lanes.")
+	PADDD(previousX, dataBytes)
+	Comment("Propagate last decoded value to all lanes of previous.")
+	PSHUFD(Imm(0b_11_11_11_11), dataBytes, previousX)
+}
+
+// searchDeltaUint32 emits the body of searchDeltaUint32SSE3, or with seek
+// of seekDeltaUint32SSE3 which stops at the start of the group of 4 values
+// holding the target instead of at the target.
+func searchDeltaUint32(dataByteCount, dataByteMask Mem, seek bool) {
+	control := Mem{Base: Load(Param("control").Base(), GP64())}
+	data := Mem{Base: Load(Param("data").Base(), GP64())}
+	dataLen := Load(Param("data").Len(), GP64())
+	Comment("Revert to scalar processing if we are within 16 bytes of the end.")
+	SUBQ(Imm(16), dataLen)
+
+	count := Load(Param("count"), GP64())
+	countTail := GP64()
+	Comment("Revert to scalar processing if we have less than 4 values to process.")
+	MOVQ(count, countTail)
+	SUBQ(Imm(4), countTail)
+
+	ci, di, n := GP64(), GP64(), GP64()
+	XORQ(ci, ci)
+	XORQ(di, di)
+	XORQ(n, n)
+
+	Comment("The byte count lookup table.")
+	byteCountPtr := Mem{Base: GP64()}
+	LEAQ(dataByteCount, byteCountPtr.Base)
+
+	Comment("The byte mask lookup table.")
+	byteMaskptr := Mem{Base: GP64()}
+	LEAQ(dataByteMask, byteMaskptr.Base)
+
+	previous := Load(Param("previous"), GP32())
+	previousX := XMM()
+	MOVD(previous, previousX)
+	PSHUFD(Imm(0), previousX, previousX)
+
+	Comment("Bias to compare unsigned values as signed.")
+	target := Load(Param("target"), GP32())
+	tmp := GP32()
+	biasX, targetX := XMM(), XMM()
+	MOVL(U32(0x80000000), tmp)
+	MOVD(tmp, biasX)
+	PSHUFD(Imm(0), biasX, biasX)
+	MOVD(target, targetX)
+	PSHUFD(Imm(0), targetX, targetX)
+	PXOR(biasX, targetX)
+
+	Label("simd")
+	Comment("Check if less than 16 encoded bytes remain and jump to scalar.")
+	CMPQ(di, dataLen)
+	JGT(LabelRef("scalar"))
+	Comment("Check if less than 4 values remain and jump to scalar.")
+	CMPQ(n, countTail)
+	JGT(LabelRef("scalar"))
+
+	var groupX VecVirtual
+	if seek {
+		Comment("Keep the value preceding the group.")
+		groupX = XMM()
+		MOVOU(previousX, groupX)
+	}
+	dataBytes, byteCount := decodeSIMDUint32(control, data, ci, di, byteCountPtr, byteMaskptr)
+	prefixSumSIMD(dataBytes, previousX)
+
+	Comment("Lanes where target > value.")
+	PXOR(biasX, dataBytes)
+	less := XMM()
+	MOVOU(targetX, less)
+	PCMPGTL(dataBytes, less)
+	mask := GP32()
+	MOVMSKPS(less, mask)
+	Comment("Stop at the first lane where value >= target.")
+	XORL(U32(0b_1111), mask)
+	JNE(LabelRef("found"))
+
+	Comment("Increment the indices.")
+	ADDQ(Imm(4), n)
+	ADDQ(byteCount, di)
+	JMP(LabelRef("simd"))
+
+	Label("found")
+	if seek {
+		MOVD(groupX, previous)
+		Store(n, ReturnIndex(0))
+		Store(di, ReturnIndex(1))
+		Store(previous, ReturnIndex(2))
+		RET()
+	} else {
+		lane := GP32()
+		BSFL(mask, lane)
+		ADDQ(lane.As64(), n)
+		JMP(LabelRef("done"))
+	}
+
+	Label("scalar")
+	MOVD(previousX, previous)
+	Comment("Process a single value at a time.")
+
+	Label("scalarLoop")
+	CMPQ(n, count)
+	JE(LabelRef("done"))
+
+	if seek {
+		Comment("Store the data offset and the value preceding each group.")
+		TESTQ(U32(3), n)
+		JNE(LabelRef("decode"))
+		Store(di, ReturnIndex(1))
+		Store(previous, ReturnIndex(2))
+		Label("decode")
+	}
+	val := decodeScalarUint32(n, ci, di, control, data)
+	Comment("Add the previous decoded value to the delta.")
+	ADDL(val, previous)
+	CMPL(previous, target)
+	if seek {
+		JAE(LabelRef("groupFound"))
+	} else {
+		JAE(LabelRef("done"))
+	}
+	INCQ(n)
+	JMP(LabelRef("scalarLoop"))
+
+	if seek {
+		Label("groupFound")
+		Comment("Return the start of the group holding the target.")
+		ANDQ(I8(-4), n)
+		Store(n, ReturnIndex(0))
+		RET()
+	}
+
+	Label("done")
+	Store(n, ReturnIndex(0))
+	if seek {
+		Store(di, ReturnIndex(1))
+		Store(previous, ReturnIndex(2))
+	}
+	RET()
+}
+
+func main() {
+
+	// Lookup table of the count of data bytes (4 to 16) referenced by a control byte.
+	dataByteCount := GLOBL("dataByteCount", RODATA|NOPTR)
+	for i := 0; i < 256; i++ {
+		count := byte(i&3) + byte((i>>2)&3) + byte((i>>4)&3) + byte((i>>6)&3) + 4
+		DATA(i, U8(count))
+	}
+
+	// Lookup table of the PSUFB mask referenced by a control byte to move data bytes
+	// into the correct location.
+	dataByteMask := GLOBL("dataByteMask", RODATA|NOPTR)
+	for i := 0; i < 256; i++ {
+		curIndex, controlByte := byte(0), byte(i)
+		mask := [16]byte{}
+		for j := 0; j < 4; j++ {
+			byteCount := controlByte & 3
+			for k := 0; k < 4; k++ {
+				if k <= int(byteCount) {
+					mask[4*j+k] = curIndex
+					curIndex++
+				} else {
+					mask[4*j+k] = 0xFF
+				}
+			}
+			controlByte >>= 2
+		}
+		lowerHalf := binary.LittleEndian.Uint64(mask[0:8])
+		upperHalf := binary.LittleEndian.Uint64(mask[8:16])
+		DATA(16*i, U64(lowerHalf))
+		DATA(16*i+8, U64(upperHalf))
+	}
+
+	TEXT("searchDeltaUint32SSE3", NOSPLIT, "func (control []byte, data []byte, count int, previous uint32, target uint32) int")
+	Doc("searchDeltaUint32SSE3 returns the index of the first of count delta encoded values >= target, or count if there is none, decoding 4 values at a time using SSE3 instructions (PSHUFB)")
+	searchDeltaUint32(dataByteCount, dataByteMask, false)
+
+	TEXT("seekDeltaUint32SSE3", NOSPLIT, "func (control []byte, data []byte, count int, previous uint32, target uint32) (n int, di int, last uint32)")
+	Doc("seekDeltaUint32SSE3 returns the index of the first group of 4 of count delta encoded values holding a value >= target, the offset of its data bytes and the value preceding it, or count and the end of the values if there is none, decoding 4 values at a time using SSE3 instructions (PSHUFB)")
+	searchDeltaUint32(dataByteCount, dataByteMask, true)
+
+	Generate()
+}
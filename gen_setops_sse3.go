@@ -0,0 +1,149 @@
+//go:build ignore
+// +build ignore
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+import (
+	"encoding/binary"
+
+	. "github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+)
+
+func main() {
+
+	// Lookup table of the number of set bits in a 4 bit comparison mask.
+	matchCount := GLOBL("matchCount", RODATA|NOPTR)
+	for i := 0; i < 16; i++ {
+		count := byte(i&1) + byte((i>>1)&1) + byte((i>>2)&1) + byte((i>>3)&1)
+		DATA(i, U8(count))
+	}
+
+	// Lookup table of the PSHUFB mask referenced by a 4 bit comparison mask to
+	// move the matching uint32 lanes to the front.
+	matchMask := GLOBL("matchMask", RODATA|NOPTR)
+	for i := 0; i < 16; i++ {
+		mask := [16]byte{}
+		for j := range mask {
+			mask[j] = 0xFF
+		}
+		curIndex := 0
+		for lane := 0; lane < 4; lane++ {
+			if i&(1<<lane) != 0 {
+				for k := 0; k < 4; k++ {
+					mask[4*curIndex+k] = byte(4*lane + k)
+				}
+				curIndex++
+			}
+		}
+		lowerHalf := binary.LittleEndian.Uint64(mask[0:8])
+		upperHalf := binary.LittleEndian.Uint64(mask[8:16])
+		DATA(16*i, U64(lowerHalf))
+		DATA(16*i+8, U64(upperHalf))
+	}
+
+	TEXT("intersectUint32SSE3", NOSPLIT, "func (dst []uint32, a []uint32, b []uint32) (k int, i int, j int)")
+	Doc("intersectUint32SSE3 writes the values common to the strictly increasing a and b to dst, comparing blocks of 4 values from each using SSE3 instructions (PSHUFB), and returns the number of values written along with the positions reached in a and b, the remaining values must be intersected by the caller.  Blocks are compared only while dst has room for 4 more values, so dst may be as short as the intersection.")
+	{
+		dst := Mem{Base: Load(Param("dst").Base(), GP64())}
+		a := Mem{Base: Load(Param("a").Base(), GP64())}
+		b := Mem{Base: Load(Param("b").Base(), GP64())}
+
+		Comment("Stop when fewer than 4 values remain in either a or b.")
+		aEnd := Load(Param("a").Len(), GP64())
+		SUBQ(Imm(4), aEnd)
+		bEnd := Load(Param("b").Len(), GP64())
+		SUBQ(Imm(4), bEnd)
+
+		k, i, j := GP64(), GP64(), GP64()
+		XORQ(k, k)
+		XORQ(i, i)
+		XORQ(j, j)
+
+		Comment("The match count lookup table.")
+		matchCountPtr := Mem{Base: GP64()}
+		LEAQ(matchCount, matchCountPtr.Base)
+
+		Comment("The match mask lookup table.")
+		matchMaskPtr := Mem{Base: GP64()}
+		LEAQ(matchMask, matchMaskPtr.Base)
+
+		va, vb, rotated, matches := XMM(), XMM(), XMM(), XMM()
+		mask, count, aMax, bMax := GP64(), GP64(), GP32(), GP32()
+
+		Label("blockLoop")
+		CMPQ(i, aEnd)
+		JG(LabelRef("done"))
+		CMPQ(j, bEnd)
+		JG(LabelRef("done"))
+		Comment("Stop when fewer than 4 values fit in dst, as all 4 lanes are stored.")
+		dstEnd := Load(Param("dst").Len(), GP64())
+		SUBQ(Imm(4), dstEnd)
+		CMPQ(k, dstEnd)
+		JG(LabelRef("done"))
+
+		Comment("Load 4 values from each of a and b.")
+		MOVOU(a.Idx(i, 4), va)
+		MOVOU(b.Idx(j, 4), vb)
+
+		Comment("Compare the values of a against every rotation of the values of b.")
+		MOVOU(va, matches)
+		PCMPEQL(vb, matches)
+		for _, rotation := range []uint64{0b00_11_10_01, 0b01_00_11_10, 0b10_01_00_11} {
+			PSHUFD(Imm(rotation), vb, rotated)
+			PCMPEQL(va, rotated)
+			POR(rotated, matches)
+		}
+
+		Comment("Move the matching values of a to the front and store them.")
+		MOVMSKPS(matches, mask.As32())
+		MOVBQZX(matchCountPtr.Idx(mask, 1), count)
+		SHLQ(Imm(4), mask)
+		PSHUFB(matchMaskPtr.Idx(mask, 1), va)
+		MOVOU(va, dst.Idx(k, 4))
+		ADDQ(count, k)
+
+		Comment("Advance past the block(s) with the smaller last value.")
+		MOVL(a.Idx(i, 4).Offset(12), aMax)
+		MOVL(b.Idx(j, 4).Offset(12), bMax)
+		CMPL(aMax, bMax)
+		JA(LabelRef("advanceB"))
+		ADDQ(Imm(4), i)
+		CMPL(aMax, bMax)
+		JB(LabelRef("blockLoop"))
+
+		Label("advanceB")
+		ADDQ(Imm(4), j)
+		JMP(LabelRef("blockLoop"))
+
+		Label("done")
+		Store(k, ReturnIndex(0))
+		Store(i, ReturnIndex(1))
+		Store(j, ReturnIndex(2))
+		RET()
+	}
+
+	Generate()
+}
@@ -1,5 +1,5 @@
 module github.com/bmkessler/streamvbyte
 
-go 1.13
+go 1.23
 
-require golang.org/x/sys v0.0.0-20200323222414-85ca7c5b95cd
+require golang.org/x/sys v0.0.0-20201119102817-f84b799fce68
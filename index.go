@@ -0,0 +1,206 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"encoding/binary"
+	"sort"
+)
+
+// Index is a sidecar for random access into Stream VByte encoded uint32.
+// Every Interval control bytes, i.e. every 4*Interval values, it records the
+// offset of the first data byte and, for delta encoded streams, the value
+// preceding that block, so that Get and DecodeRange only decode the blocks
+// they need.
+type Index struct {
+	// Interval is the number of control bytes between entries.
+	Interval int
+	// Count is the number of encoded values.
+	Count int
+	// Offsets holds the offset into the encoded data of the first data byte
+	// of each entry.
+	Offsets []int
+	// Previous holds the value preceding each entry for delta encoded
+	// streams, it is nil otherwise.
+	Previous []uint32
+
+	encoded []byte
+}
+
+// NewIndex returns an Index with an entry every interval control bytes over
+// count uint32 encoded with EncodeUint32.  interval must be positive.  The
+// Index does not record the values preceding each entry, use NewDeltaIndex
+// for streams encoded with EncodeDeltaUint32.
+func NewIndex(encoded []byte, count, interval int) *Index {
+	return &Index{
+		Interval: interval,
+		Count:    count,
+		Offsets:  indexOffsets(encoded, count, interval),
+		encoded:  encoded,
+	}
+}
+
+// NewDeltaIndex returns an Index with an entry every interval control bytes
+// over count uint32 encoded with EncodeDeltaUint32 with the initial value
+// previous.  The value preceding each entry is recovered by decoding the
+// stream once.  interval must be positive.
+func NewDeltaIndex(encoded []byte, count, interval int, previous uint32) *Index {
+	x := NewIndex(encoded, count, interval)
+	x.Previous = make([]uint32, len(x.Offsets))
+	d := NewDeltaDecoder(encoded, count, previous)
+	for k := range x.Previous {
+		if k > 0 {
+			d.Skip(4 * interval)
+		}
+		x.Previous[k] = d.previous
+	}
+	return x
+}
+
+// EncodeUint32Indexed encodes data like EncodeUint32 and returns the
+// encoded size along with an Index with an entry every interval control bytes.
+// interval must be positive.
+func EncodeUint32Indexed(encoded []byte, data []uint32, interval int) (int, *Index) {
+	size := EncodeUint32(encoded, data)
+	return size, NewIndex(encoded[:size], len(data), interval)
+}
+
+// EncodeDeltaUint32Indexed encodes data like EncodeDeltaUint32 and returns the
+// encoded size along with an Index with an entry every interval control bytes.
+// interval must be positive.
+func EncodeDeltaUint32Indexed(encoded []byte, data []uint32, previous uint32, interval int) (int, *Index) {
+	size := EncodeDeltaUint32(encoded, data, previous)
+	x := NewIndex(encoded[:size], len(data), interval)
+	x.Previous = make([]uint32, len(x.Offsets))
+	for k := range x.Previous {
+		if k > 0 {
+			previous = data[4*interval*k-1]
+		}
+		x.Previous[k] = previous
+	}
+	return size, x
+}
+
+// indexOffsets returns the data offset at every interval control bytes.
+func indexOffsets(encoded []byte, count, interval int) []int {
+	numControlBytes := (count + 3) >> 2
+	offsets := make([]int, 0, (numControlBytes+interval-1)/interval)
+	di := numControlBytes
+	for ci, controlByte := range encoded[:numControlBytes] {
+		if ci%interval == 0 {
+			offsets = append(offsets, di)
+		}
+		di += int(controlByteDataLen[controlByte])
+	}
+	return offsets
+}
+
+// SetEncoded points the Index at encoded, e.g. after the Index has been
+// stored and loaded separately from the encoded data it describes.
+func (x *Index) SetEncoded(encoded []byte) {
+	x.encoded = encoded
+}
+
+// Get returns the value at position i.
+func (x *Index) Get(i int) uint32 {
+	if i < 0 || i >= x.Count {
+		panic("streamvbyte: Get out of range")
+	}
+	var d Decoder
+	x.seek(&d, i)
+	v, _ := d.Next()
+	return v
+}
+
+// DecodeRange decodes the values at positions start through end-1 into dst
+// and returns the number of values decoded.  dst must hold at least
+// end-start values.  Values filling whole control bytes are decoded with the
+// bulk decoders, only the partial control bytes at either end one at a time.
+func (x *Index) DecodeRange(dst []uint32, start, end int) int {
+	if start < 0 || end > x.Count || start > end {
+		panic("streamvbyte: DecodeRange out of range")
+	}
+	if start == end {
+		return 0
+	}
+	var d Decoder
+	x.seek(&d, start)
+	return d.NextBatch(dst[:end-start])
+}
+
+// seek positions d at value i, starting from the entry preceding it.
+func (x *Index) seek(d *Decoder, i int) {
+	entry := i / (4 * x.Interval)
+	k := 4 * x.Interval * entry
+	di := x.Offsets[entry]
+	var previous uint32
+	delta := x.Previous != nil
+	if delta {
+		previous = x.Previous[entry]
+	} else {
+		// Skip whole control bytes preceding i.
+		for ; k+4 <= i; k += 4 {
+			di += int(controlByteDataLen[x.encoded[k>>2]])
+		}
+	}
+	for ; k < i; k++ {
+		code := (x.encoded[k>>2] >> (2 * uint(k&3))) & 3
+		if delta {
+			var v uint32
+			switch code {
+			case 0:
+				v = uint32(x.encoded[di])
+			case 1:
+				v = uint32(binary.LittleEndian.Uint16(x.encoded[di:]))
+			case 2:
+				v = uint32(x.encoded[di+2])<<16 | uint32(binary.LittleEndian.Uint16(x.encoded[di:]))
+			default:
+				v = binary.LittleEndian.Uint32(x.encoded[di:])
+			}
+			previous += v
+		}
+		di += int(code) + 1
+	}
+	*d = Decoder{encoded: x.encoded, count: x.Count, i: k, di: di, delta: delta, previous: previous}
+}
+
+// Search returns the index of the first value >= target, or Count if there
+// is none.  The Index must describe nondecreasing values, as returned by
+// EncodeDeltaUint32Indexed or NewDeltaIndex.  Search binary searches the
+// entries for the block holding the target and only decodes from there.
+func (x *Index) Search(target uint32) int {
+	if x.Previous == nil {
+		panic("streamvbyte: Search requires an Index of a delta encoded stream, see NewDeltaIndex")
+	}
+	if x.Count == 0 {
+		return 0
+	}
+	// The value preceding entry k is Previous[k], so the first entry preceded
+	// by a value >= target ends with that value and the result is in the
+	// entry before it.
+	entry := sort.Search(len(x.Previous)-1, func(k int) bool { return x.Previous[k+1] >= target })
+	start := 4 * x.Interval * entry
+	ci := x.Interval * entry
+	i := searchDeltaUint32(x.encoded[ci:], x.encoded[x.Offsets[entry]:], x.Count-start, x.Previous[entry], target)
+	return start + i
+}
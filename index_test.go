@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func testIndex(t *testing.T, x *Index, data []uint32, r *rand.Rand) {
+	for i := range data {
+		if v := x.Get(i); v != data[i] {
+			t.Fatalf("interval %d: got Get(%d): %d, expected: %d", x.Interval, i, v, data[i])
+		}
+	}
+	dst := make([]uint32, len(data))
+	for k := 0; k < 20; k++ {
+		start := r.Intn(len(data) + 1)
+		end := start + r.Intn(len(data)-start+1)
+		if n := x.DecodeRange(dst, start, end); n != end-start {
+			t.Fatalf("got DecodeRange(%d, %d): %d, expected: %d", start, end, n, end-start)
+		}
+		for i := start; i < end; i++ {
+			if dst[i-start] != data[i] {
+				t.Fatalf("got DecodeRange(%d, %d)[%d]: %d, expected: %d", start, end, i-start, dst[i-start], data[i])
+			}
+		}
+	}
+}
+
+func TestIndex(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		for _, interval := range []int{1, 2, 3, 16} {
+			data := makeRandomUint32(r, size)
+			encoded := make([]byte, MaxSize32(size))
+			_, x := EncodeUint32Indexed(encoded, data, interval)
+			if x.Previous != nil {
+				t.Errorf("got non-nil Previous for a non-delta stream")
+			}
+			testIndex(t, x, data, r)
+
+			data = makeRandomDeltaUint32(r, size, 7)
+			_, x = EncodeDeltaUint32Indexed(encoded, data, 7, interval)
+			testIndex(t, x, data, r)
+		}
+	}
+}
+
+func TestNewDeltaIndex(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		for _, interval := range []int{1, 2, 3, 16} {
+			data := makeRandomDeltaUint32(r, size, 7)
+			encoded := make([]byte, MaxSize32(size))
+			n, expected := EncodeDeltaUint32Indexed(encoded, data, 7, interval)
+			x := NewDeltaIndex(encoded[:n], size, interval, 7)
+			if !reflect.DeepEqual(x.Previous, expected.Previous) {
+				t.Fatalf("size %d interval %d: got Previous %v, expected: %v", size, interval, x.Previous, expected.Previous)
+			}
+			testIndex(t, x, data, r)
+		}
+	}
+}
+
+func TestIndexSetEncoded(t *testing.T) {
+	data := benchUint32Data[:1000]
+	encoded := make([]byte, MaxSize32(len(data)))
+	size := EncodeUint32(encoded, data)
+	x := NewIndex(encoded[:size], len(data), 8)
+	stored := &Index{Interval: x.Interval, Count: x.Count, Offsets: x.Offsets}
+	stored.SetEncoded(append([]byte(nil), encoded[:size]...))
+	testIndex(t, stored, data, rand.New(rand.NewSource(42)))
+}
+
+func TestIndexOutOfRange(t *testing.T) {
+	data := []uint32{1, 2, 3}
+	encoded := make([]byte, MaxSize32(len(data)))
+	_, x := EncodeUint32Indexed(encoded, data, 1)
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic for Get past the end")
+		}
+	}()
+	x.Get(3)
+}
+
+func BenchmarkIndexGet(b *testing.B) {
+	_, x := EncodeDeltaUint32Indexed(benchEncoded, benchUint32DataSorted, 0, 16)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Get(i % benchSize)
+	}
+}
+
+func BenchmarkIndexDecodeRange(b *testing.B) {
+	_, x := EncodeDeltaUint32Indexed(benchEncoded, benchUint32DataSorted, 0, 16)
+	dst := make([]uint32, 4096)
+	b.SetBytes(int64(4 * len(dst)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		start := (i * 4099) % (benchSize - len(dst))
+		x.DecodeRange(dst, start, start+len(dst))
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"iter"
+)
+
+// iterChunkSize is the number of values the iterators decode at a time.
+const iterChunkSize = 256
+
+// ValuesUint32 returns an iterator over the count uint32 encoded by
+// EncodeUint32.  encoded must contain at least count encoded uint32.
+func ValuesUint32(encoded []byte, count int) iter.Seq[uint32] {
+	return values[uint32](func() *Decoder { return NewDecoder(encoded, count) })
+}
+
+// ValuesDeltaUint32 returns an iterator over the count uint32 encoded by
+// EncodeDeltaUint32 with the initial value previous.  encoded must contain at
+// least count encoded uint32.
+func ValuesDeltaUint32(encoded []byte, count int, previous uint32) iter.Seq[uint32] {
+	return values[uint32](func() *Decoder { return NewDeltaDecoder(encoded, count, previous) })
+}
+
+// ValuesInt32 returns an iterator over the count int32 encoded by
+// EncodeInt32.  encoded must contain at least count encoded int32.
+func ValuesInt32(encoded []byte, count int) iter.Seq[int32] {
+	return values[int32](func() *Decoder { return NewZigzagDecoder(encoded, count) })
+}
+
+// ValuesDeltaInt32 returns an iterator over the count int32 encoded by
+// EncodeDeltaInt32 with the initial value previous.  encoded must contain at
+// least count encoded int32.
+func ValuesDeltaInt32(encoded []byte, count int, previous int32) iter.Seq[int32] {
+	return values[int32](func() *Decoder { return NewDeltaZigzagDecoder(encoded, count, previous) })
+}
+
+// AllUint32 returns an iterator over the indices and values of the count
+// uint32 encoded by EncodeUint32.  encoded must contain at least count
+// encoded uint32.
+func AllUint32(encoded []byte, count int) iter.Seq2[int, uint32] {
+	return all[uint32](func() *Decoder { return NewDecoder(encoded, count) })
+}
+
+// AllDeltaUint32 returns an iterator over the indices and values of the count
+// uint32 encoded by EncodeDeltaUint32 with the initial value previous.
+// encoded must contain at least count encoded uint32.
+func AllDeltaUint32(encoded []byte, count int, previous uint32) iter.Seq2[int, uint32] {
+	return all[uint32](func() *Decoder { return NewDeltaDecoder(encoded, count, previous) })
+}
+
+// AllInt32 returns an iterator over the indices and values of the count int32
+// encoded by EncodeInt32.  encoded must contain at least count encoded int32.
+func AllInt32(encoded []byte, count int) iter.Seq2[int, int32] {
+	return all[int32](func() *Decoder { return NewZigzagDecoder(encoded, count) })
+}
+
+// AllDeltaInt32 returns an iterator over the indices and values of the count
+// int32 encoded by EncodeDeltaInt32 with the initial value previous.  encoded
+// must contain at least count encoded int32.
+func AllDeltaInt32(encoded []byte, count int, previous int32) iter.Seq2[int, int32] {
+	return all[int32](func() *Decoder { return NewDeltaZigzagDecoder(encoded, count, previous) })
+}
+
+// values returns an iterator decoding iterChunkSize values at a time with a
+// new Decoder for each iteration.
+func values[T uint32 | int32](newDecoder func() *Decoder) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		d := newDecoder()
+		var chunk [iterChunkSize]uint32
+		for n := d.NextBatch(chunk[:]); n > 0; n = d.NextBatch(chunk[:]) {
+			for _, v := range chunk[:n] {
+				if !yield(T(v)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// all is values along with the index of each value.
+func all[T uint32 | int32](newDecoder func() *Decoder) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range values[T](newDecoder) {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestIterators(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range append(testSizes, 3*iterChunkSize+5) {
+		data := makeRandomUint32(r, size)
+		int32Data := make([]int32, size)
+		for i, v := range data {
+			int32Data[i] = int32(v)
+		}
+		encoded := make([]byte, MaxSize32(size))
+
+		EncodeUint32(encoded, data)
+		if got := slices.Collect(ValuesUint32(encoded, size)); !slices.Equal(got, data) {
+			t.Errorf("size %d: ValuesUint32 got %d values", size, len(got))
+		}
+		for i, v := range AllUint32(encoded, size) {
+			if v != data[i] {
+				t.Fatalf("size %d: AllUint32 got %d at %d, expected: %d", size, v, i, data[i])
+			}
+		}
+
+		EncodeDeltaUint32(encoded, data, 7)
+		if got := slices.Collect(ValuesDeltaUint32(encoded, size, 7)); !slices.Equal(got, data) {
+			t.Errorf("size %d: ValuesDeltaUint32 got %d values", size, len(got))
+		}
+		for i, v := range AllDeltaUint32(encoded, size, 7) {
+			if v != data[i] {
+				t.Fatalf("size %d: AllDeltaUint32 got %d at %d, expected: %d", size, v, i, data[i])
+			}
+		}
+
+		EncodeInt32(encoded, int32Data)
+		if got := slices.Collect(ValuesInt32(encoded, size)); !slices.Equal(got, int32Data) {
+			t.Errorf("size %d: ValuesInt32 got %d values", size, len(got))
+		}
+		for i, v := range AllInt32(encoded, size) {
+			if v != int32Data[i] {
+				t.Fatalf("size %d: AllInt32 got %d at %d, expected: %d", size, v, i, int32Data[i])
+			}
+		}
+
+		EncodeDeltaInt32(encoded, int32Data, -7)
+		if got := slices.Collect(ValuesDeltaInt32(encoded, size, -7)); !slices.Equal(got, int32Data) {
+			t.Errorf("size %d: ValuesDeltaInt32 got %d values", size, len(got))
+		}
+		n := 0
+		for i, v := range AllDeltaInt32(encoded, size, -7) {
+			if v != int32Data[i] {
+				t.Fatalf("size %d: AllDeltaInt32 got %d at %d, expected: %d", size, v, i, int32Data[i])
+			}
+			n++
+		}
+		if n != size {
+			t.Errorf("size %d: AllDeltaInt32 got %d values", size, n)
+		}
+	}
+}
+
+func TestIteratorsBreak(t *testing.T) {
+	data := []uint32{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	encoded := make([]byte, MaxSize32(len(data)))
+	EncodeUint32(encoded, data)
+	seq := ValuesUint32(encoded, len(data))
+	for range 2 {
+		var got []uint32
+		for v := range seq {
+			if v > 5 {
+				break
+			}
+			got = append(got, v)
+		}
+		if !slices.Equal(got, data[:5]) {
+			t.Errorf("got %v before break, expected: %v", got, data[:5])
+		}
+	}
+}
+
+func BenchmarkValuesUint32(b *testing.B) {
+	benchEncodedSize = EncodeUint32(benchEncoded, benchUint32Data)
+	b.SetBytes(int64(4 * benchSize))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var sum uint32
+		for v := range ValuesUint32(benchEncoded, benchSize) {
+			sum += v
+		}
+	}
+}
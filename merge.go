@@ -0,0 +1,222 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+// mergeBlockSize is the number of values MergeDeltaUint32 decodes from each
+// list, and encodes to the output, at a time.  It must be a multiple of 4.
+const mergeBlockSize = 256
+
+// MergeDeltaUint32 merges the nondecreasing values of lists into encoded with
+// delta encoding and the initial value 0 and returns the resulting DeltaList.
+// If unique is true repeated values are written only once.  The lists are
+// decoded incrementally, mergeBlockSize values at a time, so memory use beyond
+// encoded is bounded by the number of lists rather than their lengths.
+// encoded must hold MaxSize32 of the sum of the Counts of lists.
+func MergeDeltaUint32(encoded []byte, unique bool, lists ...DeltaList) DeltaList {
+	total := 0
+	h := make(cursorHeap, 0, len(lists))
+	for _, l := range lists {
+		total += l.Count
+		if l.Count > 0 {
+			c := newDeltaCursor(l, mergeBlockSize)
+			c.fill()
+			h = append(h, c)
+		}
+	}
+	for i := len(h)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	w := newMergeWriter(encoded, total)
+	for len(h) > 0 {
+		c := h[0]
+		// Drain the smallest list while it stays below the others.
+		next := ^uint32(0)
+		if len(h) > 1 {
+			next = h[1].values[0]
+			if len(h) > 2 && h[2].values[0] < next {
+				next = h[2].values[0]
+			}
+		}
+		for len(c.values) > 0 && c.values[0] <= next {
+			v := c.values[0]
+			c.values = c.values[1:]
+			if !unique || w.count == 0 || v != w.last {
+				w.add(v)
+			}
+		}
+		if len(c.values) == 0 && !c.fill() {
+			h[0] = h[len(h)-1]
+			h = h[:len(h)-1]
+		}
+		h.down(0)
+	}
+	return w.finish()
+}
+
+// deltaCursor decodes a DeltaList a block of values at a time, values holds
+// the decoded values not yet consumed.
+type deltaCursor struct {
+	control   []byte // control bytes of the values not yet decoded
+	data      []byte // data bytes of the values not yet decoded
+	remaining int    // number of values not yet decoded
+	previous  uint32 // last value decoded
+	values    []uint32
+	buf       []uint32
+	scratch   []byte
+}
+
+// newDeltaCursor returns a deltaCursor decoding blockSize values of l at a
+// time, blockSize must be a multiple of 4.  No values are decoded until fill
+// or seek is called.
+func newDeltaCursor(l DeltaList, blockSize int) *deltaCursor {
+	numControlBytes := (l.Count + 3) >> 2
+	return &deltaCursor{
+		control:   l.Encoded[:numControlBytes],
+		data:      l.Encoded[numControlBytes:],
+		remaining: l.Count,
+		previous:  l.Previous,
+		buf:       make([]uint32, blockSize),
+		scratch:   make([]byte, MaxSize32(blockSize)),
+	}
+}
+
+// fill decodes the next block of values, it reports false at the end of the
+// list.  The control and data bytes of the block are gathered into scratch
+// since the decoders expect the control bytes to precede the data bytes.
+func (c *deltaCursor) fill() bool {
+	n := c.remaining
+	if n == 0 {
+		c.values = nil
+		return false
+	}
+	if n > len(c.buf) {
+		n = len(c.buf)
+	}
+	numControlBytes := (n + 3) >> 2
+	size := EncodedLen(n, c.control)
+	copy(c.scratch, c.control[:numControlBytes])
+	copy(c.scratch[numControlBytes:], c.data[:size-numControlBytes])
+	c.values = c.buf[:n]
+	DecodeDeltaUint32(c.values, c.scratch[:size], c.previous)
+	c.previous = c.values[n-1]
+	c.control = c.control[numControlBytes:]
+	c.data = c.data[size-numControlBytes:]
+	c.remaining -= n
+	return true
+}
+
+// seek consumes the values below target and reports whether a value >= target
+// remains.  The groups of 4 values preceding the one holding the target are
+// stepped over by seekDeltaUint32 without being written out, only the blocks
+// from that group on are decoded.
+func (c *deltaCursor) seek(target uint32) bool {
+	if len(c.values) > 0 && c.values[len(c.values)-1] >= target {
+		c.values = c.values[gallop(c.values, target):]
+		return true
+	}
+	n, di, previous := seekDeltaUint32(c.control, c.data, c.remaining, c.previous, target)
+	c.control = c.control[n>>2:]
+	c.data = c.data[di:]
+	c.remaining -= n
+	c.previous = previous
+	for c.fill() {
+		if i := gallop(c.values, target); i < len(c.values) {
+			c.values = c.values[i:]
+			return true
+		}
+	}
+	return false
+}
+
+// cursorHeap is a binary min-heap of cursors ordered by their next value.
+type cursorHeap []*deltaCursor
+
+// down restores the heap order below i after the next value of h[i] grew.
+func (h cursorHeap) down(i int) {
+	for {
+		least := i
+		if l := 2*i + 1; l < len(h) && h[l].values[0] < h[least].values[0] {
+			least = l
+		}
+		if r := 2*i + 2; r < len(h) && h[r].values[0] < h[least].values[0] {
+			least = r
+		}
+		if least == i {
+			return
+		}
+		h[i], h[least] = h[least], h[i]
+		i = least
+	}
+}
+
+// mergeWriter delta encodes values into encoded a block at a time, reserving
+// control bytes for up to total values and moving the data bytes down once
+// the final count is known.
+type mergeWriter struct {
+	encoded  []byte
+	reserved int // number of control bytes reserved
+	ci, di   int // offsets of the next control and data bytes
+	values   []uint32
+	previous uint32 // last value encoded
+	last     uint32 // last value added
+	count    int
+	scratch  []byte
+}
+
+func newMergeWriter(encoded []byte, total int) *mergeWriter {
+	reserved := (total + 3) >> 2
+	return &mergeWriter{
+		encoded:  encoded,
+		reserved: reserved,
+		di:       reserved,
+		values:   make([]uint32, 0, mergeBlockSize),
+		scratch:  make([]byte, MaxSize32(mergeBlockSize)),
+	}
+}
+
+func (w *mergeWriter) add(v uint32) {
+	w.values = append(w.values, v)
+	w.last = v
+	w.count++
+	if len(w.values) == mergeBlockSize {
+		w.flush()
+	}
+}
+
+func (w *mergeWriter) flush() {
+	if len(w.values) == 0 {
+		return
+	}
+	size := EncodeDeltaUint32(w.scratch, w.values, w.previous)
+	numControlBytes := (len(w.values) + 3) >> 2
+	w.ci += copy(w.encoded[w.ci:], w.scratch[:numControlBytes])
+	w.di += copy(w.encoded[w.di:], w.scratch[numControlBytes:size])
+	w.previous = w.values[len(w.values)-1]
+	w.values = w.values[:0]
+}
+
+func (w *mergeWriter) finish() DeltaList {
+	w.flush()
+	size := w.ci + copy(w.encoded[w.ci:], w.encoded[w.reserved:w.di])
+	return DeltaList{Encoded: w.encoded[:size], Count: w.count}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMergeDeltaUint32(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, sizes := range [][]int{
+		{},
+		{0},
+		{1},
+		{0, 0, 5},
+		{1000},
+		{255, 256, 257},
+		{3, 1000, 7, 10000, 1},
+		{100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100},
+	} {
+		for _, universe := range []uint32{16, 100000, 1 << 31} {
+			var lists []DeltaList
+			all := []uint32{}
+			for _, size := range sizes {
+				data, l := makePostingList(r, size, universe)
+				lists = append(lists, l)
+				all = append(all, data...)
+			}
+			sort.Slice(all, func(i, j int) bool { return all[i] < all[j] })
+			for _, unique := range []bool{false, true} {
+				expected := all
+				if unique {
+					expected = uniqueUint32(append([]uint32{}, all...))
+				}
+				encoded := make([]byte, MaxSize32(len(all)))
+				merged := MergeDeltaUint32(encoded, unique, lists...)
+				got := make([]uint32, merged.Count)
+				if n := DecodeDeltaUint32(got, merged.Encoded, merged.Previous); n != len(merged.Encoded) {
+					t.Errorf("sizes %v unique %t: got encoded size %d, decoded %d", sizes, unique, len(merged.Encoded), n)
+				}
+				if !reflect.DeepEqual(got, expected) {
+					t.Errorf("sizes %v unique %t: got %d merged values, expected %d", sizes, unique, len(got), len(expected))
+				}
+				if !unique && len(merged.Encoded) != EncodedLen(len(all), merged.Encoded) {
+					t.Errorf("sizes %v: got inconsistent encoded size %d", sizes, len(merged.Encoded))
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkMergeDeltaUint32(b *testing.B) {
+	r := rand.New(rand.NewSource(42))
+	lists := make([]DeltaList, 32)
+	for i := range lists {
+		_, lists[i] = makePostingList(r, benchSize/len(lists), 4*benchSize)
+	}
+	encoded := make([]byte, MaxSize32(benchSize))
+	b.SetBytes(int64(4 * benchSize))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MergeDeltaUint32(encoded, false, lists...)
+	}
+}
@@ -0,0 +1,257 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"context"
+	"encoding/binary"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// The chunked format produced by EncodeParallel splits the values into
+// chunks encoded independently so that they can be decoded concurrently,
+// laid out as
+//
+//	magic     [4]byte "SVBC"
+//	flags     byte, FlagDelta or 0
+//	count     uvarint
+//	chunkSize uvarint, a multiple of 4
+//	table     per chunk the encoded size as a little endian uint32, followed
+//	          with FlagDelta by the value preceding the chunk as a little
+//	          endian uint32
+//	chunks    the Stream VByte encoded chunks
+//
+// All chunks hold chunkSize values except the last which holds the rest.
+const chunkedMagic = "SVBC"
+
+// DefaultChunkSize is the number of values per chunk used by EncodeParallel
+// when ParallelOptions.ChunkSize is zero.
+const DefaultChunkSize = 1 << 16
+
+// maxChunkSize keeps the encoded size of a chunk within a uint32.
+const maxChunkSize = 1 << 28
+
+// ParallelOptions configures EncodeParallel, EncodeDeltaParallel and
+// DecodeParallel.
+type ParallelOptions struct {
+	// Workers is the number of goroutines, runtime.GOMAXPROCS(0) if zero.
+	Workers int
+	// ChunkSize is the number of values per chunk when encoding,
+	// DefaultChunkSize if zero.  It is rounded up to a multiple of 4.
+	ChunkSize int
+}
+
+func (o ParallelOptions) workers() int {
+	if o.Workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return o.Workers
+}
+
+func (o ParallelOptions) chunkSize() int {
+	switch {
+	case o.ChunkSize <= 0:
+		return DefaultChunkSize
+	case o.ChunkSize > maxChunkSize:
+		return maxChunkSize
+	}
+	return (o.ChunkSize + 3) &^ 3
+}
+
+// EncodeParallel encodes data like EncodeUint32 into the chunked format,
+// encoding the chunks concurrently.  It returns ctx.Err() if ctx is done
+// before all chunks are encoded.
+func EncodeParallel(ctx context.Context, data []uint32, opts ParallelOptions) ([]byte, error) {
+	return encodeParallel(ctx, data, false, 0, opts)
+}
+
+// EncodeDeltaParallel encodes data like EncodeDeltaUint32 with the initial
+// value previous into the chunked format, encoding the chunks concurrently.
+// Each chunk is delta encoded from the value preceding it, which is recorded
+// in the chunk table.  It returns ctx.Err() if ctx is done before all chunks
+// are encoded.
+func EncodeDeltaParallel(ctx context.Context, data []uint32, previous uint32, opts ParallelOptions) ([]byte, error) {
+	return encodeParallel(ctx, data, true, previous, opts)
+}
+
+func encodeParallel(ctx context.Context, data []uint32, delta bool, previous uint32, opts ParallelOptions) ([]byte, error) {
+	chunkSize := opts.chunkSize()
+	numChunks := (len(data) + chunkSize - 1) / chunkSize
+	entrySize := 4
+	flags := Flags(0)
+	if delta {
+		entrySize = 8
+		flags = FlagDelta
+	}
+
+	var buf [binary.MaxVarintLen64]byte
+	b := append([]byte(nil), chunkedMagic...)
+	b = append(b, byte(flags))
+	b = append(b, buf[:binary.PutUvarint(buf[:], uint64(len(data)))]...)
+	b = append(b, buf[:binary.PutUvarint(buf[:], uint64(chunkSize))]...)
+	table := len(b)
+	start := table + entrySize*numChunks
+
+	// Encode each chunk at its worst case offset, then move the chunks down
+	// to their final offsets in order.
+	maxChunk := MaxSize32(chunkSize)
+	out := make([]byte, start+numChunks*maxChunk)
+	copy(out, b)
+	sizes := make([]int, numChunks)
+	err := parallelFor(ctx, numChunks, opts.workers(), func(k int) error {
+		chunk := data[k*chunkSize : min((k+1)*chunkSize, len(data))]
+		encoded := out[start+k*maxChunk:]
+		entry := out[table+entrySize*k:]
+		if delta {
+			p := previous
+			if k > 0 {
+				p = data[k*chunkSize-1]
+			}
+			binary.LittleEndian.PutUint32(entry[4:], p)
+			sizes[k] = EncodeDeltaUint32(encoded, chunk, p)
+		} else {
+			sizes[k] = EncodeUint32(encoded, chunk)
+		}
+		binary.LittleEndian.PutUint32(entry, uint32(sizes[k]))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	end := start
+	for k, size := range sizes {
+		end += copy(out[end:], out[start+k*maxChunk:start+k*maxChunk+size])
+	}
+	return out[:end], nil
+}
+
+// DecodeParallel decodes the values held in the chunked format b, which may
+// have been created by EncodeParallel or EncodeDeltaParallel, decoding the
+// chunks concurrently.  It returns ctx.Err() if ctx is done before all chunks
+// are decoded and ErrInvalidHeader, ErrTruncated or ErrCorrupt if b is
+// malformed.
+func DecodeParallel(ctx context.Context, b []byte, opts ParallelOptions) ([]uint32, error) {
+	if len(b) < len(chunkedMagic)+1 || string(b[:len(chunkedMagic)]) != chunkedMagic {
+		return nil, ErrInvalidHeader
+	}
+	flags := Flags(b[len(chunkedMagic)])
+	if flags&^FlagDelta != 0 {
+		return nil, ErrInvalidHeader
+	}
+	i := len(chunkedMagic) + 1
+	count, n := binary.Uvarint(b[i:])
+	if n <= 0 {
+		return nil, ErrInvalidHeader
+	}
+	i += n
+	chunkSize, n := binary.Uvarint(b[i:])
+	if n <= 0 || chunkSize == 0 || chunkSize > maxChunkSize || chunkSize&3 != 0 {
+		return nil, ErrInvalidHeader
+	}
+	i += n
+	// Every value takes at least one data byte, so check before allocating.
+	if count > uint64(len(b)) {
+		return nil, ErrTruncated
+	}
+	numChunks := (int(count) + int(chunkSize) - 1) / int(chunkSize)
+	entrySize := 4
+	if flags&FlagDelta != 0 {
+		entrySize = 8
+	}
+	if len(b)-i < entrySize*numChunks {
+		return nil, ErrTruncated
+	}
+	table := b[i : i+entrySize*numChunks]
+	offsets := make([]int, numChunks+1)
+	offsets[0] = i + len(table)
+	for k := 0; k < numChunks; k++ {
+		offsets[k+1] = offsets[k] + int(binary.LittleEndian.Uint32(table[entrySize*k:]))
+		if offsets[k+1] > len(b) {
+			return nil, ErrTruncated
+		}
+	}
+	if offsets[numChunks] != len(b) {
+		return nil, ErrCorrupt
+	}
+
+	data := make([]uint32, count)
+	err := parallelFor(ctx, numChunks, opts.workers(), func(k int) error {
+		chunk := data[k*int(chunkSize) : min((k+1)*int(chunkSize), len(data))]
+		encoded := b[offsets[k]:offsets[k+1]]
+		var size int
+		var err error
+		if flags&FlagDelta != 0 {
+			previous := binary.LittleEndian.Uint32(table[entrySize*k+4:])
+			size, err = SafeDecodeDeltaUint32(chunk, encoded, previous)
+		} else {
+			size, err = SafeDecodeUint32(chunk, encoded)
+		}
+		if err == nil && size != len(encoded) {
+			err = ErrCorrupt
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// parallelFor calls f for each of 0 to n-1 on up to workers goroutines and
+// returns the first error, stopping early on an error or once ctx is done.
+func parallelFor(ctx context.Context, n, workers int, f func(k int) error) error {
+	if workers > n {
+		workers = n
+	}
+	var (
+		next     atomic.Int64
+		once     sync.Once
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				k := int(next.Add(1) - 1)
+				if k >= n {
+					return
+				}
+				err := ctx.Err()
+				if err == nil {
+					err = f(k)
+				}
+				if err != nil {
+					once.Do(func() { firstErr = err })
+					next.Store(int64(n))
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestParallel(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	ctx := context.Background()
+	for _, size := range append(testSizes, 100003) {
+		data := makeRandomUint32(r, size)
+		for _, opts := range []ParallelOptions{
+			{},
+			{Workers: 1, ChunkSize: 1},
+			{Workers: 3, ChunkSize: 17},
+			{Workers: 8, ChunkSize: 4096},
+		} {
+			b, err := EncodeParallel(ctx, data, opts)
+			if err != nil {
+				t.Fatalf("size %d %+v: EncodeParallel error %v", size, opts, err)
+			}
+			got, err := DecodeParallel(ctx, b, opts)
+			if err != nil || !slices.Equal(got, data) {
+				t.Errorf("size %d %+v: DecodeParallel got %d values, error %v", size, opts, len(got), err)
+			}
+
+			b, err = EncodeDeltaParallel(ctx, data, 7, opts)
+			if err != nil {
+				t.Fatalf("size %d %+v: EncodeDeltaParallel error %v", size, opts, err)
+			}
+			got, err = DecodeParallel(ctx, b, ParallelOptions{Workers: 2})
+			if err != nil || !slices.Equal(got, data) {
+				t.Errorf("size %d %+v: DecodeParallel of delta got %d values, error %v", size, opts, len(got), err)
+			}
+		}
+	}
+}
+
+func TestParallelSingleChunk(t *testing.T) {
+	// A single chunk holds the same encoding as EncodeDeltaUint32.
+	data := makeRandomUint32(rand.New(rand.NewSource(42)), 1000)
+	b, err := EncodeDeltaParallel(context.Background(), data, 7, ParallelOptions{ChunkSize: len(data)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded := make([]byte, MaxSize32(len(data)))
+	n := EncodeDeltaUint32(encoded, data, 7)
+	if !slices.Equal(b[len(b)-n:], encoded[:n]) {
+		t.Error("single chunk does not match EncodeDeltaUint32")
+	}
+}
+
+func TestParallelCanceled(t *testing.T) {
+	data := make([]uint32, 10000)
+	ctx, cancel := context.WithCancel(context.Background())
+	b, err := EncodeParallel(ctx, data, ParallelOptions{ChunkSize: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	if _, err := EncodeParallel(ctx, data, ParallelOptions{ChunkSize: 100}); !errors.Is(err, context.Canceled) {
+		t.Errorf("got EncodeParallel error %v, expected: %v", err, context.Canceled)
+	}
+	if _, err := DecodeParallel(ctx, b, ParallelOptions{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("got DecodeParallel error %v, expected: %v", err, context.Canceled)
+	}
+}
+
+func TestParallelCorrupt(t *testing.T) {
+	ctx := context.Background()
+	data := makeRandomUint32(rand.New(rand.NewSource(42)), 1000)
+	b, err := EncodeDeltaParallel(ctx, data, 7, ParallelOptions{ChunkSize: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	badMagic := slices.Clone(b)
+	badMagic[0] = 'X'
+	badFlags := slices.Clone(b)
+	badFlags[len(chunkedMagic)] = byte(FlagZigzag)
+	badSize := slices.Clone(b)
+	// The first table entry follows the magic, the flags, the two byte count
+	// and the one byte chunkSize.
+	badSize[len(chunkedMagic)+4]--
+	for _, tc := range []struct {
+		name     string
+		b        []byte
+		expected error
+	}{
+		{"empty", nil, ErrInvalidHeader},
+		{"magic", badMagic, ErrInvalidHeader},
+		{"flags", badFlags, ErrInvalidHeader},
+		{"truncated", b[:len(b)-1], ErrTruncated},
+		{"table", b[:len(chunkedMagic)+10], ErrTruncated},
+		{"size", badSize, ErrCorrupt},
+	} {
+		if _, err := DecodeParallel(ctx, tc.b, ParallelOptions{}); err != tc.expected {
+			t.Errorf("%s: got error %v, expected: %v", tc.name, err, tc.expected)
+		}
+	}
+}
+
+func BenchmarkDecodeParallel(b *testing.B) {
+	ctx := context.Background()
+	encoded, err := EncodeParallel(ctx, benchUint32Data, ParallelOptions{})
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(4 * benchSize))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeParallel(ctx, encoded, ParallelOptions{})
+	}
+}
+
+func BenchmarkEncodeParallel(b *testing.B) {
+	ctx := context.Background()
+	b.SetBytes(int64(4 * benchSize))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeParallel(ctx, benchUint32Data, ParallelOptions{})
+	}
+}
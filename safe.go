@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"errors"
+)
+
+var (
+	// ErrTruncated is returned when the encoded data is shorter than
+	// the control bytes indicate.
+	ErrTruncated = errors.New("streamvbyte: encoded data is truncated")
+	// ErrCorrupt is returned when the unused codes of the final control
+	// byte are not zero.
+	ErrCorrupt = errors.New("streamvbyte: encoded data is corrupt")
+)
+
+// controlByteDataLen is the count of data bytes (4 to 16) referenced by a control byte.
+var controlByteDataLen = func() (lengths [256]uint8) {
+	for i := range lengths {
+		lengths[i] = uint8(i&3) + uint8((i>>2)&3) + uint8((i>>4)&3) + uint8((i>>6)&3) + 4
+	}
+	return lengths
+}()
+
+// dataLenScalar returns the count of data bytes referenced by the control bytes.
+func dataLenScalar(control []byte) int {
+	n := 0
+	for _, controlByte := range control {
+		n += int(controlByteDataLen[controlByte])
+	}
+	return n
+}
+
+// checkEncoded returns the size of count values encoded at the start of
+// encoded, or an error if encoded is too short to hold them.
+func checkEncoded(count int, encoded []byte) (int, error) {
+	numControlBytes := (count + 3) >> 2
+	if len(encoded) < numControlBytes {
+		return 0, ErrTruncated
+	}
+	fullControlBytes := count >> 2
+	size := numControlBytes + dataLen(encoded[:fullControlBytes])
+	if rem := count & 3; rem != 0 {
+		controlByte := encoded[fullControlBytes]
+		if controlByte>>(2*uint(rem)) != 0 {
+			return 0, ErrCorrupt
+		}
+		size += partialDataLen(controlByte, rem)
+	}
+	if len(encoded) < size {
+		return 0, ErrTruncated
+	}
+	return size, nil
+}
+
+// partialDataLen returns the count of data bytes referenced by the first
+// rem codes of controlByte.
+func partialDataLen(controlByte byte, rem int) int {
+	n := 0
+	for i := 0; i < rem; i++ {
+		n += int(controlByte&3) + 1
+		controlByte >>= 2
+	}
+	return n
+}
@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSafeDecodeUint32(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeRandomUint32(r, size)
+		encoded := make([]byte, MaxSize32(size)+16)
+		encodedSize := EncodeUint32(encoded, data)
+		decoded := make([]uint32, size)
+		// trailing bytes after the encoded data are ignored
+		n, err := SafeDecodeUint32(decoded, encoded)
+		if err != nil || n != encodedSize {
+			t.Fatalf("got (%d, %v), expected (%d, nil)", n, err, encodedSize)
+		}
+		for i := range data {
+			if decoded[i] != data[i] {
+				t.Fatalf("got decodedData[%d]: %d, expected: %d", i, decoded[i], data[i])
+			}
+		}
+		for end := 0; end < encodedSize; end++ {
+			if _, err := SafeDecodeUint32(decoded, encoded[:end]); err != ErrTruncated {
+				t.Fatalf("size %d truncated to %d: got %v, expected %v", size, end, err, ErrTruncated)
+			}
+		}
+	}
+}
+
+func TestSafeDecodeDeltaUint32(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeRandomDeltaUint32(r, size, 7)
+		encoded := make([]byte, MaxSize32(size))
+		encodedSize := EncodeDeltaUint32(encoded, data, 7)
+		decoded := make([]uint32, size)
+		n, err := SafeDecodeDeltaUint32(decoded, encoded[:encodedSize], 7)
+		if err != nil || n != encodedSize {
+			t.Fatalf("got (%d, %v), expected (%d, nil)", n, err, encodedSize)
+		}
+		for i := range data {
+			if decoded[i] != data[i] {
+				t.Fatalf("got decodedData[%d]: %d, expected: %d", i, decoded[i], data[i])
+			}
+		}
+		for end := 0; end < encodedSize; end++ {
+			if _, err := SafeDecodeDeltaUint32(decoded, encoded[:end], 7); err != ErrTruncated {
+				t.Fatalf("size %d truncated to %d: got %v, expected %v", size, end, err, ErrTruncated)
+			}
+		}
+	}
+}
+
+func TestSafeDecodeInt32(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeRandomInt32(r, size)
+		encoded := make([]byte, MaxSize32(size))
+		encodedSize := EncodeInt32(encoded, data)
+		decoded := make([]int32, size)
+		n, err := SafeDecodeInt32(decoded, encoded[:encodedSize])
+		if err != nil || n != encodedSize {
+			t.Fatalf("got (%d, %v), expected (%d, nil)", n, err, encodedSize)
+		}
+		for i := range data {
+			if decoded[i] != data[i] {
+				t.Fatalf("got decodedData[%d]: %d, expected: %d", i, decoded[i], data[i])
+			}
+		}
+		for end := 0; end < encodedSize; end++ {
+			if _, err := SafeDecodeInt32(decoded, encoded[:end]); err != ErrTruncated {
+				t.Fatalf("size %d truncated to %d: got %v, expected %v", size, end, err, ErrTruncated)
+			}
+		}
+	}
+}
+
+func TestSafeDecodeDeltaInt32(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeRandomDeltaInt32(r, size, -7)
+		encoded := make([]byte, MaxSize32(size))
+		encodedSize := EncodeDeltaInt32(encoded, data, -7)
+		decoded := make([]int32, size)
+		n, err := SafeDecodeDeltaInt32(decoded, encoded[:encodedSize], -7)
+		if err != nil || n != encodedSize {
+			t.Fatalf("got (%d, %v), expected (%d, nil)", n, err, encodedSize)
+		}
+		for i := range data {
+			if decoded[i] != data[i] {
+				t.Fatalf("got decodedData[%d]: %d, expected: %d", i, decoded[i], data[i])
+			}
+		}
+		for end := 0; end < encodedSize; end++ {
+			if _, err := SafeDecodeDeltaInt32(decoded, encoded[:end], -7); err != ErrTruncated {
+				t.Fatalf("size %d truncated to %d: got %v, expected %v", size, end, err, ErrTruncated)
+			}
+		}
+	}
+}
+
+func TestSafeDecodeCorrupt(t *testing.T) {
+	decoded := make([]uint32, 5)
+	encoded := make([]byte, MaxSize32(len(decoded)))
+	EncodeUint32(encoded, decoded)
+	// set an unused code of the final control byte
+	encoded[1] |= 0b_00_00_01_00
+	if _, err := SafeDecodeUint32(decoded, encoded); err != ErrCorrupt {
+		t.Fatalf("got %v, expected %v", err, ErrCorrupt)
+	}
+}
+
+func TestSafeDecodeRandomBytes(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 10000; i++ {
+		encoded := make([]byte, r.Intn(100))
+		r.Read(encoded)
+		decoded := make([]uint32, r.Intn(100))
+		n, err := SafeDecodeUint32(decoded, encoded)
+		if err == nil && n > len(encoded) {
+			t.Fatalf("consumed %d bytes of %d", n, len(encoded))
+		}
+	}
+}
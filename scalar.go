@@ -69,7 +69,7 @@ func encodeUint32scalar(encoded []byte, data []uint32) int {
 	return di
 }
 
-func decodeUint32scalar(data []uint32, encoded []byte) {
+func decodeUint32scalar(data []uint32, encoded []byte) int {
 	// index of the control bytes
 	ci := 0
 	// index of the data bytes
@@ -98,4 +98,5 @@ func decodeUint32scalar(data []uint32, encoded []byte) {
 		}
 		controlByte >>= 2
 	}
+	return di
 }
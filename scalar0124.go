@@ -0,0 +1,322 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"encoding/binary"
+)
+
+// The 0124 variant uses the control codes 0, 1, 2, 3 for 0, 1, 2 and 4 data
+// bytes, so zero values take no data bytes at all.
+
+func encodeUint32_0124scalar(encoded []byte, data []uint32) int {
+	// index of the control bytes
+	ci := 0
+	// index of the data bytes
+	di := (len(data) + 3) >> 2
+
+	controlByte := byte(0)
+	for i, v := range data {
+		controlByte >>= 2
+		switch {
+		case v == 0:
+		case v < 1<<8:
+			encoded[di] = byte(v)
+			di++
+			controlByte ^= 0b_01_00_00_00
+		case v < 1<<16:
+			binary.LittleEndian.PutUint16(encoded[di:], uint16(v))
+			di += 2
+			controlByte ^= 0b_10_00_00_00
+		default:
+			binary.LittleEndian.PutUint32(encoded[di:], v)
+			di += 4
+			controlByte ^= 0b_11_00_00_00
+		}
+		if (i+1)&3 == 0 {
+			encoded[ci] = controlByte
+			controlByte = 0
+			ci++
+		}
+	}
+	// Check if the last block was complete or the control byte
+	// needs to be shifted and written.
+	if rem := len(data) & 3; rem != 0 {
+		shift := uint(4-rem) * 2
+		encoded[ci] = controlByte >> shift
+	}
+	return di
+}
+
+func decodeUint32_0124scalar(data []uint32, encoded []byte) int {
+	// index of the control bytes
+	ci := 0
+	// index of the data bytes
+	di := (len(data) + 3) >> 2
+
+	var controlByte byte
+	for i := range data {
+		if i&3 == 0 {
+			controlByte = encoded[ci]
+			ci++
+		}
+		var v uint32
+		switch controlByte & 3 {
+		case 0:
+		case 1:
+			v = uint32(encoded[di])
+			di++
+		case 2:
+			v = uint32(binary.LittleEndian.Uint16(encoded[di:]))
+			di += 2
+		default:
+			v = binary.LittleEndian.Uint32(encoded[di:])
+			di += 4
+		}
+		data[i] = v
+		controlByte >>= 2
+	}
+	return di
+}
+
+func encodeDeltaUint32_0124scalar(encoded []byte, data []uint32, previous uint32) int {
+	// index of the control bytes
+	ci := 0
+	// index of the data bytes
+	di := (len(data) + 3) >> 2
+
+	controlByte := byte(0)
+	for i, v := range data {
+		v, previous = v-previous, v
+		controlByte >>= 2
+		switch {
+		case v == 0:
+		case v < 1<<8:
+			encoded[di] = byte(v)
+			di++
+			controlByte ^= 0b_01_00_00_00
+		case v < 1<<16:
+			binary.LittleEndian.PutUint16(encoded[di:], uint16(v))
+			di += 2
+			controlByte ^= 0b_10_00_00_00
+		default:
+			binary.LittleEndian.PutUint32(encoded[di:], v)
+			di += 4
+			controlByte ^= 0b_11_00_00_00
+		}
+		if (i+1)&3 == 0 {
+			encoded[ci] = controlByte
+			controlByte = 0
+			ci++
+		}
+	}
+	// Check if the last block was complete or the control byte
+	// needs to be shifted and written.
+	if rem := len(data) & 3; rem != 0 {
+		shift := uint(4-rem) * 2
+		encoded[ci] = controlByte >> shift
+	}
+	return di
+}
+
+func decodeDeltaUint32_0124scalar(data []uint32, encoded []byte, previous uint32) int {
+	// index of the control bytes
+	ci := 0
+	// index of the data bytes
+	di := (len(data) + 3) >> 2
+
+	var controlByte byte
+	for i := range data {
+		if i&3 == 0 {
+			controlByte = encoded[ci]
+			ci++
+		}
+		var v uint32
+		switch controlByte & 3 {
+		case 0:
+		case 1:
+			v = uint32(encoded[di])
+			di++
+		case 2:
+			v = uint32(binary.LittleEndian.Uint16(encoded[di:]))
+			di += 2
+		default:
+			v = binary.LittleEndian.Uint32(encoded[di:])
+			di += 4
+		}
+		previous += v
+		data[i] = previous
+		controlByte >>= 2
+	}
+	return di
+}
+
+func encodeInt32_0124scalar(encoded []byte, data []int32) int {
+	// index of the control bytes
+	ci := 0
+	// index of the data bytes
+	di := (len(data) + 3) >> 2
+
+	controlByte := byte(0)
+	for i, sv := range data {
+		// zigzag encode
+		v := uint32((sv >> 31) ^ (sv << 1))
+		controlByte >>= 2
+		switch {
+		case v == 0:
+		case v < 1<<8:
+			encoded[di] = byte(v)
+			di++
+			controlByte ^= 0b_01_00_00_00
+		case v < 1<<16:
+			binary.LittleEndian.PutUint16(encoded[di:], uint16(v))
+			di += 2
+			controlByte ^= 0b_10_00_00_00
+		default:
+			binary.LittleEndian.PutUint32(encoded[di:], v)
+			di += 4
+			controlByte ^= 0b_11_00_00_00
+		}
+		if (i+1)&3 == 0 {
+			encoded[ci] = controlByte
+			controlByte = 0
+			ci++
+		}
+	}
+	// Check if the last block was complete or the control byte
+	// needs to be shifted and written.
+	if rem := len(data) & 3; rem != 0 {
+		shift := uint(4-rem) * 2
+		encoded[ci] = controlByte >> shift
+	}
+	return di
+}
+
+func decodeInt32_0124scalar(data []int32, encoded []byte) int {
+	// index of the control bytes
+	ci := 0
+	// index of the data bytes
+	di := (len(data) + 3) >> 2
+
+	var controlByte byte
+	for i := range data {
+		if i&3 == 0 {
+			controlByte = encoded[ci]
+			ci++
+		}
+		var v uint32
+		switch controlByte & 3 {
+		case 0:
+		case 1:
+			v = uint32(encoded[di])
+			di++
+		case 2:
+			v = uint32(binary.LittleEndian.Uint16(encoded[di:]))
+			di += 2
+		default:
+			v = binary.LittleEndian.Uint32(encoded[di:])
+			di += 4
+		}
+		// zigzag decode
+		data[i] = int32((v >> 1) ^ -(v & 1))
+		controlByte >>= 2
+	}
+	return di
+}
+
+func encodeDeltaInt32_0124scalar(encoded []byte, data []int32, previous int32) int {
+	// index of the control bytes
+	ci := 0
+	// index of the data bytes
+	di := (len(data) + 3) >> 2
+
+	controlByte := byte(0)
+	for i, sv := range data {
+		tmp := sv
+		sv -= previous
+		previous = tmp
+		// zigzag encode
+		v := uint32((sv >> 31) ^ (sv << 1))
+		controlByte >>= 2
+		switch {
+		case v == 0:
+		case v < 1<<8:
+			encoded[di] = byte(v)
+			di++
+			controlByte ^= 0b_01_00_00_00
+		case v < 1<<16:
+			binary.LittleEndian.PutUint16(encoded[di:], uint16(v))
+			di += 2
+			controlByte ^= 0b_10_00_00_00
+		default:
+			binary.LittleEndian.PutUint32(encoded[di:], v)
+			di += 4
+			controlByte ^= 0b_11_00_00_00
+		}
+		if (i+1)&3 == 0 {
+			encoded[ci] = controlByte
+			controlByte = 0
+			ci++
+		}
+	}
+	// Check if the last block was complete or the control byte
+	// needs to be shifted and written.
+	if rem := len(data) & 3; rem != 0 {
+		shift := uint(4-rem) * 2
+		encoded[ci] = controlByte >> shift
+	}
+	return di
+}
+
+func decodeDeltaInt32_0124scalar(data []int32, encoded []byte, previous int32) int {
+	// index of the control bytes
+	ci := 0
+	// index of the data bytes
+	di := (len(data) + 3) >> 2
+
+	var controlByte byte
+	for i := range data {
+		if i&3 == 0 {
+			controlByte = encoded[ci]
+			ci++
+		}
+		var v uint32
+		switch controlByte & 3 {
+		case 0:
+		case 1:
+			v = uint32(encoded[di])
+			di++
+		case 2:
+			v = uint32(binary.LittleEndian.Uint16(encoded[di:]))
+			di += 2
+		default:
+			v = binary.LittleEndian.Uint32(encoded[di:])
+			di += 4
+		}
+		// zigzag decode
+		previous += int32((v >> 1) ^ -(v & 1))
+		data[i] = previous
+		controlByte >>= 2
+	}
+	return di
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRoundTripUint32_0124Scalar(t *testing.T) {
+	testUniformAndRandomUint32_0124(t, encodeUint32_0124scalar, decodeUint32_0124scalar)
+}
+
+func TestRoundTripInt32_0124Scalar(t *testing.T) {
+	testUniformAndRandomInt32_0124(t, encodeInt32_0124scalar, decodeInt32_0124scalar)
+}
+
+func TestRoundTripDelta0124Scalar(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		uint32Data := makeRandomDeltaUint32(r, size, 7)
+		encoded := make([]byte, MaxSize32(size))
+		decodedUint32 := make([]uint32, size)
+		encodedSize := encodeDeltaUint32_0124scalar(encoded, uint32Data, 7)
+		if decodedSize := decodeDeltaUint32_0124scalar(decodedUint32, encoded[:encodedSize], 7); decodedSize != encodedSize {
+			t.Errorf("got decodedSize: %d, expected: %d", decodedSize, encodedSize)
+		}
+		int32Data := makeRandomDeltaInt32(r, size, -7)
+		decodedInt32 := make([]int32, size)
+		encodedSize = encodeDeltaInt32_0124scalar(encoded, int32Data, -7)
+		if decodedSize := decodeDeltaInt32_0124scalar(decodedInt32, encoded[:encodedSize], -7); decodedSize != encodedSize {
+			t.Errorf("got decodedSize: %d, expected: %d", decodedSize, encodedSize)
+		}
+		for i := range uint32Data {
+			if decodedUint32[i] != uint32Data[i] || decodedInt32[i] != int32Data[i] {
+				t.Fatalf("size %d: mismatch at %d", size, i)
+			}
+		}
+	}
+}
+
+func BenchmarkEncodeUint32_0124Scalar(b *testing.B) {
+	b.SetBytes(int64(4 * benchSize))
+	for i := 0; i < b.N; i++ {
+		benchEncodedSize = encodeUint32_0124scalar(benchEncoded, benchUint32Data)
+	}
+}
+
+func BenchmarkDecodeUint32_0124Scalar(b *testing.B) {
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = encodeUint32_0124scalar(benchEncoded, benchUint32Data)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeUint32_0124scalar(benchUint32Data, benchEncoded)
+	}
+}
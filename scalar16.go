@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+func encodeUint16scalar(encoded []byte, data []uint16) int {
+	// index of the control bytes
+	ci := 0
+	// index of the data bytes
+	di := (len(data) + 7) >> 3
+
+	controlByte := byte(0)
+	for i, v := range data {
+		controlByte >>= 1
+		encoded[di] = byte(v)
+		di++
+		if v >= 1<<8 {
+			encoded[di] = byte(v >> 8)
+			di++
+			controlByte ^= 0b_1000_0000
+		}
+		if (i+1)&7 == 0 {
+			encoded[ci] = controlByte
+			controlByte = 0
+			ci++
+		}
+	}
+	// Check if the last block was complete or the control byte
+	// needs to be shifted and written.
+	if rem := len(data) & 7; rem != 0 {
+		encoded[ci] = controlByte >> uint(8-rem)
+	}
+	return di
+}
+
+func decodeUint16scalar(data []uint16, encoded []byte) int {
+	// index of the control bytes
+	ci := 0
+	// index of the data bytes
+	di := (len(data) + 7) >> 3
+
+	var controlByte byte
+	for i := range data {
+		if i&7 == 0 {
+			controlByte = encoded[ci]
+			ci++
+		}
+		v := uint16(encoded[di])
+		di++
+		if controlByte&1 != 0 {
+			v |= uint16(encoded[di]) << 8
+			di++
+		}
+		data[i] = v
+		controlByte >>= 1
+	}
+	return di
+}
+
+func encodeDeltaUint16scalar(encoded []byte, data []uint16, previous uint16) int {
+	ci := 0
+	di := (len(data) + 7) >> 3
+
+	controlByte := byte(0)
+	for i, v := range data {
+		delta := v - previous
+		previous = v
+		controlByte >>= 1
+		encoded[di] = byte(delta)
+		di++
+		if delta >= 1<<8 {
+			encoded[di] = byte(delta >> 8)
+			di++
+			controlByte ^= 0b_1000_0000
+		}
+		if (i+1)&7 == 0 {
+			encoded[ci] = controlByte
+			controlByte = 0
+			ci++
+		}
+	}
+	if rem := len(data) & 7; rem != 0 {
+		encoded[ci] = controlByte >> uint(8-rem)
+	}
+	return di
+}
+
+func decodeDeltaUint16scalar(data []uint16, encoded []byte, previous uint16) int {
+	ci := 0
+	di := (len(data) + 7) >> 3
+
+	var controlByte byte
+	for i := range data {
+		if i&7 == 0 {
+			controlByte = encoded[ci]
+			ci++
+		}
+		delta := uint16(encoded[di])
+		di++
+		if controlByte&1 != 0 {
+			delta |= uint16(encoded[di]) << 8
+			di++
+		}
+		previous += delta
+		data[i] = previous
+		controlByte >>= 1
+	}
+	return di
+}
+
+func encodeInt16scalar(encoded []byte, data []int16) int {
+	ci := 0
+	di := (len(data) + 7) >> 3
+
+	controlByte := byte(0)
+	for i, sv := range data {
+		// zigzag encode
+		v := uint16((sv >> 15) ^ (sv << 1))
+		controlByte >>= 1
+		encoded[di] = byte(v)
+		di++
+		if v >= 1<<8 {
+			encoded[di] = byte(v >> 8)
+			di++
+			controlByte ^= 0b_1000_0000
+		}
+		if (i+1)&7 == 0 {
+			encoded[ci] = controlByte
+			controlByte = 0
+			ci++
+		}
+	}
+	if rem := len(data) & 7; rem != 0 {
+		encoded[ci] = controlByte >> uint(8-rem)
+	}
+	return di
+}
+
+func decodeInt16scalar(data []int16, encoded []byte) int {
+	ci := 0
+	di := (len(data) + 7) >> 3
+
+	var controlByte byte
+	for i := range data {
+		if i&7 == 0 {
+			controlByte = encoded[ci]
+			ci++
+		}
+		v := uint16(encoded[di])
+		di++
+		if controlByte&1 != 0 {
+			v |= uint16(encoded[di]) << 8
+			di++
+		}
+		// zigzag decode
+		data[i] = int16((v >> 1) ^ -(v & 1))
+		controlByte >>= 1
+	}
+	return di
+}
+
+func encodeDeltaInt16scalar(encoded []byte, data []int16, previous int16) int {
+	ci := 0
+	di := (len(data) + 7) >> 3
+
+	controlByte := byte(0)
+	for i, sv := range data {
+		tmp := sv
+		sv -= previous
+		previous = tmp
+		// zigzag encode
+		v := uint16((sv >> 15) ^ (sv << 1))
+		controlByte >>= 1
+		encoded[di] = byte(v)
+		di++
+		if v >= 1<<8 {
+			encoded[di] = byte(v >> 8)
+			di++
+			controlByte ^= 0b_1000_0000
+		}
+		if (i+1)&7 == 0 {
+			encoded[ci] = controlByte
+			controlByte = 0
+			ci++
+		}
+	}
+	if rem := len(data) & 7; rem != 0 {
+		encoded[ci] = controlByte >> uint(8-rem)
+	}
+	return di
+}
+
+func decodeDeltaInt16scalar(data []int16, encoded []byte, previous int16) int {
+	ci := 0
+	di := (len(data) + 7) >> 3
+
+	var controlByte byte
+	for i := range data {
+		if i&7 == 0 {
+			controlByte = encoded[ci]
+			ci++
+		}
+		v := uint16(encoded[di])
+		di++
+		if controlByte&1 != 0 {
+			v |= uint16(encoded[di]) << 8
+			di++
+		}
+		// zigzag decode
+		previous += int16((v >> 1) ^ -(v & 1))
+		data[i] = previous
+		controlByte >>= 1
+	}
+	return di
+}
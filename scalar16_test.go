@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"testing"
+)
+
+func TestRoundTripUint16Scalar(t *testing.T) {
+	testUniformAndRandomUint16(t, encodeUint16scalar, decodeUint16scalar, makeUniformUint16)
+}
+
+func encodeDeltaUint16scalarTest(encoded []byte, data []uint16) int {
+	return encodeDeltaUint16scalar(encoded, data, 0)
+}
+
+func decodeDeltaUint16scalarTest(data []uint16, encoded []byte) int {
+	return decodeDeltaUint16scalar(data, encoded, 0)
+}
+
+func TestRoundTripDeltaUint16Scalar(t *testing.T) {
+	testUniformAndRandomUint16(t, encodeDeltaUint16scalarTest, decodeDeltaUint16scalarTest, makeUniformDeltaUint16)
+}
+
+func TestRoundTripInt16Scalar(t *testing.T) {
+	testUniformAndRandomInt16(t, encodeInt16scalar, decodeInt16scalar, makeUniformInt16)
+}
+
+func encodeDeltaInt16scalarTest(encoded []byte, data []int16) int {
+	return encodeDeltaInt16scalar(encoded, data, 0)
+}
+
+func decodeDeltaInt16scalarTest(data []int16, encoded []byte) int {
+	return decodeDeltaInt16scalar(data, encoded, 0)
+}
+
+func TestRoundTripDeltaInt16Scalar(t *testing.T) {
+	testUniformAndRandomInt16(t, encodeDeltaInt16scalarTest, decodeDeltaInt16scalarTest, makeUniformDeltaInt16)
+}
+
+func BenchmarkEncodeUint16Scalar(b *testing.B) {
+	b.SetBytes(int64(2 * benchSize))
+	for i := 0; i < b.N; i++ {
+		benchEncodedSize = encodeUint16scalar(benchEncoded16, benchUint16Data)
+	}
+}
+
+func BenchmarkDecodeUint16Scalar(b *testing.B) {
+	b.SetBytes(int64(2 * benchSize))
+	benchEncodedSize = encodeUint16scalar(benchEncoded16, benchUint16Data)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeUint16scalar(benchUint16Data, benchEncoded16)
+	}
+}
+
+func BenchmarkDecodeDeltaUint16Scalar(b *testing.B) {
+	b.SetBytes(int64(2 * benchSize))
+	benchEncodedSize = encodeDeltaUint16scalar(benchEncoded16, benchUint16DataSorted, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeDeltaUint16scalar(benchUint16DataSorted, benchEncoded16, 0)
+	}
+}
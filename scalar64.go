@@ -0,0 +1,226 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// numControlBytes64 returns the size of the control stream for count uint64,
+// each value has a 3-bit code packed little endian into the control bytes.
+func numControlBytes64(count int) int {
+	return (3*count + 7) >> 3
+}
+
+// putUint64 writes the low code+1 bytes of v to encoded[di:] and returns the
+// new data index along with the code.
+func putUint64(encoded []byte, di int, v uint64) (int, uint64) {
+	code := uint64(bits.Len64(v|1)-1) >> 3
+	if len(encoded)-di >= 8 {
+		binary.LittleEndian.PutUint64(encoded[di:], v)
+	} else {
+		for k := uint64(0); k <= code; k++ {
+			encoded[di+int(k)] = byte(v >> (8 * k))
+		}
+	}
+	return di + int(code) + 1, code
+}
+
+// getUint64 reads code+1 bytes from encoded[di:] and returns the value
+// along with the new data index.
+func getUint64(encoded []byte, di int, code uint64) (uint64, int) {
+	var v uint64
+	if len(encoded)-di >= 8 {
+		v = binary.LittleEndian.Uint64(encoded[di:]) & (^uint64(0) >> (56 - 8*code))
+	} else {
+		for k := int(code); k >= 0; k-- {
+			v = v<<8 | uint64(encoded[di+k])
+		}
+	}
+	return v, di + int(code) + 1
+}
+
+// controlWriter packs 3-bit codes into the control bytes, 8 codes
+// are flushed as 3 control bytes.
+type controlWriter struct {
+	encoded []byte
+	ci      int
+	codes   uint64
+	shift   uint
+}
+
+func (c *controlWriter) put(code uint64) {
+	c.codes |= code << c.shift
+	c.shift += 3
+	if c.shift == 24 {
+		c.encoded[c.ci] = byte(c.codes)
+		c.encoded[c.ci+1] = byte(c.codes >> 8)
+		c.encoded[c.ci+2] = byte(c.codes >> 16)
+		c.ci += 3
+		c.codes = 0
+		c.shift = 0
+	}
+}
+
+// flush writes the codes of a final partial group.
+func (c *controlWriter) flush() {
+	for n := (c.shift + 7) >> 3; n > 0; n-- {
+		c.encoded[c.ci] = byte(c.codes)
+		c.codes >>= 8
+		c.ci++
+	}
+	c.codes = 0
+	c.shift = 0
+}
+
+// controlReader unpacks 3-bit codes from the control bytes.
+type controlReader struct {
+	encoded []byte
+	ci      int
+	codes   uint64
+	left    uint
+}
+
+func (c *controlReader) next() uint64 {
+	if c.left == 0 {
+		// Load a group of 8 codes, the final group may be partial.
+		c.codes = uint64(c.encoded[c.ci])
+		if c.ci+1 < len(c.encoded) {
+			c.codes |= uint64(c.encoded[c.ci+1]) << 8
+		}
+		if c.ci+2 < len(c.encoded) {
+			c.codes |= uint64(c.encoded[c.ci+2]) << 16
+		}
+		c.ci += 3
+		c.left = 8
+	}
+	code := c.codes & 7
+	c.codes >>= 3
+	c.left--
+	return code
+}
+
+func encodeUint64scalar(encoded []byte, data []uint64) int {
+	// index of the data bytes
+	di := numControlBytes64(len(data))
+	control := controlWriter{encoded: encoded[:di]}
+	for _, v := range data {
+		var code uint64
+		di, code = putUint64(encoded, di, v)
+		control.put(code)
+	}
+	control.flush()
+	return di
+}
+
+func decodeUint64scalar(data []uint64, encoded []byte) int {
+	// index of the data bytes
+	di := numControlBytes64(len(data))
+	control := controlReader{encoded: encoded[:di]}
+	for i := range data {
+		data[i], di = getUint64(encoded, di, control.next())
+	}
+	return di
+}
+
+func encodeDeltaUint64scalar(encoded []byte, data []uint64, previous uint64) int {
+	di := numControlBytes64(len(data))
+	control := controlWriter{encoded: encoded[:di]}
+	for _, v := range data {
+		var code uint64
+		di, code = putUint64(encoded, di, v-previous)
+		previous = v
+		control.put(code)
+	}
+	control.flush()
+	return di
+}
+
+func decodeDeltaUint64scalar(data []uint64, encoded []byte, previous uint64) int {
+	di := numControlBytes64(len(data))
+	control := controlReader{encoded: encoded[:di]}
+	for i := range data {
+		var delta uint64
+		delta, di = getUint64(encoded, di, control.next())
+		previous += delta
+		data[i] = previous
+	}
+	return di
+}
+
+func encodeInt64scalar(encoded []byte, data []int64) int {
+	di := numControlBytes64(len(data))
+	control := controlWriter{encoded: encoded[:di]}
+	for _, sv := range data {
+		// zigzag encode
+		v := uint64((sv >> 63) ^ (sv << 1))
+		var code uint64
+		di, code = putUint64(encoded, di, v)
+		control.put(code)
+	}
+	control.flush()
+	return di
+}
+
+func decodeInt64scalar(data []int64, encoded []byte) int {
+	di := numControlBytes64(len(data))
+	control := controlReader{encoded: encoded[:di]}
+	for i := range data {
+		var v uint64
+		v, di = getUint64(encoded, di, control.next())
+		// zigzag decode
+		data[i] = int64((v >> 1) ^ -(v & 1))
+	}
+	return di
+}
+
+func encodeDeltaInt64scalar(encoded []byte, data []int64, previous int64) int {
+	di := numControlBytes64(len(data))
+	control := controlWriter{encoded: encoded[:di]}
+	for _, sv := range data {
+		tmp := sv
+		sv -= previous
+		previous = tmp
+		// zigzag encode
+		v := uint64((sv >> 63) ^ (sv << 1))
+		var code uint64
+		di, code = putUint64(encoded, di, v)
+		control.put(code)
+	}
+	control.flush()
+	return di
+}
+
+func decodeDeltaInt64scalar(data []int64, encoded []byte, previous int64) int {
+	di := numControlBytes64(len(data))
+	control := controlReader{encoded: encoded[:di]}
+	for i := range data {
+		var v uint64
+		v, di = getUint64(encoded, di, control.next())
+		// zigzag decode
+		previous += int64((v >> 1) ^ -(v & 1))
+		data[i] = previous
+	}
+	return di
+}
@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"testing"
+)
+
+func TestRoundTripUint64Scalar(t *testing.T) {
+	testUniformAndRandomUint64(t, encodeUint64scalar, decodeUint64scalar, makeUniformUint64)
+}
+
+func encodeDeltaUint64scalarTest(encoded []byte, data []uint64) int {
+	return encodeDeltaUint64scalar(encoded, data, 0)
+}
+
+func decodeDeltaUint64scalarTest(data []uint64, encoded []byte) int {
+	return decodeDeltaUint64scalar(data, encoded, 0)
+}
+
+func TestRoundTripDeltaUint64Scalar(t *testing.T) {
+	testUniformAndRandomUint64(t, encodeDeltaUint64scalarTest, decodeDeltaUint64scalarTest, makeUniformDeltaUint64)
+}
+
+func TestRoundTripInt64Scalar(t *testing.T) {
+	testUniformAndRandomInt64(t, encodeInt64scalar, decodeInt64scalar, makeUniformInt64)
+}
+
+func encodeDeltaInt64scalarTest(encoded []byte, data []int64) int {
+	return encodeDeltaInt64scalar(encoded, data, 0)
+}
+
+func decodeDeltaInt64scalarTest(data []int64, encoded []byte) int {
+	return decodeDeltaInt64scalar(data, encoded, 0)
+}
+
+func TestRoundTripDeltaInt64Scalar(t *testing.T) {
+	testUniformAndRandomInt64(t, encodeDeltaInt64scalarTest, decodeDeltaInt64scalarTest, makeUniformDeltaInt64)
+}
+
+func BenchmarkEncodeUint64Scalar(b *testing.B) {
+	b.SetBytes(int64(8 * benchSize))
+	for i := 0; i < b.N; i++ {
+		benchEncodedSize = encodeUint64scalar(benchEncoded64, benchUint64Data)
+	}
+}
+
+func BenchmarkDecodeUint64Scalar(b *testing.B) {
+	b.SetBytes(int64(8 * benchSize))
+	benchEncodedSize = encodeUint64scalar(benchEncoded64, benchUint64Data)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeUint64scalar(benchUint64Data, benchEncoded64)
+	}
+}
+
+func BenchmarkDecodeDeltaUint64Scalar(b *testing.B) {
+	b.SetBytes(int64(8 * benchSize))
+	benchEncodedSize = encodeDeltaUint64scalar(benchEncoded64, benchUint64DataSorted, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeDeltaUint64scalar(benchUint64DataSorted, benchEncoded64, 0)
+	}
+}
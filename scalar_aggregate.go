@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"encoding/binary"
+)
+
+// forEachUint32 calls f with each of count values encoded at the start of
+// encoded after applying the zigzag and delta transforms.
+func forEachUint32(encoded []byte, count int, previous uint32, delta, zigzag bool, f func(uint32)) {
+	// index of the data bytes
+	di := (count + 3) >> 2
+
+	var controlByte byte
+	for i := 0; i < count; i++ {
+		if i&3 == 0 {
+			controlByte = encoded[i>>2]
+		}
+		var v uint32
+		switch controlByte & 3 {
+		case 0:
+			v = uint32(encoded[di])
+		case 1:
+			v = uint32(binary.LittleEndian.Uint16(encoded[di:]))
+		case 2:
+			v = uint32(encoded[di+2])<<16 | uint32(binary.LittleEndian.Uint16(encoded[di:]))
+		default:
+			v = binary.LittleEndian.Uint32(encoded[di:])
+		}
+		di += int(controlByte&3) + 1
+		controlByte >>= 2
+		if zigzag {
+			v = (v >> 1) ^ -(v & 1)
+		}
+		if delta {
+			previous += v
+			v = previous
+		}
+		f(v)
+	}
+}
+
+func sumUint32scalar(encoded []byte, count int) uint64 {
+	var sum uint64
+	forEachUint32(encoded, count, 0, false, false, func(v uint32) {
+		sum += uint64(v)
+	})
+	return sum
+}
+
+func minMaxUint32scalar(encoded []byte, count int) (min, max uint32) {
+	min, max = 0xFFFFFFFF, 0
+	forEachUint32(encoded, count, 0, false, false, func(v uint32) {
+		if uint32(v) < min {
+			min = uint32(v)
+		}
+		if uint32(v) > max {
+			max = uint32(v)
+		}
+	})
+	return min, max
+}
+
+func countRangeUint32scalar(encoded []byte, count int, lo, hi uint32) int {
+	n := 0
+	forEachUint32(encoded, count, 0, false, false, func(v uint32) {
+		if lo <= uint32(v) && uint32(v) <= hi {
+			n++
+		}
+	})
+	return n
+}
+
+func sumDeltaUint32scalar(encoded []byte, count int, previous uint32) uint64 {
+	var sum uint64
+	forEachUint32(encoded, count, uint32(previous), true, false, func(v uint32) {
+		sum += uint64(v)
+	})
+	return sum
+}
+
+func minMaxDeltaUint32scalar(encoded []byte, count int, previous uint32) (min, max uint32) {
+	min, max = 0xFFFFFFFF, 0
+	forEachUint32(encoded, count, uint32(previous), true, false, func(v uint32) {
+		if uint32(v) < min {
+			min = uint32(v)
+		}
+		if uint32(v) > max {
+			max = uint32(v)
+		}
+	})
+	return min, max
+}
+
+func countRangeDeltaUint32scalar(encoded []byte, count int, previous uint32, lo, hi uint32) int {
+	n := 0
+	forEachUint32(encoded, count, uint32(previous), true, false, func(v uint32) {
+		if lo <= uint32(v) && uint32(v) <= hi {
+			n++
+		}
+	})
+	return n
+}
+
+func sumInt32scalar(encoded []byte, count int) int64 {
+	var sum int64
+	forEachUint32(encoded, count, 0, false, true, func(v uint32) {
+		sum += int64(int32(v))
+	})
+	return sum
+}
+
+func minMaxInt32scalar(encoded []byte, count int) (min, max int32) {
+	min, max = 0x7FFFFFFF, -0x80000000
+	forEachUint32(encoded, count, 0, false, true, func(v uint32) {
+		if int32(v) < min {
+			min = int32(v)
+		}
+		if int32(v) > max {
+			max = int32(v)
+		}
+	})
+	return min, max
+}
+
+func countRangeInt32scalar(encoded []byte, count int, lo, hi int32) int {
+	n := 0
+	forEachUint32(encoded, count, 0, false, true, func(v uint32) {
+		if lo <= int32(v) && int32(v) <= hi {
+			n++
+		}
+	})
+	return n
+}
+
+func sumDeltaInt32scalar(encoded []byte, count int, previous int32) int64 {
+	var sum int64
+	forEachUint32(encoded, count, uint32(previous), true, true, func(v uint32) {
+		sum += int64(int32(v))
+	})
+	return sum
+}
+
+func minMaxDeltaInt32scalar(encoded []byte, count int, previous int32) (min, max int32) {
+	min, max = 0x7FFFFFFF, -0x80000000
+	forEachUint32(encoded, count, uint32(previous), true, true, func(v uint32) {
+		if int32(v) < min {
+			min = int32(v)
+		}
+		if int32(v) > max {
+			max = int32(v)
+		}
+	})
+	return min, max
+}
+
+func countRangeDeltaInt32scalar(encoded []byte, count int, previous int32, lo, hi int32) int {
+	n := 0
+	forEachUint32(encoded, count, uint32(previous), true, true, func(v uint32) {
+		if lo <= int32(v) && int32(v) <= hi {
+			n++
+		}
+	})
+	return n
+}
@@ -72,7 +72,7 @@ func encodeDeltaUint32scalar(encoded []byte, data []uint32, previous uint32) int
 	return di
 }
 
-func decodeDeltaUint32scalar(data []uint32, encoded []byte, previous uint32) {
+func decodeDeltaUint32scalar(data []uint32, encoded []byte, previous uint32) int {
 	// index of the control bytes
 	ci := 0
 	// index of the data bytes
@@ -103,4 +103,5 @@ func decodeDeltaUint32scalar(data []uint32, encoded []byte, previous uint32) {
 		data[i] = previous
 		controlByte >>= 2
 	}
+	return di
 }
@@ -30,8 +30,8 @@ func encodeDeltaUint32scalarTest(encoded []byte, data []uint32) int {
 	return encodeDeltaUint32scalar(encoded, data, 0)
 }
 
-func decodeDeltaUint32scalarTest(data []uint32, encoded []byte) {
-	decodeDeltaUint32scalar(data, encoded, 0)
+func decodeDeltaUint32scalarTest(data []uint32, encoded []byte) int {
+	return decodeDeltaUint32scalar(data, encoded, 0)
 }
 func TestRoundTripDeltaUint32Scalar(t *testing.T) {
 	testUniformDeltaAndRandomUint32(t, encodeDeltaUint32scalarTest, decodeDeltaUint32scalarTest)
@@ -74,7 +74,7 @@ func encodeDeltaInt32scalar(encoded []byte, data []int32, previous int32) int {
 	return di
 }
 
-func decodeDeltaInt32scalar(data []int32, encoded []byte, previous int32) {
+func decodeDeltaInt32scalar(data []int32, encoded []byte, previous int32) int {
 	// index of the control bytes
 	ci := 0
 	// index of the data bytes
@@ -107,4 +107,5 @@ func decodeDeltaInt32scalar(data []int32, encoded []byte, previous int32) {
 		data[i] = previous
 		controlByte >>= 2
 	}
+	return di
 }
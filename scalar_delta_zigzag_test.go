@@ -30,8 +30,8 @@ func encodeDeltaInt32scalarTest(encoded []byte, data []int32) int {
 	return encodeDeltaInt32scalar(encoded, data, 0)
 }
 
-func decodeDeltaInt32scalarTest(data []int32, encoded []byte) {
-	decodeDeltaInt32scalar(data, encoded, 0)
+func decodeDeltaInt32scalarTest(data []int32, encoded []byte) int {
+	return decodeDeltaInt32scalar(data, encoded, 0)
 }
 func TestRoundTripDeltaInt32Scalar(t *testing.T) {
 	testUniformDeltaAndRandomInt32(t, encodeDeltaInt32scalarTest, decodeDeltaInt32scalarTest)
@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"encoding/binary"
+)
+
+// searchDeltaUint32scalar returns the index of the first of count delta
+// encoded values >= target, or count if there is none.  control and data
+// hold the control and data bytes of the values.
+func searchDeltaUint32scalar(control, data []byte, count int, previous, target uint32) int {
+	di := 0
+	var controlByte byte
+	for i := 0; i < count; i++ {
+		if i&3 == 0 {
+			controlByte = control[i>>2]
+		}
+		var v uint32
+		switch controlByte & 3 {
+		case 0:
+			v = uint32(data[di])
+		case 1:
+			v = uint32(binary.LittleEndian.Uint16(data[di:]))
+		case 2:
+			v = uint32(data[di+2])<<16 | uint32(binary.LittleEndian.Uint16(data[di:]))
+		default:
+			v = binary.LittleEndian.Uint32(data[di:])
+		}
+		di += int(controlByte&3) + 1
+		controlByte >>= 2
+		previous += v
+		if previous >= target {
+			return i
+		}
+	}
+	return count
+}
+
+// seekDeltaUint32scalar returns the index n of the first group of 4 of count
+// delta encoded values holding a value >= target, the offset di of its data
+// bytes and the value last preceding it, or count along with the offset and
+// value at the end if there is none.  control and data hold the control and
+// data bytes of the values.
+func seekDeltaUint32scalar(control, data []byte, count int, previous, target uint32) (n, di int, last uint32) {
+	for ; n < count; n += 4 {
+		controlByte := control[n>>2]
+		m := count - n
+		if m > 4 {
+			m = 4
+		}
+		next, dj := previous, di
+		for k := 0; k < m; k++ {
+			var v uint32
+			switch controlByte & 3 {
+			case 0:
+				v = uint32(data[dj])
+			case 1:
+				v = uint32(binary.LittleEndian.Uint16(data[dj:]))
+			case 2:
+				v = uint32(data[dj+2])<<16 | uint32(binary.LittleEndian.Uint16(data[dj:]))
+			default:
+				v = binary.LittleEndian.Uint32(data[dj:])
+			}
+			dj += int(controlByte&3) + 1
+			controlByte >>= 2
+			next += v
+			if next >= target {
+				return n, di, previous
+			}
+		}
+		previous, di = next, dj
+	}
+	return count, di, previous
+}
@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+// gallopRatio is the ratio of list lengths above which intersections and
+// differences gallop through the longer list rather than merging the lists.
+const gallopRatio = 32
+
+// uniqueUint32 removes repeated values from the sorted data in place.
+func uniqueUint32(data []uint32) []uint32 {
+	if len(data) == 0 {
+		return data
+	}
+	k := 1
+	for _, v := range data[1:] {
+		if v != data[k-1] {
+			data[k] = v
+			k++
+		}
+	}
+	return data[:k]
+}
+
+// gallop returns the index of the first value >= target in the sorted data,
+// or len(data) if there is none, probing exponentially increasing positions
+// before binary searching so that targets near the start are found quickly.
+func gallop(data []uint32, target uint32) int {
+	lo, hi := 0, 1
+	for hi < len(data) && data[hi] < target {
+		lo = hi
+		hi <<= 1
+	}
+	if hi > len(data) {
+		hi = len(data)
+	}
+	if len(data) > 0 && data[lo] >= target {
+		return lo
+	}
+	for lo+1 < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if data[mid] < target {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi
+}
+
+// intersectSorted writes the values common to the strictly increasing a and
+// b to dst and returns the number of values written.  dst must hold at least
+// min(len(a), len(b)) values and must not alias a or b.
+func intersectSorted(dst, a, b []uint32) int {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	if len(a)*gallopRatio < len(b) {
+		return intersectUint32Gallop(dst, a, b)
+	}
+	return intersectUint32(dst, a, b)
+}
+
+// intersectUint32scalar merges the strictly increasing a and b writing their
+// common values to dst.
+func intersectUint32scalar(dst, a, b []uint32) int {
+	i, j, k := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			dst[k] = a[i]
+			i++
+			j++
+			k++
+		}
+	}
+	return k
+}
+
+// intersectUint32Gallop writes the values of small found in large to dst,
+// galloping through large from the last match.
+func intersectUint32Gallop(dst, small, large []uint32) int {
+	k := 0
+	for _, v := range small {
+		large = large[gallop(large, v):]
+		if len(large) == 0 {
+			break
+		}
+		if large[0] == v {
+			dst[k] = v
+			k++
+		}
+	}
+	return k
+}
+
+// unionSorted writes the values in either of the strictly increasing a and b
+// to dst and returns the number of values written.  dst must hold at least
+// len(a)+len(b) values and must not alias a or b.
+func unionSorted(dst, a, b []uint32) int {
+	i, j, k := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		// Branch free as the order of the two lists is unpredictable.
+		x, y := int64(a[i]), int64(b[j])
+		dst[k] = uint32(min(x, y))
+		i += int(^(y-x)>>63) & 1
+		j += int(^(x-y)>>63) & 1
+		k++
+	}
+	k += copy(dst[k:], a[i:])
+	k += copy(dst[k:], b[j:])
+	return k
+}
+
+// differenceSorted writes the values of the strictly increasing a missing
+// from the strictly increasing b to dst and returns the number of values
+// written.  dst must hold at least len(a) values and may alias a.
+func differenceSorted(dst, a, b []uint32) int {
+	k := 0
+	if len(a)*gallopRatio < len(b) {
+		for _, v := range a {
+			b = b[gallop(b, v):]
+			if len(b) == 0 || b[0] != v {
+				dst[k] = v
+				k++
+			}
+		}
+		return k
+	}
+	j := 0
+	for _, v := range a {
+		for j < len(b) && b[j] < v {
+			j++
+		}
+		if j == len(b) || b[j] != v {
+			dst[k] = v
+			k++
+		}
+	}
+	return k
+}
@@ -71,7 +71,7 @@ func encodeInt32scalar(encoded []byte, data []int32) int {
 	return di
 }
 
-func decodeInt32scalar(data []int32, encoded []byte) {
+func decodeInt32scalar(data []int32, encoded []byte) int {
 	// index of the control bytes
 	ci := 0
 	// index of the data bytes
@@ -104,4 +104,5 @@ func decodeInt32scalar(data []int32, encoded []byte) {
 		data[i] = tmp
 		controlByte >>= 2
 	}
+	return di
 }
@@ -0,0 +1,43 @@
+//go:generate go run gen_search_sse3.go -out search_sse3_amd64.s
+
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+func searchDeltaUint32(control, data []byte, count int, previous, target uint32) int {
+	if activeKernel() >= KernelSSE3 {
+		return searchDeltaUint32SSE3(control, data, count, previous, target)
+	}
+	return searchDeltaUint32scalar(control, data, count, previous, target)
+}
+
+func searchDeltaUint32SSE3(control, data []byte, count int, previous, target uint32) int
+
+func seekDeltaUint32(control, data []byte, count int, previous, target uint32) (n, di int, last uint32) {
+	if activeKernel() >= KernelSSE3 {
+		return seekDeltaUint32SSE3(control, data, count, previous, target)
+	}
+	return seekDeltaUint32scalar(control, data, count, previous, target)
+}
+
+func seekDeltaUint32SSE3(control, data []byte, count int, previous, target uint32) (n, di int, last uint32)
@@ -0,0 +1,80 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"golang.org/x/sys/cpu"
+)
+
+func TestDifferentialSearchSSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		data := makeSortedUint32(r, size)
+		encoded := make([]byte, MaxSize32(size))
+		previous := r.Uint32() >> 8
+		EncodeDeltaUint32(encoded, data, previous)
+		control, rest := encoded[:(size+3)>>2], encoded[(size+3)>>2:]
+		for _, target := range searchTargets(r, data) {
+			got := searchDeltaUint32SSE3(control, rest, size, previous, target)
+			expected := searchDeltaUint32scalar(control, rest, size, previous, target)
+			if got != expected {
+				t.Errorf("size %d: got searchDeltaUint32SSE3(%d): %d, expected: %d", size, target, got, expected)
+			}
+			n, di, last := seekDeltaUint32SSE3(control, rest, size, previous, target)
+			expectedN, expectedDi, expectedLast := seekDeltaUint32scalar(control, rest, size, previous, target)
+			if n != expectedN || di != expectedDi || last != expectedLast {
+				t.Errorf("size %d: got seekDeltaUint32SSE3(%d): %d, %d, %d, expected: %d, %d, %d", size, target, n, di, last, expectedN, expectedDi, expectedLast)
+			}
+		}
+	}
+}
+
+func BenchmarkSearchDeltaUint32SSE3(b *testing.B) {
+	if !cpu.X86.HasSSSE3 {
+		b.Skip("CPU does not support SSSE3 instructions")
+	}
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = EncodeDeltaUint32(benchEncoded, benchUint32DataSorted, 0)
+	control, rest := benchEncoded[:(benchSize+3)>>2], benchEncoded[(benchSize+3)>>2:]
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		searchDeltaUint32SSE3(control, rest, benchSize, 0, math.MaxUint32)
+	}
+}
+
+func BenchmarkSearchDeltaUint32scalar(b *testing.B) {
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = EncodeDeltaUint32(benchEncoded, benchUint32DataSorted, 0)
+	control, rest := benchEncoded[:(benchSize+3)>>2], benchEncoded[(benchSize+3)>>2:]
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		searchDeltaUint32scalar(control, rest, benchSize, 0, math.MaxUint32)
+	}
+}
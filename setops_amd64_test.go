@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"testing"
+
+	"golang.org/x/sys/cpu"
+)
+
+func TestDifferentialIntersectSSE3(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	r := rand.New(rand.NewSource(42))
+	for _, size := range testSizes {
+		for _, universe := range []uint32{8, 100, 1000000} {
+			x, _ := makePostingList(r, size, universe)
+			y, _ := makePostingList(r, size+r.Intn(8), universe)
+			a, b := uniqueUint32(x), uniqueUint32(y)
+			expected := make([]uint32, len(a))
+			got := make([]uint32, len(a))
+			n := intersectUint32scalar(expected, a, b)
+			k, i, j := intersectUint32SSE3(got, a, b)
+			k += intersectUint32scalar(got[k:], a[i:], b[j:])
+			if k != n {
+				t.Fatalf("size %d: got intersectUint32SSE3 %d values, expected: %d", size, k, n)
+			}
+			for m := 0; m < n; m++ {
+				if got[m] != expected[m] {
+					t.Fatalf("size %d: got intersectUint32SSE3 %d at %d, expected: %d", size, got[m], m, expected[m])
+				}
+			}
+		}
+	}
+}
+
+func TestIntersectSSE3WithinLen(t *testing.T) {
+	if !cpu.X86.HasSSSE3 {
+		t.Skip("CPU does not support SSSE3 instructions")
+	}
+	const sentinel = 0xA5A5A5A5
+	r := rand.New(rand.NewSource(42))
+	cases := [][2][]uint32{{{1, 2, 3, 10}, {1, 2, 3, 5, 10, 11, 12, 13}}}
+	for _, size := range testSizes {
+		x, _ := makePostingList(r, size, 64)
+		y, _ := makePostingList(r, size+r.Intn(8), 64)
+		cases = append(cases, [2][]uint32{uniqueUint32(x), uniqueUint32(y)})
+	}
+	for _, c := range cases {
+		a, b := c[0], c[1]
+		expected := make([]uint32, len(a))
+		n := intersectUint32scalar(expected, a, b)
+		// dst exactly as long as the intersection, followed by sentinels.
+		buf := make([]uint32, n+8)
+		for m := n; m < len(buf); m++ {
+			buf[m] = sentinel
+		}
+		dst := buf[:n]
+		k, i, j := intersectUint32SSE3(dst, a, b)
+		k += intersectUint32scalar(dst[k:], a[i:], b[j:])
+		if k != n {
+			t.Fatalf("len(a) %d, len(b) %d: got intersectUint32SSE3 %d values, expected: %d", len(a), len(b), k, n)
+		}
+		for m := 0; m < n; m++ {
+			if dst[m] != expected[m] {
+				t.Fatalf("len(a) %d, len(b) %d: got intersectUint32SSE3 %d at %d, expected: %d", len(a), len(b), dst[m], m, expected[m])
+			}
+		}
+		for m := n; m < len(buf); m++ {
+			if buf[m] != sentinel {
+				t.Fatalf("len(a) %d, len(b) %d: intersectUint32SSE3 wrote %d at %d past len(dst) %d", len(a), len(b), buf[m], m, n)
+			}
+		}
+	}
+}
+
+func benchmarkIntersect(b *testing.B, intersect func(dst, a, b []uint32) int) {
+	r := rand.New(rand.NewSource(42))
+	x, _ := makePostingList(r, benchSize, 4*benchSize)
+	y, _ := makePostingList(r, benchSize, 4*benchSize)
+	x, y = uniqueUint32(x), uniqueUint32(y)
+	dst := make([]uint32, benchSize)
+	b.SetBytes(int64(4 * (len(x) + len(y))))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		intersect(dst, x, y)
+	}
+}
+
+func BenchmarkIntersectUint32SSE3(b *testing.B) {
+	if !cpu.X86.HasSSSE3 {
+		b.Skip("CPU does not support SSSE3 instructions")
+	}
+	benchmarkIntersect(b, intersectUint32)
+}
+
+func BenchmarkIntersectUint32scalar(b *testing.B) {
+	benchmarkIntersect(b, intersectUint32scalar)
+}
@@ -0,0 +1,133 @@
+// Code generated by command: go run gen_setops_sse3.go -out setops_sse3_amd64.s. DO NOT EDIT.
+
+#include "textflag.h"
+
+DATA matchCount<>+0(SB)/1, $0x00
+DATA matchCount<>+1(SB)/1, $0x01
+DATA matchCount<>+2(SB)/1, $0x01
+DATA matchCount<>+3(SB)/1, $0x02
+DATA matchCount<>+4(SB)/1, $0x01
+DATA matchCount<>+5(SB)/1, $0x02
+DATA matchCount<>+6(SB)/1, $0x02
+DATA matchCount<>+7(SB)/1, $0x03
+DATA matchCount<>+8(SB)/1, $0x01
+DATA matchCount<>+9(SB)/1, $0x02
+DATA matchCount<>+10(SB)/1, $0x02
+DATA matchCount<>+11(SB)/1, $0x03
+DATA matchCount<>+12(SB)/1, $0x02
+DATA matchCount<>+13(SB)/1, $0x03
+DATA matchCount<>+14(SB)/1, $0x03
+DATA matchCount<>+15(SB)/1, $0x04
+GLOBL matchCount<>(SB), RODATA|NOPTR, $16
+
+DATA matchMask<>+0(SB)/8, $0xffffffffffffffff
+DATA matchMask<>+8(SB)/8, $0xffffffffffffffff
+DATA matchMask<>+16(SB)/8, $0xffffffff03020100
+DATA matchMask<>+24(SB)/8, $0xffffffffffffffff
+DATA matchMask<>+32(SB)/8, $0xffffffff07060504
+DATA matchMask<>+40(SB)/8, $0xffffffffffffffff
+DATA matchMask<>+48(SB)/8, $0x0706050403020100
+DATA matchMask<>+56(SB)/8, $0xffffffffffffffff
+DATA matchMask<>+64(SB)/8, $0xffffffff0b0a0908
+DATA matchMask<>+72(SB)/8, $0xffffffffffffffff
+DATA matchMask<>+80(SB)/8, $0x0b0a090803020100
+DATA matchMask<>+88(SB)/8, $0xffffffffffffffff
+DATA matchMask<>+96(SB)/8, $0x0b0a090807060504
+DATA matchMask<>+104(SB)/8, $0xffffffffffffffff
+DATA matchMask<>+112(SB)/8, $0x0706050403020100
+DATA matchMask<>+120(SB)/8, $0xffffffff0b0a0908
+DATA matchMask<>+128(SB)/8, $0xffffffff0f0e0d0c
+DATA matchMask<>+136(SB)/8, $0xffffffffffffffff
+DATA matchMask<>+144(SB)/8, $0x0f0e0d0c03020100
+DATA matchMask<>+152(SB)/8, $0xffffffffffffffff
+DATA matchMask<>+160(SB)/8, $0x0f0e0d0c07060504
+DATA matchMask<>+168(SB)/8, $0xffffffffffffffff
+DATA matchMask<>+176(SB)/8, $0x0706050403020100
+DATA matchMask<>+184(SB)/8, $0xffffffff0f0e0d0c
+DATA matchMask<>+192(SB)/8, $0x0f0e0d0c0b0a0908
+DATA matchMask<>+200(SB)/8, $0xffffffffffffffff
+DATA matchMask<>+208(SB)/8, $0x0b0a090803020100
+DATA matchMask<>+216(SB)/8, $0xffffffff0f0e0d0c
+DATA matchMask<>+224(SB)/8, $0x0b0a090807060504
+DATA matchMask<>+232(SB)/8, $0xffffffff0f0e0d0c
+DATA matchMask<>+240(SB)/8, $0x0706050403020100
+DATA matchMask<>+248(SB)/8, $0x0f0e0d0c0b0a0908
+GLOBL matchMask<>(SB), RODATA|NOPTR, $256
+
+// func intersectUint32SSE3(dst []uint32, a []uint32, b []uint32) (k int, i int, j int)
+// Requires: SSE, SSE2, SSSE3
+TEXT ·intersectUint32SSE3(SB), NOSPLIT, $0-96
+	MOVQ dst_base+0(FP), AX
+	MOVQ a_base+24(FP), CX
+	MOVQ b_base+48(FP), DX
+
+	// Stop when fewer than 4 values remain in either a or b.
+	MOVQ a_len+32(FP), BX
+	SUBQ $0x04, BX
+	MOVQ b_len+56(FP), SI
+	SUBQ $0x04, SI
+	XORQ DI, DI
+	XORQ R8, R8
+	XORQ R9, R9
+
+	// The match count lookup table.
+	LEAQ matchCount<>+0(SB), R10
+
+	// The match mask lookup table.
+	LEAQ matchMask<>+0(SB), R11
+
+blockLoop:
+	CMPQ R8, BX
+	JG   done
+	CMPQ R9, SI
+	JG   done
+
+	// Stop when fewer than 4 values fit in dst, as all 4 lanes are stored.
+	MOVQ dst_len+8(FP), R12
+	SUBQ $0x04, R12
+	CMPQ DI, R12
+	JG   done
+
+	// Load 4 values from each of a and b.
+	MOVOU (CX)(R8*4), X0
+	MOVOU (DX)(R9*4), X1
+
+	// Compare the values of a against every rotation of the values of b.
+	MOVOU   X0, X3
+	PCMPEQL X1, X3
+	PSHUFD  $0x39, X1, X2
+	PCMPEQL X0, X2
+	POR     X2, X3
+	PSHUFD  $0x4e, X1, X2
+	PCMPEQL X0, X2
+	POR     X2, X3
+	PSHUFD  $0x93, X1, X2
+	PCMPEQL X0, X2
+	POR     X2, X3
+
+	// Move the matching values of a to the front and store them.
+	MOVMSKPS X3, R12
+	MOVBQZX  (R10)(R12*1), R13
+	SHLQ     $0x04, R12
+	PSHUFB   (R11)(R12*1), X0
+	MOVOU    X0, (AX)(DI*4)
+	ADDQ     R13, DI
+
+	// Advance past the block(s) with the smaller last value.
+	MOVL 12(CX)(R8*4), R12
+	MOVL 12(DX)(R9*4), R13
+	CMPL R12, R13
+	JA   advanceB
+	ADDQ $0x04, R8
+	CMPL R12, R13
+	JB   blockLoop
+
+advanceB:
+	ADDQ $0x04, R9
+	JMP  blockLoop
+
+done:
+	MOVQ DI, k+72(FP)
+	MOVQ R8, i+80(FP)
+	MOVQ R9, j+88(FP)
+	RET
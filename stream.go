@@ -0,0 +1,249 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// A stream is a sequence of blocks, each laid out as
+//
+//	count uvarint, 1 to streamBlockSize
+//	size  uvarint
+//	data  size bytes of Stream VByte encoded count values
+//
+// terminated by a block with a count of zero and no size or data.
+const streamBlockSize = 4096
+
+var errWriterClosed = errors.New("streamvbyte: write to closed Writer")
+
+// Writer encodes uint32 values written to it as a stream of blocks.
+type Writer struct {
+	w       io.Writer
+	values  []uint32
+	encoded []byte
+	err     error
+}
+
+// NewWriter returns a new Writer writing encoded blocks to w.
+// It is the caller's responsibility to call Close on the Writer when done.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		w:       w,
+		values:  make([]uint32, 0, streamBlockSize),
+		encoded: make([]byte, 2*binary.MaxVarintLen64+MaxSize32(streamBlockSize)),
+	}
+}
+
+// Write buffers data, writing a block to the underlying writer each time
+// streamBlockSize values are buffered. It returns the number of values consumed.
+func (w *Writer) Write(data []uint32) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	n := 0
+	for len(data) > 0 {
+		if len(w.values) == 0 && len(data) >= streamBlockSize {
+			if err := w.writeBlock(data[:streamBlockSize]); err != nil {
+				return n, err
+			}
+			data = data[streamBlockSize:]
+			n += streamBlockSize
+			continue
+		}
+		m := copy(w.values[len(w.values):cap(w.values)], data)
+		w.values = w.values[:len(w.values)+m]
+		data = data[m:]
+		n += m
+		if len(w.values) == streamBlockSize {
+			if err := w.writeBlock(w.values); err != nil {
+				return n, err
+			}
+			w.values = w.values[:0]
+		}
+	}
+	return n, nil
+}
+
+// writeBlock encodes values as a single block and writes it to the underlying writer.
+func (w *Writer) writeBlock(values []uint32) error {
+	n := binary.PutUvarint(w.encoded, uint64(len(values)))
+	size := MaxSize32(len(values))
+	dataStart := n + binary.MaxVarintLen64
+	size = EncodeUint32(w.encoded[dataStart:dataStart+size], values)
+	n += binary.PutUvarint(w.encoded[n:], uint64(size))
+	n += copy(w.encoded[n:], w.encoded[dataStart:dataStart+size])
+	if _, err := w.w.Write(w.encoded[:n]); err != nil {
+		w.err = err
+		return err
+	}
+	return nil
+}
+
+// Flush writes any buffered values to the underlying writer as a block.
+func (w *Writer) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+	if len(w.values) == 0 {
+		return nil
+	}
+	if err := w.writeBlock(w.values); err != nil {
+		return err
+	}
+	w.values = w.values[:0]
+	return nil
+}
+
+// Close flushes any buffered values and writes the end of stream marker.
+// It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.err == errWriterClosed {
+		return nil
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if _, err := w.w.Write([]byte{0}); err != nil {
+		w.err = err
+		return err
+	}
+	w.err = errWriterClosed
+	return nil
+}
+
+// Reset discards the Writer's state and makes it equivalent to the result
+// of NewWriter called with dst instead.
+func (w *Writer) Reset(dst io.Writer) {
+	w.w = dst
+	w.values = w.values[:0]
+	w.err = nil
+}
+
+// Reader decodes uint32 values from a stream of blocks written by a Writer.
+type Reader struct {
+	r       streamReader
+	values  []uint32
+	pending []uint32
+	encoded []byte
+	err     error
+}
+
+// streamReader is the interface the Reader needs from its underlying reader.
+type streamReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// NewReader returns a new Reader decoding values from r.
+// If r does not also implement io.ByteReader, the Reader may read more
+// data than necessary from r.
+func NewReader(r io.Reader) *Reader {
+	z := &Reader{
+		values:  make([]uint32, streamBlockSize),
+		encoded: make([]byte, MaxSize32(streamBlockSize)),
+	}
+	z.Reset(r)
+	return z
+}
+
+// Reset discards the Reader's state and makes it equivalent to the result
+// of NewReader called with r instead.
+func (z *Reader) Reset(r io.Reader) {
+	sr, ok := r.(streamReader)
+	if !ok {
+		sr = bufio.NewReader(r)
+	}
+	z.r = sr
+	z.pending = nil
+	z.err = nil
+}
+
+// Read decodes up to len(data) values into data and returns the number
+// of values decoded. At the end of the stream it returns 0, io.EOF.
+func (z *Reader) Read(data []uint32) (int, error) {
+	n := 0
+	for n < len(data) {
+		if len(z.pending) == 0 {
+			if z.err != nil {
+				break
+			}
+			z.err = z.readBlock()
+			continue
+		}
+		m := copy(data[n:], z.pending)
+		z.pending = z.pending[m:]
+		n += m
+	}
+	if n > 0 {
+		return n, nil
+	}
+	return 0, z.err
+}
+
+// readBlock reads and decodes the next block into pending.
+func (z *Reader) readBlock() error {
+	count, err := binary.ReadUvarint(z.r)
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	if count == 0 {
+		return io.EOF
+	}
+	if count > streamBlockSize {
+		return ErrCorrupt
+	}
+	size, err := binary.ReadUvarint(z.r)
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	if size > uint64(MaxSize32(int(count))) {
+		return ErrCorrupt
+	}
+	encoded := z.encoded[:size]
+	if _, err := io.ReadFull(z.r, encoded); err != nil {
+		return unexpectedEOF(err)
+	}
+	values := z.values[:count]
+	n, err := SafeDecodeUint32(values, encoded)
+	if err != nil {
+		return err
+	}
+	if n != len(encoded) {
+		return ErrCorrupt
+	}
+	z.pending = values
+	return nil
+}
+
+// unexpectedEOF converts io.EOF to io.ErrUnexpectedEOF since the stream
+// must end with an end of stream marker.
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+)
+
+func TestStreamRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range []int{0, 1, 5, streamBlockSize - 1, streamBlockSize, 3*streamBlockSize + 17} {
+		data := makeRandomUint32(r, size)
+		var buf bytes.Buffer
+		w := NewWriter(&buf)
+		// write in uneven chunks with intermediate flushes
+		for i := 0; i < len(data); {
+			end := i + r.Intn(2*streamBlockSize) + 1
+			if end > len(data) {
+				end = len(data)
+			}
+			if n, err := w.Write(data[i:end]); err != nil || n != end-i {
+				t.Fatalf("Write got %d, %v, expected %d", n, err, end-i)
+			}
+			if r.Intn(4) == 0 {
+				if err := w.Flush(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			i = end
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(data); err == nil {
+			t.Errorf("Write after Close got nil error")
+		}
+
+		// io.Reader without io.ByteReader to exercise the buffered path
+		z := NewReader(struct{ io.Reader }{&buf})
+		decoded := make([]uint32, 0, size)
+		chunk := make([]uint32, 1000)
+		for {
+			n, err := z.Read(chunk)
+			decoded = append(decoded, chunk[:n]...)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("size %d: got error %v", size, err)
+			}
+		}
+		if len(decoded) != len(data) {
+			t.Fatalf("got len %d, expected %d", len(decoded), len(data))
+		}
+		for i := range data {
+			if decoded[i] != data[i] {
+				t.Fatalf("got decodedData[%d]: %d, expected: %d", i, decoded[i], data[i])
+			}
+		}
+	}
+}
+
+func TestStreamTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.Write(benchUint32Data[:streamBlockSize+10])
+	w.Close()
+	b := buf.Bytes()
+	data := make([]uint32, 2*streamBlockSize)
+	for end := 0; end < len(b); end++ {
+		z := NewReader(bytes.NewReader(b[:end]))
+		var err error
+		for err == nil {
+			_, err = z.Read(data)
+		}
+		if err != io.ErrUnexpectedEOF {
+			t.Errorf("truncated to %d: got %v, expected %v", end, err, io.ErrUnexpectedEOF)
+		}
+	}
+}
+
+func BenchmarkStreamWriter(b *testing.B) {
+	w := NewWriter(ioutil.Discard)
+	b.SetBytes(int64(len(benchUint32Data) * 4))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		w.Write(benchUint32Data)
+	}
+	w.Close()
+}
+
+func BenchmarkStreamReader(b *testing.B) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.Write(benchUint32Data)
+	w.Close()
+	data := make([]uint32, len(benchUint32Data))
+	src := bytes.NewReader(buf.Bytes())
+	z := NewReader(src)
+	b.SetBytes(int64(len(benchUint32Data) * 4))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		src.Reset(buf.Bytes())
+		z.Reset(src)
+		for {
+			if _, err := z.Read(data); err != nil {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"encoding/binary"
+)
+
+// The table driven decoders decode the four values of a control byte without
+// branching on their lengths.  Each control byte indexes a swarEntry and two
+// 64-bit loads cover the values, the first holding values 0 and 1, each of
+// at most 4 bytes, and the second, starting at value 2, holding values 2
+// and 3.  The values are then shifted into place and masked to their lengths.
+
+// swarEntry describes the values referenced by a control byte.
+type swarEntry struct {
+	masks   [4]uint32 // masks of the value lengths
+	shift1  uint8     // bit offset of value 1 in the first load
+	offset2 uint8     // byte offset of value 2, where the second load starts
+	shift3  uint8     // bit offset of value 3 in the second load
+	size    uint8     // count of data bytes (4 to 16)
+}
+
+var swarTable = func() (table [256]swarEntry) {
+	for i := range table {
+		var lengths [4]uint8
+		for j := range lengths {
+			lengths[j] = uint8(i>>(2*uint(j)))&3 + 1
+			table[i].masks[j] = ^uint32(0) >> (32 - 8*uint(lengths[j]))
+		}
+		table[i].shift1 = 8 * lengths[0]
+		table[i].offset2 = lengths[0] + lengths[1]
+		table[i].shift3 = 8 * lengths[2]
+		table[i].size = lengths[0] + lengths[1] + lengths[2] + lengths[3]
+	}
+	return table
+}()
+
+// swarValue decodes value i whose data starts at di and returns it along with
+// the offset of the following data.
+func swarValue(encoded []byte, i, di int) (uint32, int) {
+	switch (encoded[i>>2] >> (2 * uint(i&3))) & 3 {
+	case 0:
+		return uint32(encoded[di]), di + 1
+	case 1:
+		return uint32(binary.LittleEndian.Uint16(encoded[di:])), di + 2
+	case 2:
+		return uint32(encoded[di+2])<<16 | uint32(binary.LittleEndian.Uint16(encoded[di:])), di + 3
+	default:
+		return binary.LittleEndian.Uint32(encoded[di:]), di + 4
+	}
+}
+
+// zigzagDecode returns the bits of the int32 zigzag encoded as v.
+func zigzagDecode(v uint32) uint32 {
+	return (v >> 1) ^ -(v & 1)
+}
+
+func decodeUint32SWAR(data []uint32, encoded []byte) int {
+	// index of the data bytes
+	di := (len(data) + 3) >> 2
+
+	i := 0
+	for ; i+4 <= len(data) && di+16 <= len(encoded); i += 4 {
+		e := &swarTable[encoded[i>>2]]
+		lo := binary.LittleEndian.Uint64(encoded[di:])
+		hi := binary.LittleEndian.Uint64(encoded[di+int(e.offset2):])
+		d := data[i : i+4 : i+4]
+		d[0] = uint32(lo) & e.masks[0]
+		d[1] = uint32(lo>>e.shift1) & e.masks[1]
+		d[2] = uint32(hi) & e.masks[2]
+		d[3] = uint32(hi>>e.shift3) & e.masks[3]
+		di += int(e.size)
+	}
+	// Decode the values within 16 bytes of the end one at a time.
+	for ; i < len(data); i++ {
+		var v uint32
+		v, di = swarValue(encoded, i, di)
+		data[i] = v
+	}
+	return di
+}
+
+func decodeDeltaUint32SWAR(data []uint32, encoded []byte, previous uint32) int {
+	// index of the data bytes
+	di := (len(data) + 3) >> 2
+
+	i := 0
+	for ; i+4 <= len(data) && di+16 <= len(encoded); i += 4 {
+		e := &swarTable[encoded[i>>2]]
+		lo := binary.LittleEndian.Uint64(encoded[di:])
+		hi := binary.LittleEndian.Uint64(encoded[di+int(e.offset2):])
+		d := data[i : i+4 : i+4]
+		previous += uint32(lo) & e.masks[0]
+		d[0] = previous
+		previous += uint32(lo>>e.shift1) & e.masks[1]
+		d[1] = previous
+		previous += uint32(hi) & e.masks[2]
+		d[2] = previous
+		previous += uint32(hi>>e.shift3) & e.masks[3]
+		d[3] = previous
+		di += int(e.size)
+	}
+	// Decode the values within 16 bytes of the end one at a time.
+	for ; i < len(data); i++ {
+		var v uint32
+		v, di = swarValue(encoded, i, di)
+		previous += v
+		data[i] = previous
+	}
+	return di
+}
+
+func decodeInt32SWAR(data []int32, encoded []byte) int {
+	// index of the data bytes
+	di := (len(data) + 3) >> 2
+
+	i := 0
+	for ; i+4 <= len(data) && di+16 <= len(encoded); i += 4 {
+		e := &swarTable[encoded[i>>2]]
+		lo := binary.LittleEndian.Uint64(encoded[di:])
+		hi := binary.LittleEndian.Uint64(encoded[di+int(e.offset2):])
+		d := data[i : i+4 : i+4]
+		d[0] = int32(zigzagDecode(uint32(lo) & e.masks[0]))
+		d[1] = int32(zigzagDecode(uint32(lo>>e.shift1) & e.masks[1]))
+		d[2] = int32(zigzagDecode(uint32(hi) & e.masks[2]))
+		d[3] = int32(zigzagDecode(uint32(hi>>e.shift3) & e.masks[3]))
+		di += int(e.size)
+	}
+	// Decode the values within 16 bytes of the end one at a time.
+	for ; i < len(data); i++ {
+		var v uint32
+		v, di = swarValue(encoded, i, di)
+		data[i] = int32(zigzagDecode(v))
+	}
+	return di
+}
+
+func decodeDeltaInt32SWAR(data []int32, encoded []byte, previous int32) int {
+	// index of the data bytes
+	di := (len(data) + 3) >> 2
+
+	i := 0
+	for ; i+4 <= len(data) && di+16 <= len(encoded); i += 4 {
+		e := &swarTable[encoded[i>>2]]
+		lo := binary.LittleEndian.Uint64(encoded[di:])
+		hi := binary.LittleEndian.Uint64(encoded[di+int(e.offset2):])
+		d := data[i : i+4 : i+4]
+		previous += int32(zigzagDecode(uint32(lo) & e.masks[0]))
+		d[0] = previous
+		previous += int32(zigzagDecode(uint32(lo>>e.shift1) & e.masks[1]))
+		d[1] = previous
+		previous += int32(zigzagDecode(uint32(hi) & e.masks[2]))
+		d[2] = previous
+		previous += int32(zigzagDecode(uint32(hi>>e.shift3) & e.masks[3]))
+		d[3] = previous
+		di += int(e.size)
+	}
+	// Decode the values within 16 bytes of the end one at a time.
+	for ; i < len(data); i++ {
+		var v uint32
+		v, di = swarValue(encoded, i, di)
+		previous += int32(zigzagDecode(v))
+		data[i] = previous
+	}
+	return di
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2020 Brian M. Kessler
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package streamvbyte
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func decodeDeltaUint32SWARTest(data []uint32, encoded []byte) int {
+	return decodeDeltaUint32SWAR(data, encoded, 0)
+}
+
+func decodeDeltaInt32SWARTest(data []int32, encoded []byte) int {
+	return decodeDeltaInt32SWAR(data, encoded, 0)
+}
+
+func TestRoundTripSWAR(t *testing.T) {
+	testUniformAndRandomUint32(t, encodeUint32scalar, decodeUint32SWAR)
+	testUniformDeltaAndRandomUint32(t, encodeDeltaUint32scalarTest, decodeDeltaUint32SWARTest)
+	testUniformAndRandomInt32(t, encodeInt32scalar, decodeInt32SWAR)
+	testUniformDeltaAndRandomInt32(t, encodeDeltaInt32scalarTest, decodeDeltaInt32SWARTest)
+}
+
+// TestDifferentialSWAR checks the table driven decoders against the scalar
+// decoders on random encodings of every length up to 100 values, with and
+// without trailing bytes after the encoded values.
+func TestDifferentialSWAR(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for size := 0; size <= 100; size++ {
+		for _, trailing := range []int{0, 1, 16} {
+			data := makeRandomUint32(r, size)
+			encoded := make([]byte, MaxSize32(size)+trailing)
+			n := encodeUint32scalar(encoded, data)
+			encoded = append(encoded[:n], make([]byte, trailing)...)
+			previous := r.Uint32()
+
+			expected, got := make([]uint32, size), make([]uint32, size)
+			decodeUint32scalar(expected, encoded)
+			if m := decodeUint32SWAR(got, encoded); m != n || !slices.Equal(got, expected) {
+				t.Errorf("size %d trailing %d: decodeUint32SWAR mismatch", size, trailing)
+			}
+			decodeDeltaUint32scalar(expected, encoded, previous)
+			if m := decodeDeltaUint32SWAR(got, encoded, previous); m != n || !slices.Equal(got, expected) {
+				t.Errorf("size %d trailing %d: decodeDeltaUint32SWAR mismatch", size, trailing)
+			}
+
+			expectedInt32, gotInt32 := make([]int32, size), make([]int32, size)
+			decodeInt32scalar(expectedInt32, encoded)
+			if m := decodeInt32SWAR(gotInt32, encoded); m != n || !slices.Equal(gotInt32, expectedInt32) {
+				t.Errorf("size %d trailing %d: decodeInt32SWAR mismatch", size, trailing)
+			}
+			decodeDeltaInt32scalar(expectedInt32, encoded, int32(previous))
+			if m := decodeDeltaInt32SWAR(gotInt32, encoded, int32(previous)); m != n || !slices.Equal(gotInt32, expectedInt32) {
+				t.Errorf("size %d trailing %d: decodeDeltaInt32SWAR mismatch", size, trailing)
+			}
+		}
+	}
+}
+
+func BenchmarkDecodeUint32SWAR(b *testing.B) {
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = encodeUint32scalar(benchEncoded, benchUint32Data)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeUint32SWAR(benchUint32Data, benchEncoded)
+	}
+}
+
+func BenchmarkDecodeDeltaUint32SWAR(b *testing.B) {
+	b.SetBytes(int64(4 * benchSize))
+	benchEncodedSize = encodeDeltaUint32scalar(benchEncoded, benchUint32DataSorted, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decodeDeltaUint32SWAR(benchUint32DataSorted, benchEncoded, 0)
+	}
+}